@@ -0,0 +1,116 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// apiErrorResources wraps the functions of every resource or data source so
+// that the errors they return name the resource and operation which failed.
+// Errors from GitHub also give the ID of the request, to quote when
+// contacting GitHub support, and the rate limit left, which tells throttling
+// apart from other failures during large applies.
+func apiErrorResources(resources map[string]*schema.Resource, dataSource bool) {
+	for name, r := range resources {
+		if dataSource {
+			r.Read = describeErrors(name+" data source", "reading", r.Read)
+			continue
+		}
+
+		r.Create = describeErrors(name, "creating", r.Create)
+		r.Read = describeErrors(name, "reading", r.Read)
+		r.Update = describeErrors(name, "updating", r.Update)
+		r.Delete = describeErrors(name, "deleting", r.Delete)
+		if r.Importer != nil && r.Importer.State != nil {
+			r.Importer.State = describeImportErrors(name, r.Importer.State)
+		}
+	}
+}
+
+func describeErrors(resourceType, operation string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		// The ID is gone once a delete succeeds, and missing until a
+		// create does
+		id := d.Id()
+		err := f(d, meta)
+		if err == nil {
+			return nil
+		}
+		if id == "" {
+			id = d.Id()
+		}
+
+		return describeError(resourceType, operation, id, err)
+	}
+}
+
+func describeImportErrors(resourceType string, f schema.StateFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		id := d.Id()
+		states, err := f(d, meta)
+		if err != nil {
+			return nil, describeError(resourceType, "importing", id, err)
+		}
+
+		return states, nil
+	}
+}
+
+func describeError(resourceType, operation, id string, err error) error {
+	target := resourceType
+	if id != "" {
+		target = fmt.Sprintf("%s %q", resourceType, id)
+	}
+
+	if details := apiErrorDetails(err); details != "" {
+		return fmt.Errorf("Error %s %s: %w (%s)", operation, target, err, details)
+	}
+	return fmt.Errorf("Error %s %s: %w", operation, target, err)
+}
+
+// apiErrorDetails describes the response GitHub answered with, if err is one
+// of the errors go-github returns for unsuccessful responses.
+func apiErrorDetails(err error) string {
+	var resp *http.Response
+	throttled := ""
+	switch e := err.(type) {
+	case *github.ErrorResponse:
+		resp = e.Response
+	case *github.RateLimitError:
+		resp = e.Response
+		throttled = "the rate limit was exceeded"
+	case *github.AbuseRateLimitError:
+		resp = e.Response
+		throttled = "the secondary rate limit was exceeded, which GitHub applies to bursts of requests"
+	}
+	if resp == nil {
+		return ""
+	}
+
+	var details []string
+	if throttled != "" {
+		details = append(details, throttled)
+	}
+	if requestID := resp.Header.Get("X-GitHub-Request-Id"); requestID != "" {
+		details = append(details, "request ID "+requestID)
+	}
+	if remaining := resp.Header.Get("X-RateLimit-Remaining"); remaining != "" {
+		rate := fmt.Sprintf("%s of %s requests left", remaining, resp.Header.Get("X-RateLimit-Limit"))
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			rate += " until " + time.Unix(reset, 0).UTC().Format(time.RFC3339)
+		}
+		details = append(details, rate)
+	}
+
+	return strings.Join(details, "; ")
+}
@@ -0,0 +1,80 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestApiErrorResources(t *testing.T) {
+	apiErr := &github.ErrorResponse{
+		Response: &http.Response{
+			StatusCode: http.StatusForbidden,
+			Request:    &http.Request{Method: "PATCH", URL: &url.URL{Scheme: "https", Host: "api.github.com", Path: "/teams/1234"}},
+			Header: http.Header{
+				"X-Github-Request-Id":   []string{"CAFE:1234"},
+				"X-Ratelimit-Limit":     []string{"5000"},
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{"1800000000"},
+			},
+		},
+		Message: "API rate limit exceeded",
+	}
+
+	resources := map[string]*schema.Resource{
+		"github_example": {
+			Schema: map[string]*schema.Schema{},
+			Create: func(d *schema.ResourceData, meta interface{}) error {
+				return fmt.Errorf("boom")
+			},
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				return nil
+			},
+			Update: func(d *schema.ResourceData, meta interface{}) error {
+				return apiErr
+			},
+		},
+	}
+	apiErrorResources(resources, false)
+	r := resources["github_example"]
+
+	if r.Delete != nil {
+		t.Fatal("Expected a missing delete function to stay missing")
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	if err := r.Read(d, nil); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	err := r.Create(d, nil)
+	if err == nil || err.Error() != "Error creating github_example: boom" {
+		t.Fatalf("Expected the resource type in the error, got %v", err)
+	}
+
+	d.SetId("1234")
+	err = r.Update(d, nil)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	for _, expected := range []string{
+		`Error updating github_example "1234": PATCH https://api.github.com/teams/1234: 403 API rate limit exceeded`,
+		"request ID CAFE:1234",
+		"0 of 5000 requests left until 2027-01-15T08:00:00Z",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("Expected %q in the error, got %s", expected, err)
+		}
+	}
+
+	// The error stays available to code inspecting it
+	if ghErr, ok := errors.Unwrap(err).(*github.ErrorResponse); !ok || ghErr != apiErr {
+		t.Fatalf("Expected the error of GitHub to be wrapped, got %#v", errors.Unwrap(err))
+	}
+}
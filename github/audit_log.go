@@ -0,0 +1,100 @@
+package github
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// auditLog appends a JSON record of every create, update and delete operation
+// to a local file, so that the changes made by an apply can be attached to a
+// change ticket as evidence.
+type auditLog struct {
+	path  string
+	actor string
+
+	m sync.Mutex
+}
+
+type auditRecord struct {
+	Time         string `json:"time"`
+	Resource     string `json:"resource"`
+	Action       string `json:"action"`
+	Target       string `json:"target"`
+	Organization string `json:"organization,omitempty"`
+	Actor        string `json:"actor,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+func (l *auditLog) record(r *auditRecord) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	r.Time = time.Now().UTC().Format(time.RFC3339)
+	r.Actor = l.actor
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		log.Printf("[WARN] Unable to encode audit record: %s", err)
+		return
+	}
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		log.Printf("[WARN] Unable to open audit log %s: %s", l.path, err)
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		log.Printf("[WARN] Unable to write audit log %s: %s", l.path, err)
+	}
+}
+
+// auditResources wraps the create, update and delete functions of every
+// resource so that they are recorded in the audit log, if one is configured.
+func auditResources(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		r.Create = auditOperation(name, "create", r.Create)
+		r.Update = auditOperation(name, "update", r.Update)
+		r.Delete = auditOperation(name, "delete", r.Delete)
+	}
+}
+
+func auditOperation(resourceType, action string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		// The ID is gone once a delete succeeds
+		target := d.Id()
+		err := f(d, meta)
+
+		org := meta.(*Organization)
+		if org.auditLog == nil {
+			return err
+		}
+
+		if target == "" {
+			target = d.Id()
+		}
+
+		r := &auditRecord{
+			Resource:     resourceType,
+			Action:       action,
+			Target:       target,
+			Organization: org.name,
+		}
+		if err != nil {
+			r.Error = err.Error()
+		}
+		org.auditLog.record(r)
+
+		return err
+	}
+}
@@ -0,0 +1,82 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAuditResources(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-github-audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	resources := map[string]*schema.Resource{
+		"github_example": {
+			Schema: map[string]*schema.Schema{},
+			Create: func(d *schema.ResourceData, meta interface{}) error {
+				d.SetId("example")
+				return nil
+			},
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				return nil
+			},
+			Delete: func(d *schema.ResourceData, meta interface{}) error {
+				return fmt.Errorf("boom")
+			},
+		},
+	}
+	auditResources(resources)
+
+	if resources["github_example"].Update != nil {
+		t.Fatal("Expected a missing update function to stay missing")
+	}
+
+	meta := &Organization{
+		name:                "example-org",
+		organizationOptions: organizationOptions{auditLog: &auditLog{path: path, actor: "octocat"}},
+	}
+	d := schema.TestResourceDataRaw(t, resources["github_example"].Schema, map[string]interface{}{})
+	if err := resources["github_example"].Create(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if err := resources["github_example"].Delete(d, meta); err == nil {
+		t.Fatal("Expected the error of the delete function to be returned")
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 audit records, got %d", len(lines))
+	}
+
+	expected := []auditRecord{
+		{Resource: "github_example", Action: "create", Target: "example", Organization: "example-org", Actor: "octocat"},
+		{Resource: "github_example", Action: "delete", Target: "example", Organization: "example-org", Actor: "octocat", Error: "boom"},
+	}
+	for i, line := range lines {
+		var r auditRecord
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatal(err)
+		}
+		if r.Time == "" {
+			t.Fatalf("Expected audit record %d to have a time", i)
+		}
+		r.Time = ""
+		if r != expected[i] {
+			t.Fatalf("Expected audit record %+v, got %+v", expected[i], r)
+		}
+	}
+}
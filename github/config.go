@@ -4,8 +4,12 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"log"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-github/v28/github"
 	"github.com/hashicorp/terraform/helper/logging"
@@ -19,26 +23,86 @@ type Config struct {
 	Insecure     bool
 	Individual   bool
 	Anonymous    bool
+	APIVersion   string
+	AuditLogFile string
+
+	DisableConditionalRequests bool
+	ValidateReferences         bool
+	LogRequests                bool
+	MaxConcurrentRequests      int
+	PerPage                    int
+	ConsistencyTimeout         time.Duration
+	PreventDestroy             bool
+
+	LookupCacheFile string
+	LookupCacheTTL  time.Duration
+
+	// Stops the requests made configuring the provider, and later its
+	// operations, when Terraform is interrupted
+	StopContext context.Context
 }
 
 type Organization struct {
 	name        string
 	client      *github.Client
 	StopContext context.Context
+	teamsRoutes teamsRoutes
+
+	organizationOptions
+
+	// The IDs of teams by their slug, of repositories by their name and of
+	// users by their login, which may be kept in lookupCacheFile across runs
+	teamIDs       lookupCache
+	repositoryIDs lookupCache
+	userIDs       lookupCache
+
+	// The meta of every organization overriding the configured one
+	owners     map[string]*Organization
+	ownersLock sync.Mutex
+
+	// The meta keeping the caches, if this is the meta of an operation
+	shared *Organization
+}
+
+// organizationOptions are what the provider is configured with, which the
+// metas of other owners and of operations are copied along with.
+type organizationOptions struct {
+	individual         bool
+	repositoryDefaults *repositoryDefaults
+	auditLog           *auditLog
+	lookupCacheFile    *lookupCacheFile
+
+	// The transports of the provider around the one authenticating
+	// requests, for clients with other credentials
+	transport func(authenticate func(base http.RoundTripper) http.RoundTripper) http.RoundTripper
+
+	// Whether reads are made conditional on the etag of their resource
+	conditionalRequests bool
+	// Whether plans check that the teams and users they refer to exist
+	validateReferences bool
+	// Whether base_url points at a GitHub Enterprise Server
+	enterpriseServer bool
+	// How many read requests may be in flight at once
+	maxConcurrentRequests int
+	// How many items are requested per page of a list
+	perPage int
+	// How long GitHub may not find what was just created
+	consistencyTimeout time.Duration
+	// Whether repositories, teams and memberships are only destroyed if
+	// they allow it
+	preventDestroy bool
 }
 
 // Client configures and returns a fully initialized GithubClient
 func (c *Config) Client() (interface{}, error) {
 	var org Organization
 	var ts oauth2.TokenSource
-	var tc *http.Client
-
-	ctx := context.Background()
 
-	if c.Insecure {
-		insecureClient := insecureHttpClient()
-		ctx = context.WithValue(ctx, oauth2.HTTPClient, insecureClient)
+	ctx := c.StopContext
+	if ctx == nil {
+		ctx = context.Background()
 	}
+	org.StopContext = c.StopContext
 
 	// Either Organization needs to be set, or Individual needs to be true
 	if c.Organization != "" && c.Individual {
@@ -48,11 +112,15 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, fmt.Errorf("If `individual` is false, `organization` is required.")
 	}
 
-	if c.Individual {
-		org.name = ""
-	} else {
-		org.name = c.Organization
-	}
+	org.name = c.Organization
+	org.individual = c.Individual
+	// Anonymous clients are not given the etag transport below
+	org.conditionalRequests = !c.Anonymous && !c.DisableConditionalRequests
+	org.validateReferences = c.ValidateReferences
+	org.maxConcurrentRequests = c.MaxConcurrentRequests
+	org.perPage = c.PerPage
+	org.consistencyTimeout = c.ConsistencyTimeout
+	org.preventDestroy = c.PreventDestroy
 
 	// Either run as anonymous, or run with a Token
 	if c.Token != "" && c.Anonymous {
@@ -68,16 +136,18 @@ func (c *Config) Client() (interface{}, error) {
 		)
 	}
 
-	tc = oauth2.NewClient(ctx, ts)
-
-	if c.Anonymous {
-		tc.Transport = http.DefaultTransport
-	} else {
-		tc.Transport = NewEtagTransport(tc.Transport)
-	}
+	org.transport = c.transport
+	tc := &http.Client{Transport: c.transport(func(base http.RoundTripper) http.RoundTripper {
+		if c.Anonymous {
+			return base
+		}
 
-	tc.Transport = NewRateLimitTransport(tc.Transport)
-	tc.Transport = logging.NewTransport("Github", tc.Transport)
+		var rt http.RoundTripper = &oauth2.Transport{Source: ts, Base: base}
+		if org.conditionalRequests {
+			rt = NewEtagTransport(rt)
+		}
+		return rt
+	})}
 
 	org.client = github.NewClient(tc)
 
@@ -87,11 +157,164 @@ func (c *Config) Client() (interface{}, error) {
 			return nil, err
 		}
 		org.client.BaseURL = u
+		org.enterpriseServer = isEnterpriseServer(u)
+	}
+
+	if c.APIVersion != "" {
+		if err := checkApiVersion(ctx, org.client, c.APIVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	// Problems with the token or the organization are reported once here,
+	// rather than by every resource
+	var login string
+	if !c.Anonymous {
+		var err error
+		login, err = preflight(ctx, org.client, c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// An individual account owns the repositories of the authenticated user
+	if c.Individual {
+		if login == "" && !c.Anonymous {
+			return nil, fmt.Errorf("`individual` needs the login of the authenticated user, which GitHub does not return for GitHub App installation tokens; set `organization` instead")
+		}
+		org.name = login
+	}
+
+	if c.LookupCacheFile != "" {
+		org.persistLookups(loadLookupCacheFile(c.LookupCacheFile, c.LookupCacheTTL))
+	}
+
+	if c.AuditLogFile != "" {
+		if login == "" && !c.Anonymous {
+			return nil, fmt.Errorf("`audit_log_file` needs the login of the authenticated user to record as the actor, which GitHub does not return for GitHub App installation tokens")
+		}
+		// Records name the authenticated user as the actor
+		org.auditLog = &auditLog{path: c.AuditLogFile, actor: login}
 	}
 
 	return &org, nil
 }
 
+// isEnterpriseServer tells whether the API at u is served by a GitHub
+// Enterprise Server rather than by github.com or a GHE.com subdomain.
+func isEnterpriseServer(u *url.URL) bool {
+	host := strings.ToLower(u.Hostname())
+	return host != "api.github.com" && host != "github.com" && !strings.HasSuffix(host, ".ghe.com")
+}
+
+// preflight makes sure the token is valid, can see the organization and has
+// the scopes the provider needs, returning the login of the authenticated
+// user. Every problem found is reported in a single error. The installation
+// tokens of GitHub Apps are not a user, so their login is empty.
+func preflight(ctx context.Context, client *github.Client, c *Config) (string, error) {
+	user, resp, err := client.Users.Get(ctx, "")
+	if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusForbidden {
+		// GitHub answers "Resource not accessible by integration"
+		log.Printf("[INFO] The token is not a user, assuming a GitHub App installation token: %s", ghErr.Message)
+		user, resp, err = nil, nil, nil
+	}
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusUnauthorized {
+			return "", fmt.Errorf("GitHub rejected the token set by `token`: %s", ghErr.Message)
+		}
+		return "", err
+	}
+
+	who := "the token"
+	if user != nil {
+		who = user.GetLogin()
+	}
+
+	var problems []string
+	if c.Organization != "" {
+		_, _, err := client.Organizations.Get(ctx, c.Organization)
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			problems = append(problems, fmt.Sprintf(
+				"the organization %q set by `organization` does not exist, or %s cannot see it", c.Organization, who))
+		} else if err != nil {
+			return "", err
+		}
+	}
+
+	// Only classic personal access tokens have scopes; other tokens are
+	// granted permissions which cannot be inspected. Which scopes are
+	// needed depends on the resources managed, e.g. teams do not need repo,
+	// so missing scopes are only warned about
+	if resp != nil {
+		if scopes, ok := resp.Header[http.CanonicalHeaderKey("X-OAuth-Scopes")]; ok {
+			for _, missing := range missingTokenScopes(strings.Join(scopes, ","), c.Organization != "") {
+				log.Printf("[WARN] The GitHub provider may not be able to manage every resource: %s", missing)
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return "", fmt.Errorf("The GitHub provider is not configured correctly:\n\n  * %s", strings.Join(problems, "\n  * "))
+	}
+
+	return user.GetLogin(), nil
+}
+
+// missingTokenScopes describes the scopes the provider needs which are not
+// among the comma-separated scopes of a token. Scopes which imply others, such
+// as admin:org for read:org, satisfy them.
+func missingTokenScopes(scopes string, organization bool) []string {
+	granted := map[string]bool{}
+	for _, scope := range strings.Split(scopes, ",") {
+		granted[strings.TrimSpace(scope)] = true
+	}
+
+	var missing []string
+	if !granted["repo"] && !granted["public_repo"] {
+		missing = append(missing, "the token lacks the `repo` scope, or `public_repo` for public repositories only")
+	}
+	if organization && !granted["read:org"] && !granted["write:org"] && !granted["admin:org"] {
+		missing = append(missing, "the token lacks the `read:org` scope, or `admin:org` to manage the organization")
+	}
+
+	return missing
+}
+
+// checkApiVersion makes sure the server accepts the pinned API version, so a
+// rejected version is reported once when the provider is configured rather
+// than by every resource.
+func checkApiVersion(ctx context.Context, client *github.Client, version string) error {
+	_, err := apiRequest(ctx, client, "GET", "meta", nil, nil)
+	if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusBadRequest {
+		return fmt.Errorf("GitHub rejected the API version %q set by `api_version`: %s", version, ghErr.Message)
+	}
+
+	return err
+}
+
+// transport returns the transports every request of the provider goes
+// through, around the one authenticating requests which authenticate returns
+// given the transport finally sending them.
+func (c *Config) transport(authenticate func(base http.RoundTripper) http.RoundTripper) http.RoundTripper {
+	base := http.DefaultTransport
+	if c.Insecure {
+		base = insecureHttpClient().Transport
+	}
+	rt := authenticate(base)
+
+	if c.APIVersion != "" {
+		rt = NewApiVersionTransport(rt, c.APIVersion)
+	}
+
+	if c.LogRequests {
+		rt = NewRequestLogTransport(rt, apiRequestMetrics)
+	}
+
+	// Clients with other credentials are rate limited on their own
+	rt = NewRateLimitTransport(rt, c.MaxConcurrentRequests)
+	return logging.NewTransport("Github", rt)
+}
+
 func insecureHttpClient() *http.Client {
 	return &http.Client{
 		Transport: &http.Transport{
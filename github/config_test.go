@@ -0,0 +1,208 @@
+package github
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestMissingTokenScopes(t *testing.T) {
+	cases := []struct {
+		Scopes       string
+		Organization bool
+		Missing      int
+	}{
+		{"repo, read:org", true, 0},
+		{"public_repo, admin:org", true, 0},
+		{"repo", false, 0},
+		{"repo", true, 1},
+		{"gist", true, 2},
+		{"", false, 1},
+	}
+
+	for _, tc := range cases {
+		if missing := missingTokenScopes(tc.Scopes, tc.Organization); len(missing) != tc.Missing {
+			t.Fatalf("Expected %d missing scopes for %q, got %v", tc.Missing, tc.Scopes, missing)
+		}
+	}
+}
+
+func TestIsEnterpriseServer(t *testing.T) {
+	cases := []struct {
+		BaseURL          string
+		EnterpriseServer bool
+	}{
+		{"https://api.github.com/", false},
+		{"https://api.octocorp.ghe.com/", false},
+		{"https://github.example.com/api/v3/", true},
+		{"http://10.0.0.1:8080/api/v3/", true},
+	}
+
+	for _, tc := range cases {
+		u, _ := url.Parse(tc.BaseURL)
+		if isEnterpriseServer(u) != tc.EnterpriseServer {
+			t.Fatalf("Expected %q to be an Enterprise Server: %t", tc.BaseURL, tc.EnterpriseServer)
+		}
+	}
+}
+
+func TestConfig_preflight(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/user",
+			ResponseHeaders: map[string]string{
+				"X-OAuth-Scopes": "repo",
+			},
+			ResponseBody: `{"login": "octocat"}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/orgs/example",
+			ResponseBody: `{"message": "Not Found"}`,
+			StatusCode:   404,
+		},
+	})
+	defer ts.Close()
+
+	config := Config{
+		Token:        "token",
+		Organization: "example",
+		BaseURL:      ts.URL + "/",
+	}
+
+	_, err := config.Client()
+	if err == nil || !strings.Contains(err.Error(), `"example"`) {
+		t.Fatalf("Expected the missing organization to be reported, got: %v", err)
+	}
+}
+
+func TestConfig_preflightScopes(t *testing.T) {
+	// Tokens only managing teams and memberships need no repo scope
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/user",
+			ResponseHeaders: map[string]string{
+				"X-OAuth-Scopes": "admin:org",
+			},
+			ResponseBody: `{"login": "octocat"}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/orgs/example",
+			ResponseBody: `{"login": "example"}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	config := Config{
+		Token:        "token",
+		Organization: "example",
+		BaseURL:      ts.URL + "/",
+	}
+
+	if _, err := config.Client(); err != nil {
+		t.Fatalf("Expected missing scopes to only be warned about, got: %s", err)
+	}
+}
+
+func TestConfig_stopContext(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/user",
+			ResponseBody: `{"login": "octocat"}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	// Interrupting Terraform stops the provider from being configured
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	config := Config{
+		Token:        "token",
+		Organization: "example",
+		BaseURL:      ts.URL + "/",
+		StopContext:  ctx,
+	}
+
+	if _, err := config.Client(); err == nil || !strings.Contains(err.Error(), context.Canceled.Error()) {
+		t.Fatalf("Expected configuring the provider to stop, got: %v", err)
+	}
+}
+
+func TestConfig_preflightIndividual(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/user",
+			ResponseBody: `{"login": "octocat"}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	config := Config{
+		Token:      "token",
+		Individual: true,
+		BaseURL:    ts.URL + "/",
+	}
+
+	meta, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := meta.(*Organization).name; name != "octocat" {
+		t.Fatalf("Expected the authenticated user to own the repositories, got %q", name)
+	}
+}
+
+func TestConfig_preflightInstallationToken(t *testing.T) {
+	// GitHub App installation tokens are not a user
+	responses := func() []*mockResponse {
+		return []*mockResponse{
+			{
+				ExpectedUri:  "/user",
+				ResponseBody: `{"message": "Resource not accessible by integration"}`,
+				StatusCode:   403,
+			},
+			{
+				ExpectedUri:  "/orgs/example",
+				ResponseBody: `{"login": "example"}`,
+				StatusCode:   200,
+			},
+		}
+	}
+
+	ts := githubApiMock(responses())
+	defer ts.Close()
+
+	config := Config{
+		Token:        "ghs_token",
+		Organization: "example",
+		BaseURL:      ts.URL + "/",
+	}
+
+	meta, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := meta.(*Organization).name; name != "example" {
+		t.Fatalf("Expected the organization to be managed, got %q", name)
+	}
+
+	// Neither can own the repositories nor be recorded as the actor
+	for _, c := range []Config{
+		{Token: "ghs_token", Individual: true},
+		{Token: "ghs_token", Organization: "example", AuditLogFile: "audit.log"},
+	} {
+		ts := githubApiMock(responses())
+		defer ts.Close()
+
+		c.BaseURL = ts.URL + "/"
+		_, err := c.Client()
+		if err == nil || !strings.Contains(err.Error(), "GitHub App installation tokens") {
+			t.Fatalf("Expected the missing login to be reported, got %v", err)
+		}
+	}
+}
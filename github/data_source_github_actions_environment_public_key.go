@@ -0,0 +1,43 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubActionsEnvironmentPublicKey() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubActionsEnvironmentPublicKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubActionsEnvironmentPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	envName := d.Get("environment").(string)
+
+	return fetchSecretsPublicKey(d, meta,
+		fmt.Sprintf("repos/%s/%s/environments/%s/secrets/public-key", owner, repoName, url.PathEscape(envName)),
+		buildTwoPartID(&repoName, &envName))
+}
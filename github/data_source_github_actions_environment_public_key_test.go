@@ -0,0 +1,50 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubActionsEnvironmentPublicKeyDataSource_basic(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-key-%s", rs)
+	keyRe := regexp.MustCompile("^.+$")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubActionsEnvironmentPublicKeyDataSourceConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.github_actions_environment_public_key.test", "key_id", keyRe),
+					resource.TestMatchResourceAttr("data.github_actions_environment_public_key.test", "key", keyRe),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubActionsEnvironmentPublicKeyDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_repository_environment" "test" {
+  repository  = "${github_repository.test.name}"
+  environment = "production"
+}
+
+data "github_actions_environment_public_key" "test" {
+  repository  = "${github_repository.test.name}"
+  environment = "${github_repository_environment.test.environment}"
+}
+`, repoName)
+}
@@ -0,0 +1,83 @@
+package github
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type secretsPublicKey struct {
+	KeyID *string `json:"key_id,omitempty"`
+	Key   *string `json:"key,omitempty"`
+}
+
+func (k *secretsPublicKey) GetKeyID() string {
+	if k == nil || k.KeyID == nil {
+		return ""
+	}
+	return *k.KeyID
+}
+
+func dataSourceGithubActionsPublicKey() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubActionsPublicKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubActionsPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
+	return readSecretsPublicKey(d, meta, "actions")
+}
+
+// readSecretsPublicKey reads the public key which secrets of the given
+// product ("actions", "dependabot", ...) must be encrypted with, for the
+// configured repository or else for the organization.
+func readSecretsPublicKey(d *schema.ResourceData, meta interface{}, product string) error {
+	owner := meta.(*Organization).name
+
+	if repoName, ok := d.GetOk("repository"); ok {
+		return fetchSecretsPublicKey(d, meta,
+			fmt.Sprintf("repos/%s/%s/%s/secrets/public-key", owner, repoName.(string), product),
+			fmt.Sprintf("%s/%s", owner, repoName.(string)))
+	}
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	return fetchSecretsPublicKey(d, meta, fmt.Sprintf("orgs/%s/%s/secrets/public-key", owner, product), owner)
+}
+
+func fetchSecretsPublicKey(d *schema.ResourceData, meta interface{}, url, id string) error {
+	client := meta.(*Organization).client
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Reading secrets public key: %s", url)
+	key := new(secretsPublicKey)
+	_, err := apiRequest(ctx, client, "GET", url, nil, key)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+	d.Set("key_id", key.KeyID)
+	d.Set("key", key.Key)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubActionsPublicKeyDataSource_basic(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-key-%s", rs)
+	keyRe := regexp.MustCompile("^.+$")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubActionsPublicKeyDataSourceConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.github_actions_public_key.repository", "key_id", keyRe),
+					resource.TestMatchResourceAttr("data.github_actions_public_key.repository", "key", keyRe),
+					resource.TestMatchResourceAttr("data.github_actions_public_key.organization", "key_id", keyRe),
+					resource.TestMatchResourceAttr("data.github_actions_public_key.organization", "key", keyRe),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubActionsPublicKeyDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+data "github_actions_public_key" "repository" {
+  repository = "${github_repository.test.name}"
+}
+
+data "github_actions_public_key" "organization" {}
+`, repoName)
+}
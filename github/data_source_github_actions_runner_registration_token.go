@@ -0,0 +1,68 @@
+package github
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type runnerRegistrationToken struct {
+	Token     *string `json:"token,omitempty"`
+	ExpiresAt *string `json:"expires_at,omitempty"`
+}
+
+func dataSourceGithubActionsRunnerRegistrationToken() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubActionsRunnerRegistrationTokenRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubActionsRunnerRegistrationTokenRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	// Without a repository the runner is registered with the organization,
+	// where runner groups decide which repositories may use it
+	tokenURL := fmt.Sprintf("orgs/%s/actions/runners/registration-token", owner)
+	id := owner
+	if repoName, ok := d.GetOk("repository"); ok {
+		tokenURL = fmt.Sprintf("repos/%s/%s/actions/runners/registration-token", owner, repoName.(string))
+		id = fmt.Sprintf("%s/%s", owner, repoName.(string))
+	} else {
+		err := checkOrganization(meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Creating runner registration token: %s", id)
+	token := new(runnerRegistrationToken)
+	_, err := apiRequest(ctx, client, "POST", tokenURL, nil, token)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+	d.Set("token", token.Token)
+	d.Set("expires_at", token.ExpiresAt)
+
+	return nil
+}
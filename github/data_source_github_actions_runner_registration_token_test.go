@@ -0,0 +1,74 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccGithubActionsRunnerRegistrationTokenDataSource_basic(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-runner-%s", rs)
+	dsn := "data.github_actions_runner_registration_token.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+data "github_actions_runner_registration_token" "test" {
+  repository = "${github_repository.test.name}"
+}
+`, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsn, "id", fmt.Sprintf("%s/%s", testOrganization, repoName)),
+					resource.TestCheckResourceAttrSet(dsn, "token"),
+					resource.TestCheckResourceAttrSet(dsn, "expires_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestGithubActionsRunnerRegistrationTokenRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/orgs/example/actions/runners/registration-token",
+			ExpectedMethod: "POST",
+			StatusCode:     201,
+			ResponseBody:   `{"token": "LLBF3JGZDX3P5PMEXLND6TS6FCWO6", "expires_at": "2020-01-22T12:13:35.123-08:00"}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubActionsRunnerRegistrationToken().Schema, map[string]interface{}{})
+	if err := dataSourceGithubActionsRunnerRegistrationTokenRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "example" {
+		t.Fatalf("Expected the ID of the organization, got %q", d.Id())
+	}
+	if v := d.Get("token"); v != "LLBF3JGZDX3P5PMEXLND6TS6FCWO6" {
+		t.Fatalf("Unexpected token %q", v)
+	}
+	if v := d.Get("expires_at"); v != "2020-01-22T12:13:35.123-08:00" {
+		t.Fatalf("Unexpected expiry %q", v)
+	}
+}
@@ -0,0 +1,174 @@
+package github
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type actionsRunnerGroups struct {
+	TotalCount   int                   `json:"total_count"`
+	RunnerGroups []*actionsRunnerGroup `json:"runner_groups"`
+}
+
+type actionsRunner struct {
+	ID     *int64  `json:"id,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	Status *string `json:"status,omitempty"`
+	Busy   *bool   `json:"busy,omitempty"`
+}
+
+type actionsRunners struct {
+	TotalCount int              `json:"total_count"`
+	Runners    []*actionsRunner `json:"runners"`
+}
+
+// runnerUsage counts the runners of a runner group by their state. Offline
+// runners are neither busy nor idle.
+type runnerUsage struct {
+	Total   int
+	Online  int
+	Offline int
+	Busy    int
+	Idle    int
+}
+
+func (u *runnerUsage) add(r *actionsRunner) {
+	u.Total++
+	if r.Status == nil || *r.Status != "online" {
+		u.Offline++
+		return
+	}
+
+	u.Online++
+	if r.Busy != nil && *r.Busy {
+		u.Busy++
+	} else {
+		u.Idle++
+	}
+}
+
+func dataSourceGithubActionsRunnerUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubActionsRunnerUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"runner_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"online": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"offline": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"busy": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"idle": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"total": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"busy": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"idle": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubActionsRunnerUsageRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Reading Actions runner usage: %s", orgName)
+	groups, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		result := new(actionsRunnerGroups)
+		resp, err := apiRequest(ctx, client, "GET",
+			fmt.Sprintf("orgs/%s/actions/runner-groups?per_page=%d&page=%d", orgName, opt.PerPage, opt.Page), nil, result)
+		return result.RunnerGroups, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	var total runnerUsage
+	usages := make([]interface{}, 0, len(groups))
+	for _, v := range groups {
+		g := v.(*actionsRunnerGroup)
+		if g.ID == nil {
+			continue
+		}
+
+		runners, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+			result := new(actionsRunners)
+			resp, err := apiRequest(ctx, client, "GET",
+				fmt.Sprintf("orgs/%s/actions/runner-groups/%d/runners?per_page=%d&page=%d",
+					orgName, *g.ID, opt.PerPage, opt.Page), nil, result)
+			return result.Runners, resp, err
+		})
+		if err != nil {
+			return err
+		}
+
+		var usage runnerUsage
+		for _, r := range runners {
+			usage.add(r.(*actionsRunner))
+			total.add(r.(*actionsRunner))
+		}
+
+		usages = append(usages, map[string]interface{}{
+			"id":      g.ID,
+			"name":    g.Name,
+			"total":   usage.Total,
+			"online":  usage.Online,
+			"offline": usage.Offline,
+			"busy":    usage.Busy,
+			"idle":    usage.Idle,
+		})
+	}
+
+	d.SetId(orgName)
+	d.Set("runner_groups", usages)
+	d.Set("total", total.Total)
+	d.Set("busy", total.Busy)
+	d.Set("idle", total.Idle)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestRunnerUsage(t *testing.T) {
+	var usage runnerUsage
+	usage.add(&actionsRunner{Status: github.String("online"), Busy: github.Bool(true)})
+	usage.add(&actionsRunner{Status: github.String("online"), Busy: github.Bool(false)})
+	usage.add(&actionsRunner{Status: github.String("offline"), Busy: github.Bool(true)})
+	usage.add(&actionsRunner{})
+
+	expected := runnerUsage{Total: 4, Online: 2, Offline: 2, Busy: 1, Idle: 1}
+	if usage != expected {
+		t.Fatalf("Expected runner usage %+v, got %+v", expected, usage)
+	}
+}
+
+func TestAccGithubActionsRunnerUsageDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "github_actions_runner_usage" "test" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					// Every organization has a default runner group
+					resource.TestCheckResourceAttrSet("data.github_actions_runner_usage.test", "runner_groups.0.name"),
+					resource.TestCheckResourceAttrSet("data.github_actions_runner_usage.test", "total"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,129 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type actionsSecret struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type actionsSecrets struct {
+	TotalCount int              `json:"total_count"`
+	Secrets    []*actionsSecret `json:"secrets"`
+}
+
+func dataSourceGithubActionsSecretsInventory() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubActionsSecretsInventoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"expected_secrets": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"secrets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"missing_secrets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"extra_secrets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGithubActionsSecretsInventoryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	// Without a repository the organization's secrets are inventoried
+	baseURL := fmt.Sprintf("orgs/%s/actions/secrets", owner)
+	id := owner
+	if repoName, ok := d.GetOk("repository"); ok {
+		baseURL = fmt.Sprintf("repos/%s/%s/actions/secrets", owner, repoName.(string))
+		id = fmt.Sprintf("%s/%s", owner, repoName.(string))
+	} else {
+		err := checkOrganization(meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Reading Actions secrets inventory: %s", id)
+	secrets, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		result := new(actionsSecrets)
+		resp, err := apiRequest(ctx, client, "GET",
+			fmt.Sprintf("%s?per_page=%d&page=%d", baseURL, opt.PerPage, opt.Page), nil, result)
+		return result.Secrets, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+	for _, v := range secrets {
+		if s := v.(*actionsSecret); s.Name != nil {
+			names = append(names, *s.Name)
+		}
+	}
+
+	expected := expandStringList(d.Get("expected_secrets").(*schema.Set).List())
+	missing, extra := diffSecretNames(expected, names)
+	sort.Strings(names)
+
+	d.SetId(id)
+	d.Set("secrets", names)
+	d.Set("missing_secrets", missing)
+	d.Set("extra_secrets", extra)
+
+	return nil
+}
+
+// diffSecretNames returns the sorted names which are expected but absent, and
+// those which are present but not expected.
+func diffSecretNames(expected, actual []string) ([]string, []string) {
+	actualSet := make(map[string]bool, len(actual))
+	for _, name := range actual {
+		actualSet[name] = true
+	}
+
+	expectedSet := make(map[string]bool, len(expected))
+	missing := []string{}
+	for _, name := range expected {
+		expectedSet[name] = true
+		if !actualSet[name] {
+			missing = append(missing, name)
+		}
+	}
+
+	extra := []string{}
+	for _, name := range actual {
+		if !expectedSet[name] {
+			extra = append(extra, name)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(extra)
+	return missing, extra
+}
@@ -0,0 +1,82 @@
+package github
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestDiffSecretNames(t *testing.T) {
+	cases := []struct {
+		Expected, Actual []string
+		Missing, Extra   []string
+	}{
+		{
+			Expected: []string{"A", "B"},
+			Actual:   []string{"B", "A"},
+			Missing:  []string{},
+			Extra:    []string{},
+		},
+		{
+			Expected: []string{"C", "A"},
+			Actual:   []string{"B", "A", "D"},
+			Missing:  []string{"C"},
+			Extra:    []string{"B", "D"},
+		},
+		{
+			Expected: []string{},
+			Actual:   []string{},
+			Missing:  []string{},
+			Extra:    []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		missing, extra := diffSecretNames(tc.Expected, tc.Actual)
+		if !reflect.DeepEqual(missing, tc.Missing) {
+			t.Fatalf("Expected missing %v, got %v", tc.Missing, missing)
+		}
+		if !reflect.DeepEqual(extra, tc.Extra) {
+			t.Fatalf("Expected extra %v, got %v", tc.Extra, extra)
+		}
+	}
+}
+
+func TestAccGithubActionsSecretsInventoryDataSource_repository(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-secrets-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubActionsSecretsInventoryDataSourceConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.github_actions_secrets_inventory.test", "secrets.#", "0"),
+					resource.TestCheckResourceAttr("data.github_actions_secrets_inventory.test", "missing_secrets.#", "1"),
+					resource.TestCheckResourceAttr("data.github_actions_secrets_inventory.test", "missing_secrets.0", "DEPLOY_TOKEN"),
+					resource.TestCheckResourceAttr("data.github_actions_secrets_inventory.test", "extra_secrets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubActionsSecretsInventoryDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+data "github_actions_secrets_inventory" "test" {
+  repository       = "${github_repository.test.name}"
+  expected_secrets = ["DEPLOY_TOKEN"]
+}
+`, repoName)
+}
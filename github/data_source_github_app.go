@@ -0,0 +1,102 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// githubApp is a GitHub App; unlike the vendored go-github type it includes
+// the permissions and events the app requests.
+type githubApp struct {
+	ID          *int64            `json:"id,omitempty"`
+	NodeID      *string           `json:"node_id,omitempty"`
+	Slug        *string           `json:"slug,omitempty"`
+	Name        *string           `json:"name,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	ExternalURL *string           `json:"external_url,omitempty"`
+	HTMLURL     *string           `json:"html_url,omitempty"`
+	Owner       *github.User      `json:"owner,omitempty"`
+	Permissions map[string]string `json:"permissions,omitempty"`
+	Events      []string          `json:"events,omitempty"`
+}
+
+func dataSourceGithubApp() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubAppRead,
+
+		Schema: map[string]*schema.Schema{
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"permissions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGithubAppRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	slug := d.Get("slug").(string)
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Reading GitHub App: %s", slug)
+	app := new(githubApp)
+	_, err := apiRequest(ctx, client, "GET", fmt.Sprintf("apps/%s", slug), nil, app)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(*app.ID, 10))
+	d.Set("app_id", app.ID)
+	d.Set("node_id", app.NodeID)
+	d.Set("name", app.Name)
+	d.Set("description", app.Description)
+	d.Set("owner", app.Owner.GetLogin())
+	d.Set("external_url", app.ExternalURL)
+	d.Set("html_url", app.HTMLURL)
+	d.Set("permissions", app.Permissions)
+	d.Set("events", app.Events)
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type appInstallation struct {
+	ID                  *int64            `json:"id,omitempty"`
+	AppID               *int64            `json:"app_id,omitempty"`
+	AppSlug             *string           `json:"app_slug,omitempty"`
+	TargetType          *string           `json:"target_type,omitempty"`
+	RepositorySelection *string           `json:"repository_selection,omitempty"`
+	HTMLURL             *string           `json:"html_url,omitempty"`
+	Permissions         map[string]string `json:"permissions,omitempty"`
+	Events              []string          `json:"events,omitempty"`
+	SuspendedAt         *string           `json:"suspended_at,omitempty"`
+}
+
+type appInstallations struct {
+	TotalCount    int                `json:"total_count"`
+	Installations []*appInstallation `json:"installations"`
+}
+
+func dataSourceGithubAppInstallation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubAppInstallationRead,
+
+		Schema: map[string]*schema.Schema{
+			"app_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"installation_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"app_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"repository_selection": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"permissions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"suspended": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubAppInstallationRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
+	slug := d.Get("app_slug").(string)
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Reading installation of GitHub App %s in organization: %s", slug, orgName)
+	installations, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		page := new(appInstallations)
+		resp, err := apiRequest(ctx, client, "GET",
+			fmt.Sprintf("orgs/%s/installations?%s", orgName, pageQuery(url.Values{}, opt)), nil, page)
+		return page.Installations, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, v := range installations {
+		i := v.(*appInstallation)
+		if i.AppSlug == nil || *i.AppSlug != slug {
+			continue
+		}
+
+		d.SetId(strconv.FormatInt(*i.ID, 10))
+		d.Set("installation_id", i.ID)
+		d.Set("app_id", i.AppID)
+		d.Set("repository_selection", i.RepositorySelection)
+		d.Set("html_url", i.HTMLURL)
+		d.Set("permissions", i.Permissions)
+		d.Set("events", i.Events)
+		d.Set("suspended", i.SuspendedAt != nil)
+		return nil
+	}
+
+	return fmt.Errorf("GitHub App %s is not installed in organization %s", slug, orgName)
+}
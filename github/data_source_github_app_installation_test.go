@@ -0,0 +1,37 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubAppInstallationDataSource_basic(t *testing.T) {
+	slug := os.Getenv("GITHUB_TEST_APP_SLUG")
+	if slug == "" {
+		t.Skip("GITHUB_TEST_APP_SLUG must be set to an app installed in the organization for this acceptance test")
+	}
+	dsn := "data.github_app_installation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "github_app_installation" "test" {
+  app_slug = "%s"
+}
+`, slug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsn, "installation_id"),
+					resource.TestCheckResourceAttrSet(dsn, "repository_selection"),
+				),
+			},
+		},
+	})
+}
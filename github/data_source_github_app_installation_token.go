@@ -0,0 +1,205 @@
+package github
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type installationTokenRequest struct {
+	Repositories []string          `json:"repositories,omitempty"`
+	Permissions  map[string]string `json:"permissions,omitempty"`
+}
+
+type installationToken struct {
+	Token               *string           `json:"token,omitempty"`
+	ExpiresAt           *string           `json:"expires_at,omitempty"`
+	Permissions         map[string]string `json:"permissions,omitempty"`
+	RepositorySelection *string           `json:"repository_selection,omitempty"`
+}
+
+func dataSourceGithubAppInstallationToken() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubAppInstallationTokenRead,
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"installation_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"pem": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"permissions": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"repositories": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"granted_permissions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"repository_selection": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubAppInstallationTokenRead(d *schema.ResourceData, meta interface{}) error {
+	appID := int64(d.Get("app_id").(int))
+	installationID := int64(d.Get("installation_id").(int))
+	ctx := stopContext(meta)
+
+	// The token is requested as the app rather than with the provider's own
+	// credentials
+	client, err := newAppClient(meta, appID, d.Get("pem").(string))
+	if err != nil {
+		return err
+	}
+
+	body := &installationTokenRequest{
+		Repositories: expandStringList(d.Get("repositories").(*schema.Set).List()),
+	}
+	if v, ok := d.GetOk("permissions"); ok {
+		body.Permissions = map[string]string{}
+		for k, p := range v.(map[string]interface{}) {
+			body.Permissions[k] = p.(string)
+		}
+	}
+
+	log.Printf("[DEBUG] Creating token for installation %d of GitHub App %d", installationID, appID)
+	token := new(installationToken)
+	_, err = apiRequest(ctx, client, "POST",
+		fmt.Sprintf("app/installations/%d/access_tokens", installationID), body, token)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(installationID, 10))
+	d.Set("token", token.Token)
+	d.Set("expires_at", token.ExpiresAt)
+	d.Set("granted_permissions", token.Permissions)
+	d.Set("repository_selection", token.RepositorySelection)
+
+	return nil
+}
+
+// appJWTTransport authenticates requests as a GitHub App
+type appJWTTransport struct {
+	transport http.RoundTripper
+	jwt       string
+}
+
+func (t *appJWTTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", "Bearer "+t.jwt)
+
+	return t.transport.RoundTrip(req)
+}
+
+// newAppClient returns a client authenticated as the GitHub App with the given
+// ID and PEM-encoded private key, for the same GitHub as the provider and
+// through the same transports.
+func newAppClient(meta interface{}, appID int64, privateKey string) (*github.Client, error) {
+	jwt, err := signAppJWT(appID, privateKey, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	authenticate := func(base http.RoundTripper) http.RoundTripper {
+		return &appJWTTransport{transport: base, jwt: jwt}
+	}
+	var rt http.RoundTripper
+	if transport := meta.(*Organization).transport; transport != nil {
+		rt = transport(authenticate)
+	} else {
+		rt = authenticate(http.DefaultTransport)
+	}
+
+	client := github.NewClient(&http.Client{Transport: rt})
+	client.BaseURL = meta.(*Organization).client.BaseURL
+
+	return client, nil
+}
+
+// signAppJWT returns the RS256-signed JSON Web Token a GitHub App
+// authenticates with, signed by the PEM-encoded private key of the app.
+func signAppJWT(appID int64, privateKey string, now time.Time) (string, error) {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return "", fmt.Errorf("The private key of the GitHub App is not PEM-encoded")
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		parsed, pkcs8Err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if pkcs8Err != nil {
+			return "", fmt.Errorf("Unable to parse the private key of the GitHub App: %s", err)
+		}
+		rsaKey, ok := parsed.(*rsa.PrivateKey)
+		if !ok {
+			return "", fmt.Errorf("The private key of the GitHub App is not an RSA key")
+		}
+		key = rsaKey
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	// Backdated to allow for clock drift; GitHub refuses tokens valid for
+	// more than ten minutes
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": strconv.FormatInt(appID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return unsigned + "." + enc.EncodeToString(sig), nil
+}
@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestSignAppJWT(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}))
+
+	now := time.Unix(1600000000, 0)
+	jwt, err := signAppJWT(1234, privateKey, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		t.Fatalf("Expected a JWT of 3 parts, got %d", len(parts))
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], sig); err != nil {
+		t.Fatalf("Invalid signature: %s", err)
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var claims map[string]interface{}
+	if err := json.Unmarshal(b, &claims); err != nil {
+		t.Fatal(err)
+	}
+	if claims["iss"] != "1234" {
+		t.Fatalf("Expected issuer 1234, got %v", claims["iss"])
+	}
+	if claims["exp"].(float64)-claims["iat"].(float64) > 600 {
+		t.Fatalf("Expected the JWT to be valid for at most ten minutes")
+	}
+
+	if _, err := signAppJWT(1234, "not a key", now); err == nil {
+		t.Fatal("Expected an error for an invalid private key")
+	}
+}
+
+func TestNewAppClient(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}))
+
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/app",
+			ExpectedHeaders: map[string]string{
+				"X-GitHub-Api-Version": "2022-11-28",
+			},
+			ResponseBody: `{"name": "Example App"}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	// The app is requested through the transports of the provider
+	config := Config{APIVersion: "2022-11-28"}
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{transport: config.transport}}
+
+	appClient, err := newAppClient(meta, 1234, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app, _, err := appClient.Apps.Get(context.Background(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if app.GetName() != "Example App" {
+		t.Fatalf("Expected the app, got %q", app.GetName())
+	}
+}
+
+func TestAccGithubAppInstallationTokenDataSource_basic(t *testing.T) {
+	appID := os.Getenv("GITHUB_TEST_APP_ID")
+	installationID := os.Getenv("GITHUB_TEST_APP_INSTALLATION_ID")
+	pemFile := os.Getenv("GITHUB_TEST_APP_PEM_FILE")
+	if appID == "" || installationID == "" || pemFile == "" {
+		t.Skip("GITHUB_TEST_APP_ID, GITHUB_TEST_APP_INSTALLATION_ID and GITHUB_TEST_APP_PEM_FILE must be set for this acceptance test")
+	}
+	pemBytes, err := ioutil.ReadFile(pemFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dsn := "data.github_app_installation_token.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "github_app_installation_token" "test" {
+  app_id          = %s
+  installation_id = %s
+  pem             = <<EOF
+%s
+EOF
+
+  permissions = {
+    contents = "read"
+  }
+}
+`, appID, installationID, strings.TrimSpace(string(pemBytes))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsn, "token"),
+					resource.TestCheckResourceAttrSet(dsn, "expires_at"),
+					resource.TestCheckResourceAttr(dsn, "granted_permissions.contents", "read"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,30 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubAppDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "github_app" "test" {
+  slug = "github-actions"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.github_app.test", "app_id"),
+					resource.TestCheckResourceAttrSet("data.github_app.test", "node_id"),
+					resource.TestCheckResourceAttr("data.github_app.test", "owner", "github"),
+				),
+			},
+		},
+	})
+}
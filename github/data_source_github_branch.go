@@ -0,0 +1,54 @@
+package github
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubBranch() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubBranchRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"branch": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sha": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubBranchRead(d *schema.ResourceData, meta interface{}) error {
+	ref := "heads/" + d.Get("branch").(string)
+
+	resolved, err := resolveGithubRef(d, meta, ref)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resolved.ID)
+	d.Set("ref", "refs/"+ref)
+	d.Set("sha", resolved.SHA)
+	d.Set("node_id", resolved.NodeID)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubBranchDataSource_basic(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-branch-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubBranchDataSourceConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.github_branch.test", "ref", "refs/heads/master"),
+					resource.TestMatchResourceAttr("data.github_branch.test", "sha", regexp.MustCompile("^[0-9a-f]{40}$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubBranchDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+data "github_branch" "test" {
+  repository = "${github_repository.test.name}"
+  branch     = "master"
+}
+`, repoName)
+}
@@ -0,0 +1,208 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type codeScanningAlert struct {
+	Number    int    `json:"number"`
+	State     string `json:"state"`
+	HTMLURL   string `json:"html_url"`
+	CreatedAt string `json:"created_at"`
+	Rule      struct {
+		ID                    string `json:"id"`
+		Severity              string `json:"severity"`
+		SecuritySeverityLevel string `json:"security_severity_level"`
+		Description           string `json:"description"`
+	} `json:"rule"`
+	Tool struct {
+		Name string `json:"name"`
+	} `json:"tool"`
+	MostRecentInstance struct {
+		Ref      string `json:"ref"`
+		Location struct {
+			Path      string `json:"path"`
+			StartLine int    `json:"start_line"`
+		} `json:"location"`
+	} `json:"most_recent_instance"`
+	// Only set in the listing of the organization
+	Repository *struct {
+		Name string `json:"name"`
+	} `json:"repository"`
+}
+
+func dataSourceGithubCodeScanningAlerts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubCodeScanningAlertsRead,
+
+		Schema: map[string]*schema.Schema{
+			// Lists the alerts of every repository of the organization
+			// unless a repository is given
+			"repository": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "open",
+				ValidateFunc: validateValueFunc([]string{"open", "closed", "dismissed", "fixed"}),
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateValueFunc([]string{"critical", "high", "medium", "low", "warning", "note", "error"}),
+			},
+			"tool_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_results": maxResultsSchema(),
+			// Fails the read, and with it the plan, when any alert matches
+			"fail_on_alerts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"alerts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"repository": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_severity_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tool_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ref": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_line": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubCodeScanningAlertsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	alertsURL := fmt.Sprintf("orgs/%s/code-scanning/alerts", owner)
+	id := owner
+	repoName, isRepository := d.GetOk("repository")
+	if isRepository {
+		alertsURL = fmt.Sprintf("repos/%s/%s/code-scanning/alerts", owner, repoName.(string))
+		id = fmt.Sprintf("%s/%s", owner, repoName.(string))
+	} else {
+		err := checkOrganization(meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	query := url.Values{}
+	query.Set("state", d.Get("state").(string))
+	for _, key := range []string{"severity", "tool_name", "ref"} {
+		if v, ok := d.GetOk(key); ok {
+			query.Set(key, v.(string))
+		}
+	}
+
+	log.Printf("[DEBUG] Reading code scanning alerts: %s (%s)", id, query.Get("state"))
+	result, err := listPages(meta, d.Get("max_results").(int), func(opt listOptions) (interface{}, *github.Response, error) {
+		var alerts []*codeScanningAlert
+		resp, err := apiRequest(ctx, client, "GET", alertsURL+"?"+pageQuery(query, opt), nil, &alerts)
+		return alerts, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	alerts := []interface{}{}
+	for _, v := range result {
+		a := v.(*codeScanningAlert)
+		alertRepo := repoName
+		if a.Repository != nil {
+			alertRepo = a.Repository.Name
+		}
+		alerts = append(alerts, map[string]interface{}{
+			"number":                  a.Number,
+			"repository":              alertRepo,
+			"state":                   a.State,
+			"rule_id":                 a.Rule.ID,
+			"rule_description":        a.Rule.Description,
+			"severity":                a.Rule.Severity,
+			"security_severity_level": a.Rule.SecuritySeverityLevel,
+			"tool_name":               a.Tool.Name,
+			"ref":                     a.MostRecentInstance.Ref,
+			"path":                    a.MostRecentInstance.Location.Path,
+			"start_line":              a.MostRecentInstance.Location.StartLine,
+			"html_url":                a.HTMLURL,
+			"created_at":              a.CreatedAt,
+		})
+	}
+
+	if d.Get("fail_on_alerts").(bool) && len(alerts) > 0 {
+		return fmt.Errorf("Found %d code scanning alerts of %s matching the filters", len(alerts), id)
+	}
+
+	d.SetId(id)
+	d.Set("alerts", alerts)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGithubCodeScanningAlertsRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/example/api/code-scanning/alerts?page=1&per_page=100&severity=critical&state=open",
+			StatusCode:  200,
+			ResponseBody: `[{"number": 4, "state": "open", "html_url": "https://github.com/example/api/security/code-scanning/4",
+				"rule": {"id": "js/sql-injection", "severity": "error", "security_severity_level": "critical", "description": "Database query built from user-controlled sources"},
+				"tool": {"name": "CodeQL"},
+				"most_recent_instance": {"ref": "refs/heads/main", "location": {"path": "src/db.js", "start_line": 42}}}]`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubCodeScanningAlerts().Schema, map[string]interface{}{
+		"repository": "api",
+		"severity":   "critical",
+	})
+	if err := dataSourceGithubCodeScanningAlertsRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "example/api" {
+		t.Fatalf("Expected the repository as ID, got %q", d.Id())
+	}
+	if v := d.Get("alerts.0.repository"); v != "api" {
+		t.Fatalf("Expected the alert of the api repository, got %v", v)
+	}
+	if v := d.Get("alerts.0.start_line"); v != 42 {
+		t.Fatalf("Expected the line of the alert, got %v", v)
+	}
+}
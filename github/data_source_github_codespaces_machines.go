@@ -0,0 +1,125 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type codespacesMachine struct {
+	Name                 *string `json:"name,omitempty"`
+	DisplayName          *string `json:"display_name,omitempty"`
+	OperatingSystem      *string `json:"operating_system,omitempty"`
+	StorageInBytes       *int64  `json:"storage_in_bytes,omitempty"`
+	MemoryInBytes        *int64  `json:"memory_in_bytes,omitempty"`
+	CPUs                 *int    `json:"cpus,omitempty"`
+	PrebuildAvailability *string `json:"prebuild_availability,omitempty"`
+}
+
+type codespacesMachines struct {
+	TotalCount int                  `json:"total_count"`
+	Machines   []*codespacesMachine `json:"machines"`
+}
+
+func dataSourceGithubCodespacesMachines() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubCodespacesMachinesRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"machines": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operating_system": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_in_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_in_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"prebuild_availability": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubCodespacesMachinesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	query := url.Values{}
+	if v, ok := d.GetOk("location"); ok {
+		query.Set("location", v.(string))
+	}
+	if v, ok := d.GetOk("ref"); ok {
+		query.Set("ref", v.(string))
+	}
+	u := fmt.Sprintf("repos/%s/%s/codespaces/machines", owner, repoName)
+	if len(query) > 0 {
+		u = u + "?" + query.Encode()
+	}
+
+	log.Printf("[DEBUG] Reading Codespaces machine types: %s/%s", owner, repoName)
+	machines := new(codespacesMachines)
+	_, err := apiRequest(ctx, client, "GET", u, nil, machines)
+	if err != nil {
+		return err
+	}
+
+	result := make([]interface{}, 0, len(machines.Machines))
+	for _, m := range machines.Machines {
+		result = append(result, map[string]interface{}{
+			"name":                  m.Name,
+			"display_name":          m.DisplayName,
+			"operating_system":      m.OperatingSystem,
+			"cpus":                  m.CPUs,
+			"memory_in_bytes":       m.MemoryInBytes,
+			"storage_in_bytes":      m.StorageInBytes,
+			"prebuild_availability": m.PrebuildAvailability,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repoName))
+	d.Set("machines", result)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubCodespacesMachinesDataSource_basic(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-machines-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+data "github_codespaces_machines" "test" {
+  repository = "${github_repository.test.name}"
+}
+`, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.github_codespaces_machines.test", "machines.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package github
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubCodespacesPublicKey() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubCodespacesPublicKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"user"},
+			},
+			"user": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubCodespacesPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
+	// The key of the secrets of the authenticated user
+	if d.Get("user").(bool) {
+		return fetchSecretsPublicKey(d, meta, codespacesUserSecretsURL+"/public-key", "user")
+	}
+
+	return readSecretsPublicKey(d, meta, "codespaces")
+}
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-github/v28/github"
@@ -16,7 +15,8 @@ func dataSourceGithubCollaborators() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"owner": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"repository": {
 				Type:     schema.TypeString,
@@ -32,6 +32,7 @@ func dataSourceGithubCollaborators() *schema.Resource {
 				Optional: true,
 				Default:  "all",
 			},
+			"max_results": maxResultsSchema(),
 			"collaborator": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -101,6 +102,34 @@ func dataSourceGithubCollaborators() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"permissions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"admin": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"maintain": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"push": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"triage": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"pull": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -111,42 +140,37 @@ func dataSourceGithubCollaborators() *schema.Resource {
 func dataSourceGithubCollaboratorsRead(d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*Organization).client
-	ctx := context.Background()
+	ctx := stopContext(meta)
 
-	owner := d.Get("owner").(string)
+	owner := meta.(*Organization).name
+	if v, ok := d.GetOk("owner"); ok {
+		owner = v.(string)
+	}
 	repo := d.Get("repository").(string)
 	affiliation := d.Get("affiliation").(string)
 
-	options := &github.ListCollaboratorsOptions{
-		Affiliation: affiliation,
-		ListOptions: github.ListOptions{
-			PerPage: maxPerPage,
-		},
-	}
-
 	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repo, affiliation))
 	d.Set("owner", owner)
 	d.Set("repository", repo)
 	d.Set("affiliation", affiliation)
 
-	totalCollaborators := make([]interface{}, 0)
-	for {
-		collaborators, resp, err := client.Repositories.ListCollaborators(ctx, owner, repo, options)
-		if err != nil {
-			return err
-		}
-
-		result, err := flattenGitHubCollaborators(collaborators)
-		if err != nil {
-			return fmt.Errorf("unable to flatten GitHub Collaborators (Owner: %q/Repository: %q) : %+v", owner, repo, err)
-		}
-
-		totalCollaborators = append(totalCollaborators, result...)
+	users, err := listPages(meta, d.Get("max_results").(int), func(opt listOptions) (interface{}, *github.Response, error) {
+		return client.Repositories.ListCollaborators(ctx, owner, repo, &github.ListCollaboratorsOptions{
+			Affiliation: affiliation,
+			ListOptions: opt.ListOptions,
+		})
+	})
+	if err != nil {
+		return err
+	}
 
-		if resp.NextPage == 0 {
-			break
-		}
-		options.Page = resp.NextPage
+	collaborators := make([]*github.User, 0, len(users))
+	for _, v := range users {
+		collaborators = append(collaborators, v.(*github.User))
+	}
+	totalCollaborators, err := flattenGitHubCollaborators(collaborators)
+	if err != nil {
+		return fmt.Errorf("unable to flatten GitHub Collaborators (Owner: %q/Repository: %q) : %+v", owner, repo, err)
 	}
 
 	d.Set("collaborator", totalCollaborators)
@@ -186,8 +210,28 @@ func flattenGitHubCollaborators(collaborators []*github.User) ([]interface{}, er
 		}
 
 		result["permission"] = permissionName
+		result["permissions"] = flattenCollaboratorPermissions(c.Permissions)
 		results = append(results, result)
 	}
 
 	return results, nil
 }
+
+// flattenCollaboratorPermissions returns every permission level reported for
+// a collaborator, including the maintain and triage roles which are not
+// reflected in the permission attribute.
+func flattenCollaboratorPermissions(p *map[string]bool) []interface{} {
+	if p == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"admin":    (*p)[adminPermission],
+			"maintain": (*p)["maintain"],
+			"push":     (*p)[pushPermission],
+			"triage":   (*p)["triage"],
+			"pull":     (*p)[pullPermission],
+		},
+	}
+}
@@ -23,6 +23,9 @@ func TestAccGithubCollaboratorsDataSource_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(dsn, "collaborator.#"),
 					resource.TestCheckResourceAttr(dsn, "affiliation", "all"),
+					resource.TestCheckResourceAttr(dsn, "collaborator.0.permission", "admin"),
+					resource.TestCheckResourceAttr(dsn, "collaborator.0.permissions.0.admin", "true"),
+					resource.TestCheckResourceAttr(dsn, "collaborator.0.permissions.0.pull", "true"),
 				),
 			},
 		},
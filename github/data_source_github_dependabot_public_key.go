@@ -0,0 +1,30 @@
+package github
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubDependabotPublicKey() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubDependabotPublicKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubDependabotPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
+	return readSecretsPublicKey(d, meta, "dependabot")
+}
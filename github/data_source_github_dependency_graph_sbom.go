@@ -0,0 +1,149 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type dependencyGraphSBOM struct {
+	SBOM json.RawMessage `json:"sbom"`
+}
+
+// spdxDocument holds the fields of the SPDX document of the dependency graph
+// which are exported one by one; the whole document is kept as JSON.
+type spdxDocument struct {
+	SPDXID       string `json:"SPDXID"`
+	SPDXVersion  string `json:"spdxVersion"`
+	Name         string `json:"name"`
+	CreationInfo struct {
+		Created string `json:"created"`
+	} `json:"creationInfo"`
+	Packages []struct {
+		SPDXID           string `json:"SPDXID"`
+		Name             string `json:"name"`
+		VersionInfo      string `json:"versionInfo"`
+		LicenseConcluded string `json:"licenseConcluded"`
+		LicenseDeclared  string `json:"licenseDeclared"`
+		ExternalRefs     []struct {
+			ReferenceType    string `json:"referenceType"`
+			ReferenceLocator string `json:"referenceLocator"`
+		} `json:"externalRefs"`
+	} `json:"packages"`
+}
+
+func dataSourceGithubDependencyGraphSBOM() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubDependencyGraphSBOMRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sbom": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spdx_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spdx_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"packages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"spdx_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_concluded": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_declared": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"purl": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubDependencyGraphSBOMRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Reading dependency graph SBOM: %s/%s", owner, repoName)
+	result := new(dependencyGraphSBOM)
+	_, err := apiRequest(ctx, client, "GET", fmt.Sprintf("repos/%s/%s/dependency-graph/sbom", owner, repoName), nil, result)
+	if err != nil {
+		return err
+	}
+
+	var doc spdxDocument
+	err = json.Unmarshal(result.SBOM, &doc)
+	if err != nil {
+		return err
+	}
+
+	packages := make([]interface{}, 0, len(doc.Packages))
+	for _, p := range doc.Packages {
+		purl := ""
+		for _, ref := range p.ExternalRefs {
+			if ref.ReferenceType == "purl" {
+				purl = ref.ReferenceLocator
+				break
+			}
+		}
+		packages = append(packages, map[string]interface{}{
+			"spdx_id":           p.SPDXID,
+			"name":              p.Name,
+			"version":           p.VersionInfo,
+			"license_concluded": p.LicenseConcluded,
+			"license_declared":  p.LicenseDeclared,
+			"purl":              purl,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repoName))
+	d.Set("sbom", string(result.SBOM))
+	d.Set("spdx_id", doc.SPDXID)
+	d.Set("spdx_version", doc.SPDXVersion)
+	d.Set("name", doc.Name)
+	d.Set("created_at", doc.CreationInfo.Created)
+	d.Set("packages", packages)
+
+	return nil
+}
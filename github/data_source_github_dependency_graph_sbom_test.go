@@ -0,0 +1,50 @@
+package github
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGithubDependencyGraphSBOMRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/example/api/dependency-graph/sbom",
+			StatusCode:  200,
+			ResponseBody: `{"sbom": {"SPDXID": "SPDXRef-DOCUMENT", "spdxVersion": "SPDX-2.3", "name": "com.github.example/api",
+				"creationInfo": {"created": "2020-01-01T00:00:00Z"},
+				"packages": [{"SPDXID": "SPDXRef-npm-lodash-4.17.21", "name": "npm:lodash", "versionInfo": "4.17.21",
+					"licenseConcluded": "MIT", "externalRefs": [{"referenceCategory": "PACKAGE-MANAGER",
+					"referenceType": "purl", "referenceLocator": "pkg:npm/lodash@4.17.21"}]}]}}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubDependencyGraphSBOM().Schema, map[string]interface{}{
+		"repository": "api",
+	})
+	if err := dataSourceGithubDependencyGraphSBOMRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := d.Get("spdx_version"); v != "SPDX-2.3" {
+		t.Fatalf("Unexpected SPDX version %v", v)
+	}
+	if v := d.Get("packages.0.purl"); v != "pkg:npm/lodash@4.17.21" {
+		t.Fatalf("Expected the package URL of lodash, got %v", v)
+	}
+	if v := d.Get("packages.0.license_concluded"); v != "MIT" {
+		t.Fatalf("Expected the license of lodash, got %v", v)
+	}
+	var sbom map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("sbom").(string)), &sbom); err != nil || sbom["SPDXID"] != "SPDXRef-DOCUMENT" {
+		t.Fatalf("Expected the whole SPDX document, got %v (%v)", d.Get("sbom"), err)
+	}
+}
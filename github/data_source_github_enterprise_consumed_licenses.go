@@ -0,0 +1,119 @@
+package github
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type enterpriseConsumedLicenses struct {
+	TotalSeatsConsumed  int                              `json:"total_seats_consumed"`
+	TotalSeatsPurchased int                              `json:"total_seats_purchased"`
+	Users               []*enterpriseConsumedLicenseUser `json:"users"`
+}
+
+type enterpriseConsumedLicenseUser struct {
+	GithubComLogin               string `json:"github_com_login"`
+	GithubComName                string `json:"github_com_name"`
+	LicenseType                  string `json:"license_type"`
+	GithubComUser                bool   `json:"github_com_user"`
+	EnterpriseServerUser         bool   `json:"enterprise_server_user"`
+	VisualStudioSubscriptionUser bool   `json:"visual_studio_subscription_user"`
+}
+
+func dataSourceGithubEnterpriseConsumedLicenses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubEnterpriseConsumedLicensesRead,
+
+		Schema: map[string]*schema.Schema{
+			"enterprise": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"total_seats_consumed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_seats_purchased": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"login": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"github_com_user": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enterprise_server_user": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"visual_studio_subscription_user": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubEnterpriseConsumedLicensesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	enterprise := d.Get("enterprise").(string)
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Reading consumed licenses of enterprise: %s", enterprise)
+	// Every page repeats the totals, which are kept from the first one
+	var licenses *enterpriseConsumedLicenses
+	result, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		page := new(enterpriseConsumedLicenses)
+		resp, err := apiRequest(ctx, client, "GET",
+			fmt.Sprintf("enterprises/%s/consumed-licenses?page=%d&per_page=%d", enterprise, opt.Page, opt.PerPage), nil, page)
+		if opt.Page == 1 {
+			licenses = page
+		}
+		return page.Users, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	users := []interface{}{}
+	for _, v := range result {
+		u := v.(*enterpriseConsumedLicenseUser)
+		users = append(users, map[string]interface{}{
+			"login":                           u.GithubComLogin,
+			"name":                            u.GithubComName,
+			"license_type":                    u.LicenseType,
+			"github_com_user":                 u.GithubComUser,
+			"enterprise_server_user":          u.EnterpriseServerUser,
+			"visual_studio_subscription_user": u.VisualStudioSubscriptionUser,
+		})
+	}
+
+	d.SetId(enterprise)
+	d.Set("total_seats_consumed", licenses.TotalSeatsConsumed)
+	d.Set("total_seats_purchased", licenses.TotalSeatsPurchased)
+	d.Set("users", users)
+
+	return nil
+}
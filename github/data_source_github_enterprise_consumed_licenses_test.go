@@ -0,0 +1,51 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGithubEnterpriseConsumedLicensesRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/enterprises/acme/consumed-licenses?page=1&per_page=100",
+			StatusCode:  200,
+			ResponseHeaders: map[string]string{
+				"Link": `<https://api.github.com/enterprises/acme/consumed-licenses?page=2&per_page=100>; rel="next"`,
+			},
+			ResponseBody: `{"total_seats_consumed": 2, "total_seats_purchased": 10, "users": [
+				{"github_com_login": "alice", "github_com_name": "Alice", "license_type": "enterprise", "github_com_user": true}]}`,
+		},
+		{
+			ExpectedUri: "/enterprises/acme/consumed-licenses?page=2&per_page=100",
+			StatusCode:  200,
+			ResponseBody: `{"total_seats_consumed": 2, "total_seats_purchased": 10, "users": [
+				{"github_com_login": "bob", "license_type": "enterprise", "enterprise_server_user": true}]}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubEnterpriseConsumedLicenses().Schema, map[string]interface{}{
+		"enterprise": "acme",
+	})
+	if err := dataSourceGithubEnterpriseConsumedLicensesRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := d.Get("total_seats_purchased"); v != 10 {
+		t.Fatalf("Unexpected purchased seats %v", v)
+	}
+	if n := d.Get("users.#").(int); n != 2 {
+		t.Fatalf("Expected the users of both pages, got %d", n)
+	}
+	if !d.Get("users.1.enterprise_server_user").(bool) {
+		t.Fatal("Expected bob to be a user of an Enterprise Server")
+	}
+}
@@ -0,0 +1,97 @@
+package github
+
+import (
+	"encoding/json"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// licenseSeats is a number of seats, or "unlimited", which is read as -1.
+type licenseSeats int
+
+func (s *licenseSeats) UnmarshalJSON(data []byte) error {
+	var unlimited string
+	if json.Unmarshal(data, &unlimited) == nil {
+		*s = -1
+		if n, err := strconv.Atoi(unlimited); err == nil {
+			*s = licenseSeats(n)
+		}
+		return nil
+	}
+
+	var n int
+	err := json.Unmarshal(data, &n)
+	*s = licenseSeats(n)
+	return err
+}
+
+type enterpriseServerLicense struct {
+	Seats               licenseSeats `json:"seats"`
+	SeatsUsed           int          `json:"seats_used"`
+	SeatsAvailable      licenseSeats `json:"seats_available"`
+	Kind                string       `json:"kind"`
+	DaysUntilExpiration int          `json:"days_until_expiration"`
+	ExpireAt            string       `json:"expire_at"`
+}
+
+func dataSourceGithubEnterpriseLicense() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubEnterpriseLicenseRead,
+
+		Schema: map[string]*schema.Schema{
+			"seats": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"seats_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"seats_available": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"days_until_expiration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubEnterpriseLicenseRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Reading license of Enterprise Server: %s", client.BaseURL.Host)
+	license := new(enterpriseServerLicense)
+	_, err = apiRequest(ctx, client, "GET", "enterprise/settings/license", nil, license)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(client.BaseURL.Host)
+	d.Set("seats", int(license.Seats))
+	d.Set("seats_used", license.SeatsUsed)
+	d.Set("seats_available", int(license.SeatsAvailable))
+	d.Set("kind", license.Kind)
+	d.Set("days_until_expiration", license.DaysUntilExpiration)
+	d.Set("expires_at", license.ExpireAt)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGithubEnterpriseLicenseRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/enterprise/settings/license",
+			StatusCode:  200,
+			ResponseBody: `{"seats": 1400, "seats_used": 1316, "seats_available": 84, "kind": "standard",
+				"days_until_expiration": 365, "expire_at": "2021-01-01T00:00:00-08:00"}`,
+		},
+		{
+			ExpectedUri:  "/enterprise/settings/license",
+			StatusCode:   200,
+			ResponseBody: `{"seats": "unlimited", "seats_used": 1316, "seats_available": "unlimited", "kind": "standard"}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{enterpriseServer: true}}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubEnterpriseLicense().Schema, map[string]interface{}{})
+	if err := dataSourceGithubEnterpriseLicenseRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Get("seats_available"); v != 84 {
+		t.Fatalf("Unexpected available seats %v", v)
+	}
+
+	// Unlimited seats are read as -1
+	if err := dataSourceGithubEnterpriseLicenseRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Get("seats"); v != -1 {
+		t.Fatalf("Expected unlimited seats, got %v", v)
+	}
+}
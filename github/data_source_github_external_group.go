@@ -0,0 +1,95 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubExternalGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubExternalGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"team_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceGithubExternalGroupRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
+	name := d.Get("group_name").(string)
+	ctx := stopContext(meta)
+
+	// The display name filter matches substrings, so the exact name is
+	// looked for among the results
+	log.Printf("[DEBUG] Finding external group %s of organization: %s", name, orgName)
+	query := url.Values{}
+	query.Set("display_name", name)
+	groups, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		page := new(externalGroups)
+		resp, err := apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/external-groups?%s", orgName, pageQuery(query, opt)), nil, page)
+		return page.Groups, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	var match *externalGroup
+	for _, v := range groups {
+		if g := v.(*externalGroup); g.GroupName != nil && *g.GroupName == name {
+			match = g
+			break
+		}
+	}
+	if match == nil {
+		return fmt.Errorf("Could not find external group %s in organization %s", name, orgName)
+	}
+
+	// Only the group itself lists the teams it is mapped to
+	group := new(externalGroup)
+	_, err = apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/external-group/%d", orgName, *match.GroupID), nil, group)
+	if err != nil {
+		return err
+	}
+
+	teamIDs := []int64{}
+	for _, t := range group.Teams {
+		if t.TeamID != nil {
+			teamIDs = append(teamIDs, *t.TeamID)
+		}
+	}
+
+	d.SetId(strconv.FormatInt(*group.GroupID, 10))
+	d.Set("group_id", group.GroupID)
+	d.Set("updated_at", group.UpdatedAt)
+	d.Set("team_ids", teamIDs)
+
+	return nil
+}
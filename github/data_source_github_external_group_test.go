@@ -0,0 +1,37 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubExternalGroupDataSource_basic(t *testing.T) {
+	groupName := os.Getenv("GITHUB_TEST_EXTERNAL_GROUP_NAME")
+	if groupName == "" {
+		t.Skip("GITHUB_TEST_EXTERNAL_GROUP_NAME must be set for this acceptance test")
+	}
+	dsn := "data.github_external_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "github_external_group" "test" {
+  group_name = "%s"
+}
+`, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsn, "group_id"),
+					resource.TestCheckResourceAttrSet(dsn, "updated_at"),
+				),
+			},
+		},
+	})
+}
@@ -1,61 +1,104 @@
 package github
 
 import (
+	"log"
+	"net"
+
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func dataSourceGithubIpRanges() *schema.Resource {
-	return &schema.Resource{
-		Read: dataSourceGithubIpRangesRead,
+// githubMeta is the response of the meta API; the vendored go-github library
+// only knows about some of its fields.
+type githubMeta struct {
+	InstalledVersion *string  `json:"installed_version,omitempty"`
+	Hooks            []string `json:"hooks,omitempty"`
+	Web              []string `json:"web,omitempty"`
+	API              []string `json:"api,omitempty"`
+	Git              []string `json:"git,omitempty"`
+	Pages            []string `json:"pages,omitempty"`
+	Importer         []string `json:"importer,omitempty"`
+	Actions          []string `json:"actions,omitempty"`
+	Dependabot       []string `json:"dependabot,omitempty"`
+}
 
-		Schema: map[string]*schema.Schema{
-			"hooks": {
-				Type:     schema.TypeList,
-				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-			},
-			"git": {
-				Type:     schema.TypeList,
-				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-			},
-			"pages": {
-				Type:     schema.TypeList,
-				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-			},
-			"importer": {
+func (m *githubMeta) GetInstalledVersion() string {
+	if m == nil || m.InstalledVersion == nil {
+		return ""
+	}
+	return *m.InstalledVersion
+}
+
+func dataSourceGithubIpRanges() *schema.Resource {
+	s := map[string]*schema.Schema{}
+	for _, k := range []string{"hooks", "web", "api", "git", "pages", "importer", "actions", "dependabot"} {
+		for _, suffix := range []string{"", "_ipv4", "_ipv6"} {
+			s[k+suffix] = &schema.Schema{
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
-			},
-		},
+			}
+		}
+	}
+
+	return &schema.Resource{
+		Read: dataSourceGithubIpRangesRead,
+
+		Schema: s,
 	}
 }
 
 func dataSourceGithubIpRangesRead(d *schema.ResourceData, meta interface{}) error {
 	org := meta.(*Organization)
 
-	api, _, err := org.client.APIMeta(org.StopContext)
+	log.Printf("[DEBUG] Reading GitHub IP ranges")
+	api := new(githubMeta)
+	_, err := apiRequest(org.StopContext, org.client, "GET", "meta", nil, api)
 	if err != nil {
 		return err
 	}
 
-	if len(api.Hooks)+len(api.Git)+len(api.Pages)+len(api.Importer) > 0 {
-		d.SetId("github-ip-ranges")
-	}
-	if len(api.Hooks) > 0 {
-		d.Set("hooks", api.Hooks)
-	}
-	if len(api.Git) > 0 {
-		d.Set("git", api.Git)
+	ranges := map[string][]string{
+		"hooks":      api.Hooks,
+		"web":        api.Web,
+		"api":        api.API,
+		"git":        api.Git,
+		"pages":      api.Pages,
+		"importer":   api.Importer,
+		"actions":    api.Actions,
+		"dependabot": api.Dependabot,
 	}
-	if len(api.Pages) > 0 {
-		d.Set("pages", api.Pages)
-	}
-	if len(api.Importer) > 0 {
-		d.Set("importer", api.Importer)
+
+	d.SetId("github-ip-ranges")
+	for k, cidrs := range ranges {
+		ipv4, ipv6, err := splitIpRanges(cidrs)
+		if err != nil {
+			return err
+		}
+
+		d.Set(k, cidrs)
+		d.Set(k+"_ipv4", ipv4)
+		d.Set(k+"_ipv6", ipv6)
 	}
 
 	return nil
 }
+
+// splitIpRanges separates a list of CIDR blocks into its IPv4 and IPv6 blocks.
+func splitIpRanges(cidrs []string) ([]string, []string, error) {
+	ipv4 := []string{}
+	ipv6 := []string{}
+	for _, cidr := range cidrs {
+		ip, _, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if ip.To4() != nil {
+			ipv4 = append(ipv4, cidr)
+		} else {
+			ipv6 = append(ipv6, cidr)
+		}
+	}
+
+	return ipv4, ipv6, nil
+}
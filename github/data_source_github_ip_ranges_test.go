@@ -1,6 +1,7 @@
 package github
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -22,8 +23,30 @@ func TestAccGithubIpRangesDataSource_existing(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.github_ip_ranges.test", "git.#"),
 					resource.TestCheckResourceAttrSet("data.github_ip_ranges.test", "pages.#"),
 					resource.TestCheckResourceAttrSet("data.github_ip_ranges.test", "importer.#"),
+					resource.TestCheckResourceAttrSet("data.github_ip_ranges.test", "web.#"),
+					resource.TestCheckResourceAttrSet("data.github_ip_ranges.test", "api.#"),
+					resource.TestCheckResourceAttrSet("data.github_ip_ranges.test", "actions.#"),
+					resource.TestCheckResourceAttrSet("data.github_ip_ranges.test", "hooks_ipv4.#"),
+					resource.TestCheckResourceAttrSet("data.github_ip_ranges.test", "hooks_ipv6.#"),
 				),
 			},
 		},
 	})
 }
+
+func TestSplitIpRanges(t *testing.T) {
+	ipv4, ipv6, err := splitIpRanges([]string{"192.30.252.0/22", "2a0a:a440::/29", "140.82.112.0/20"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ipv4, []string{"192.30.252.0/22", "140.82.112.0/20"}) {
+		t.Fatalf("Unexpected IPv4 ranges: %v", ipv4)
+	}
+	if !reflect.DeepEqual(ipv6, []string{"2a0a:a440::/29"}) {
+		t.Fatalf("Unexpected IPv6 ranges: %v", ipv6)
+	}
+
+	if _, _, err := splitIpRanges([]string{"not-a-cidr"}); err == nil {
+		t.Fatal("Expected an error for an invalid CIDR block")
+	}
+}
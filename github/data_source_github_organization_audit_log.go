@@ -0,0 +1,185 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// auditLogEvent holds the fields every audit log event has; the other fields
+// depend on its action and are only kept in its raw JSON.
+type auditLogEvent struct {
+	DocumentID string `json:"_document_id"`
+	Timestamp  int64  `json:"@timestamp"`
+	Action     string `json:"action"`
+	Actor      string `json:"actor"`
+	User       string `json:"user"`
+	Repo       string `json:"repo"`
+	Org        string `json:"org"`
+}
+
+func dataSourceGithubOrganizationAuditLog() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationAuditLogRead,
+
+		Schema: map[string]*schema.Schema{
+			// Lists the audit log of the enterprise instead
+			"enterprise": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"phrase": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"created_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"created_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"include": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "web",
+				ValidateFunc: validateValueFunc([]string{"web", "git", "all"}),
+			},
+			"order": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "desc",
+				ValidateFunc: validateValueFunc([]string{"asc", "desc"}),
+			},
+			"max_results": maxResultsSchema(),
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"document_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"actor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repository": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organization": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"raw": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// auditLogPhrase adds the time range of d to its search phrase.
+func auditLogPhrase(d *schema.ResourceData) string {
+	terms := []string{}
+	if v, ok := d.GetOk("phrase"); ok {
+		terms = append(terms, v.(string))
+	}
+	if v, ok := d.GetOk("created_after"); ok {
+		terms = append(terms, "created:>="+v.(string))
+	}
+	if v, ok := d.GetOk("created_before"); ok {
+		terms = append(terms, "created:<="+v.(string))
+	}
+
+	return strings.Join(terms, " ")
+}
+
+func dataSourceGithubOrganizationAuditLogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	ctx := stopContext(meta)
+
+	var baseURL, id string
+	if enterprise, ok := d.GetOk("enterprise"); ok {
+		baseURL = fmt.Sprintf("enterprises/%s/audit-log", enterprise.(string))
+		id = "enterprises/" + enterprise.(string)
+	} else {
+		err := checkOrganization(meta)
+		if err != nil {
+			return err
+		}
+		baseURL = fmt.Sprintf("orgs/%s/audit-log", meta.(*Organization).name)
+		id = meta.(*Organization).name
+	}
+
+	query := url.Values{}
+	phrase := auditLogPhrase(d)
+	if phrase != "" {
+		query.Set("phrase", phrase)
+	}
+	query.Set("include", d.Get("include").(string))
+	query.Set("order", d.Get("order").(string))
+
+	log.Printf("[DEBUG] Reading audit log: %s (%q)", id, phrase)
+	result, err := listPages(meta, d.Get("max_results").(int), func(opt listOptions) (interface{}, *github.Response, error) {
+		var page []json.RawMessage
+		resp, err := apiRequest(ctx, client, "GET", baseURL+"?"+cursorQuery(query, opt), nil, &page)
+		return page, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	events := make([]interface{}, 0, len(result))
+	for _, v := range result {
+		raw := v.(json.RawMessage)
+		var e auditLogEvent
+		err := json.Unmarshal(raw, &e)
+		if err != nil {
+			return err
+		}
+
+		events = append(events, map[string]interface{}{
+			"document_id":  e.DocumentID,
+			"action":       e.Action,
+			"actor":        e.Actor,
+			"user":         e.User,
+			"repository":   e.Repo,
+			"organization": e.Org,
+			"created_at":   time.Unix(0, e.Timestamp*int64(time.Millisecond)).UTC().Format(time.RFC3339),
+			"raw":          string(raw),
+		})
+	}
+
+	d.SetId(id)
+	d.Set("events", events)
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccGithubOrganizationAuditLogDataSource_basic(t *testing.T) {
+	dsn := "data.github_organization_audit_log.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "github_organization_audit_log" "test" {
+  phrase      = "action:org"
+  max_results = 5
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsn, "events.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestGithubOrganizationAuditLogRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/orgs/example/audit-log?include=all&order=desc&per_page=100" +
+				"&phrase=action%3Arepo.create+created%3A%3E%3D2020-01-01T00%3A00%3A00Z",
+			StatusCode: 200,
+			ResponseHeaders: map[string]string{
+				"Link": `<https://api.github.com/organizations/1/audit-log?after=MS4yMw%3D%3D&before=>; rel="next"`,
+			},
+			ResponseBody: `[{"@timestamp": 1577836800000, "_document_id": "a1", "action": "repo.create",
+  "actor": "alice", "repo": "example/one", "org": "example", "visibility": "private"}]`,
+		},
+		{
+			ExpectedUri: "/orgs/example/audit-log?after=MS4yMw%3D%3D&include=all&order=desc&per_page=100" +
+				"&phrase=action%3Arepo.create+created%3A%3E%3D2020-01-01T00%3A00%3A00Z",
+			StatusCode: 200,
+			ResponseBody: `[{"@timestamp": 1577836801000, "_document_id": "b2", "action": "repo.create",
+  "actor": "bob", "repo": "example/two", "org": "example"}]`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubOrganizationAuditLog().Schema, map[string]interface{}{
+		"phrase":        "action:repo.create",
+		"created_after": "2020-01-01T00:00:00Z",
+		"include":       "all",
+	})
+	if err := dataSourceGithubOrganizationAuditLogRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	events := d.Get("events").([]interface{})
+	if len(events) != 2 {
+		t.Fatalf("Expected the events of both pages, got %d", len(events))
+	}
+	first := events[0].(map[string]interface{})
+	if first["actor"] != "alice" || first["repository"] != "example/one" {
+		t.Fatalf("Unexpected event: %#v", first)
+	}
+	if first["created_at"] != "2020-01-01T00:00:00Z" {
+		t.Fatalf("Expected the timestamp in RFC 3339 format, got %q", first["created_at"])
+	}
+	if first["raw"] == "" {
+		t.Fatal("Expected the raw JSON of the event")
+	}
+}
+
+func TestGithubOrganizationAuditLogReadMaxResults(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/orgs/example/audit-log?include=web&order=desc&per_page=3",
+			StatusCode:  200,
+			ResponseHeaders: map[string]string{
+				"Link": `<https://api.github.com/organizations/1/audit-log?after=MS4yMw%3D%3D&before=>; rel="next"`,
+			},
+			ResponseBody: `[{"_document_id": "a1", "action": "org.update_member"},
+  {"_document_id": "b2", "action": "org.update_member"}]`,
+		},
+		{
+			ExpectedUri: "/orgs/example/audit-log?after=MS4yMw%3D%3D&include=web&order=desc&per_page=3",
+			StatusCode:  200,
+			ResponseHeaders: map[string]string{
+				"Link": `<https://api.github.com/organizations/1/audit-log?after=MS4yNQ%3D%3D&before=>; rel="next"`,
+			},
+			ResponseBody: `[{"_document_id": "c3", "action": "org.update_member"},
+  {"_document_id": "d4", "action": "org.update_member"}]`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubOrganizationAuditLog().Schema, map[string]interface{}{
+		"max_results": 3,
+	})
+	if err := dataSourceGithubOrganizationAuditLogRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len(d.Get("events").([]interface{})); n != 3 {
+		t.Fatalf("Expected max_results to stop the listing after 3 events, got %d", n)
+	}
+}
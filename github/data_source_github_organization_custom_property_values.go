@@ -0,0 +1,130 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type repositoryCustomPropertyValues struct {
+	RepositoryID       *int64                 `json:"repository_id,omitempty"`
+	RepositoryName     *string                `json:"repository_name,omitempty"`
+	RepositoryFullName *string                `json:"repository_full_name,omitempty"`
+	Properties         []*customPropertyValue `json:"properties"`
+}
+
+func dataSourceGithubOrganizationCustomPropertyValues() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationCustomPropertyValuesRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository_query": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"repositories": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"repository_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repository_full_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"property": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"property_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"property_value": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"repository_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationCustomPropertyValuesRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	// The query uses the syntax of the repository search, e.g.
+	// props.environment:production
+	query := url.Values{}
+	if v, ok := d.GetOk("repository_query"); ok {
+		query.Set("repository_query", v.(string))
+	}
+
+	log.Printf("[DEBUG] Reading custom property values: %s", orgName)
+	result, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		var values []*repositoryCustomPropertyValues
+		resp, err := apiRequest(ctx, client, "GET",
+			fmt.Sprintf("orgs/%s/properties/values?%s", orgName, pageQuery(query, opt)), nil, &values)
+		return values, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	repositories := []interface{}{}
+	names := []string{}
+	for _, v := range result {
+		r := v.(*repositoryCustomPropertyValues)
+		properties := make([]interface{}, 0, len(r.Properties))
+		for _, p := range r.Properties {
+			properties = append(properties, map[string]interface{}{
+				"property_name":  p.PropertyName,
+				"property_value": flattenCustomPropertyValue(p.Value),
+			})
+		}
+
+		repositories = append(repositories, map[string]interface{}{
+			"repository_id":        r.RepositoryID,
+			"repository_name":      r.RepositoryName,
+			"repository_full_name": r.RepositoryFullName,
+			"property":             properties,
+		})
+		if r.RepositoryName != nil {
+			names = append(names, *r.RepositoryName)
+		}
+	}
+
+	d.SetId(orgName)
+	d.Set("repositories", repositories)
+	d.Set("repository_names", names)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGithubOrganizationCustomPropertyValuesRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/orgs/example/properties/values?page=1&per_page=100&repository_query=props.environment%3Aproduction",
+			ResponseBody: `[{"repository_id": 1296269, "repository_name": "api", "repository_full_name": "example/api",
+				"properties": [{"property_name": "environment", "value": "production"}, {"property_name": "teams", "value": ["backend", "ops"]}]}]`,
+			StatusCode: 200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubOrganizationCustomPropertyValues().Schema, map[string]interface{}{
+		"repository_query": "props.environment:production",
+	})
+	if err := dataSourceGithubOrganizationCustomPropertyValuesRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if names := d.Get("repository_names").([]interface{}); len(names) != 1 || names[0] != "api" {
+		t.Fatalf("Expected the api repository, got %v", names)
+	}
+	if id := d.Get("repositories.0.repository_id").(int); id != 1296269 {
+		t.Fatalf("Expected the ID of the repository, got %d", id)
+	}
+	if v := d.Get("repositories.0.property.1.property_value").([]interface{}); len(v) != 2 || v[1] != "ops" {
+		t.Fatalf("Expected the values of the multi_select property, got %v", v)
+	}
+}
@@ -0,0 +1,227 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type dependabotAlert struct {
+	Number     int    `json:"number"`
+	State      string `json:"state"`
+	HTMLURL    string `json:"html_url"`
+	CreatedAt  string `json:"created_at"`
+	Dependency struct {
+		Package struct {
+			Ecosystem string `json:"ecosystem"`
+			Name      string `json:"name"`
+		} `json:"package"`
+		ManifestPath string `json:"manifest_path"`
+		Scope        string `json:"scope"`
+	} `json:"dependency"`
+	SecurityAdvisory struct {
+		GHSAID   string `json:"ghsa_id"`
+		CVEID    string `json:"cve_id"`
+		Summary  string `json:"summary"`
+		Severity string `json:"severity"`
+	} `json:"security_advisory"`
+	SecurityVulnerability struct {
+		VulnerableVersionRange string `json:"vulnerable_version_range"`
+		FirstPatchedVersion    struct {
+			Identifier string `json:"identifier"`
+		} `json:"first_patched_version"`
+	} `json:"security_vulnerability"`
+	Repository struct {
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+func dataSourceGithubOrganizationDependabotAlerts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationDependabotAlertsRead,
+
+		Schema: map[string]*schema.Schema{
+			// Only open alerts are listed unless states are given
+			"states": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateValueFunc([]string{"auto_dismissed", "dismissed", "fixed", "open"}),
+				},
+			},
+			"severities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateValueFunc([]string{"low", "medium", "high", "critical"}),
+				},
+			},
+			"ecosystems": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"packages": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateValueFunc([]string{"development", "runtime"}),
+			},
+			"max_results": maxResultsSchema(),
+			"alerts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"repository": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ecosystem": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"package": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"manifest_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ghsa_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cve_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vulnerable_version_range": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_patched_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dependabotAlertsFilter joins the values of a set argument in the comma
+// separated form the filters of the alert listings take.
+func dependabotAlertsFilter(d *schema.ResourceData, key string) string {
+	values := expandStringList(d.Get(key).(*schema.Set).List())
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+func dataSourceGithubOrganizationDependabotAlertsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	query := url.Values{}
+	query.Set("state", "open")
+	if v := dependabotAlertsFilter(d, "states"); v != "" {
+		query.Set("state", v)
+	}
+	if v := dependabotAlertsFilter(d, "severities"); v != "" {
+		query.Set("severity", v)
+	}
+	if v := dependabotAlertsFilter(d, "ecosystems"); v != "" {
+		query.Set("ecosystem", v)
+	}
+	if v := dependabotAlertsFilter(d, "packages"); v != "" {
+		query.Set("package", v)
+	}
+	if v, ok := d.GetOk("scope"); ok {
+		query.Set("scope", v.(string))
+	}
+
+	log.Printf("[DEBUG] Reading Dependabot alerts: %s (%s)", orgName, query.Get("state"))
+	result, err := listPages(meta, d.Get("max_results").(int), func(opt listOptions) (interface{}, *github.Response, error) {
+		var page []*dependabotAlert
+		resp, err := apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/dependabot/alerts?%s", orgName, cursorQuery(query, opt)), nil, &page)
+		return page, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	alerts := make([]interface{}, 0, len(result))
+	for _, v := range result {
+		a := v.(*dependabotAlert)
+		alerts = append(alerts, map[string]interface{}{
+			"number":                   a.Number,
+			"repository":               a.Repository.Name,
+			"state":                    a.State,
+			"severity":                 a.SecurityAdvisory.Severity,
+			"ecosystem":                a.Dependency.Package.Ecosystem,
+			"package":                  a.Dependency.Package.Name,
+			"manifest_path":            a.Dependency.ManifestPath,
+			"scope":                    a.Dependency.Scope,
+			"ghsa_id":                  a.SecurityAdvisory.GHSAID,
+			"cve_id":                   a.SecurityAdvisory.CVEID,
+			"summary":                  a.SecurityAdvisory.Summary,
+			"vulnerable_version_range": a.SecurityVulnerability.VulnerableVersionRange,
+			"first_patched_version":    a.SecurityVulnerability.FirstPatchedVersion.Identifier,
+			"html_url":                 a.HTMLURL,
+			"created_at":               a.CreatedAt,
+		})
+	}
+
+	d.SetId(orgName)
+	d.Set("alerts", alerts)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGithubOrganizationDependabotAlertsRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/orgs/example/dependabot/alerts?ecosystem=npm&per_page=100&severity=critical%2Chigh&state=open",
+			StatusCode:  200,
+			ResponseHeaders: map[string]string{
+				"Link": `<https://api.github.com/organizations/1/dependabot/alerts?after=Y3Vyc29y&per_page=100>; rel="next"`,
+			},
+			ResponseBody: `[{"number": 2, "state": "open", "html_url": "https://github.com/example/api/security/dependabot/2",
+				"dependency": {"package": {"ecosystem": "npm", "name": "lodash"}, "manifest_path": "package-lock.json", "scope": "runtime"},
+				"security_advisory": {"ghsa_id": "GHSA-jf85-cpcp-j695", "cve_id": "CVE-2019-10744", "summary": "Prototype Pollution in lodash", "severity": "critical"},
+				"security_vulnerability": {"vulnerable_version_range": "< 4.17.12", "first_patched_version": {"identifier": "4.17.12"}},
+				"repository": {"name": "api", "full_name": "example/api"}}]`,
+		},
+		{
+			ExpectedUri:  "/orgs/example/dependabot/alerts?after=Y3Vyc29y&ecosystem=npm&per_page=100&severity=critical%2Chigh&state=open",
+			StatusCode:   200,
+			ResponseBody: `[{"number": 1, "state": "open", "repository": {"name": "web"}, "security_advisory": {"severity": "high"}}]`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubOrganizationDependabotAlerts().Schema, map[string]interface{}{
+		"severities": []interface{}{"high", "critical"},
+		"ecosystems": []interface{}{"npm"},
+	})
+	if err := dataSourceGithubOrganizationDependabotAlertsRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := d.Get("alerts.#").(int); n != 2 {
+		t.Fatalf("Expected the alerts of both pages, got %d", n)
+	}
+	if v := d.Get("alerts.0.first_patched_version"); v != "4.17.12" {
+		t.Fatalf("Expected the first patched version of lodash, got %v", v)
+	}
+	if v := d.Get("alerts.1.repository"); v != "web" {
+		t.Fatalf("Expected the alert of the web repository, got %v", v)
+	}
+}
@@ -0,0 +1,194 @@
+package github
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// externalIdentity is an ExternalIdentity object of the GraphQL API, linking
+// a member of the organization to its identity at the SAML identity provider
+// and, if users are provisioned through SCIM, its SCIM identity.
+type externalIdentity struct {
+	GUID         string `json:"guid"`
+	SamlIdentity *struct {
+		NameID   string                  `json:"nameId"`
+		Username string                  `json:"username"`
+		Emails   []*externalIdentityMail `json:"emails"`
+	} `json:"samlIdentity"`
+	ScimIdentity *struct {
+		Username string                  `json:"username"`
+		Emails   []*externalIdentityMail `json:"emails"`
+	} `json:"scimIdentity"`
+	User *struct {
+		Login string `json:"login"`
+	} `json:"user"`
+}
+
+type externalIdentityMail struct {
+	Value string `json:"value"`
+}
+
+const externalIdentitiesQuery = `query($login: String!, $first: Int!, $after: String) {
+  organization(login: $login) {
+    samlIdentityProvider {
+      externalIdentities(first: $first, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          guid
+          samlIdentity { nameId username emails { value } }
+          scimIdentity { username emails { value } }
+          user { login }
+        }
+      }
+    }
+  }
+}`
+
+func dataSourceGithubOrganizationExternalIdentities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationExternalIdentitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"max_results": maxResultsSchema(),
+			"identities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"guid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// Empty for identities which are not linked to a
+						// GitHub user yet, e.g. pending invitations
+						"login": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"saml_name_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"saml_username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"saml_emails": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"scim_username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scim_emails": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationExternalIdentitiesRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Reading external identities of organization: %s", orgName)
+	result, err := listCursorPages(meta, d.Get("max_results").(int), func(opt listOptions) (interface{}, string, error) {
+		var data struct {
+			Organization struct {
+				SamlIdentityProvider *struct {
+					ExternalIdentities struct {
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+						Nodes []*externalIdentity `json:"nodes"`
+					} `json:"externalIdentities"`
+				} `json:"samlIdentityProvider"`
+			} `json:"organization"`
+		}
+		variables := map[string]interface{}{"login": orgName, "first": opt.PerPage}
+		if opt.After != "" {
+			variables["after"] = opt.After
+		}
+		err := graphqlRequest(ctx, client, externalIdentitiesQuery, variables, &data)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Organizations without SAML single sign-on have no identities
+		provider := data.Organization.SamlIdentityProvider
+		if provider == nil {
+			log.Printf("[INFO] SAML single sign-on is not enabled for organization %s", orgName)
+			return nil, "", nil
+		}
+
+		next := ""
+		if provider.ExternalIdentities.PageInfo.HasNextPage {
+			next = provider.ExternalIdentities.PageInfo.EndCursor
+		}
+		return provider.ExternalIdentities.Nodes, next, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	identities := make([]interface{}, 0, len(result))
+	for _, v := range result {
+		identities = append(identities, flattenExternalIdentity(v.(*externalIdentity)))
+	}
+
+	d.SetId(orgName)
+	d.Set("identities", identities)
+
+	return nil
+}
+
+func flattenExternalIdentity(identity *externalIdentity) map[string]interface{} {
+	m := map[string]interface{}{
+		"guid":          identity.GUID,
+		"login":         "",
+		"saml_name_id":  "",
+		"saml_username": "",
+		"saml_emails":   []interface{}{},
+		"scim_username": "",
+		"scim_emails":   []interface{}{},
+	}
+	if identity.User != nil {
+		m["login"] = identity.User.Login
+	}
+	if saml := identity.SamlIdentity; saml != nil {
+		m["saml_name_id"] = saml.NameID
+		m["saml_username"] = saml.Username
+		m["saml_emails"] = flattenExternalIdentityMails(saml.Emails)
+	}
+	if scim := identity.ScimIdentity; scim != nil {
+		m["scim_username"] = scim.Username
+		m["scim_emails"] = flattenExternalIdentityMails(scim.Emails)
+	}
+
+	return m
+}
+
+func flattenExternalIdentityMails(mails []*externalIdentityMail) []interface{} {
+	values := make([]interface{}, 0, len(mails))
+	for _, mail := range mails {
+		values = append(values, mail.Value)
+	}
+
+	return values
+}
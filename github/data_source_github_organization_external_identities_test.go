@@ -0,0 +1,89 @@
+package github
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccGithubOrganizationExternalIdentitiesDataSource_basic(t *testing.T) {
+	// Requires an organization with SAML single sign-on
+	if os.Getenv("GITHUB_TEST_SAML_ORGANIZATION") == "" {
+		t.Skip("GITHUB_TEST_SAML_ORGANIZATION must be set for this acceptance test")
+	}
+	dsn := "data.github_organization_external_identities.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "github_organization_external_identities" "test" {
+  owner = "` + os.Getenv("GITHUB_TEST_SAML_ORGANIZATION") + `"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsn, "identities.#"),
+					resource.TestCheckResourceAttrSet(dsn, "identities.0.saml_name_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestGithubOrganizationExternalIdentitiesRead(t *testing.T) {
+	query, _ := json.Marshal(externalIdentitiesQuery)
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/graphql",
+			StatusCode:  200,
+			ResponseBody: `{"data": {"organization": {"samlIdentityProvider": {"externalIdentities": {
+  "pageInfo": {"hasNextPage": true, "endCursor": "Y3Vyc29yOjE="},
+  "nodes": [{"guid": "a1", "user": {"login": "alice"},
+    "samlIdentity": {"nameId": "alice@example.com", "username": "alice", "emails": [{"value": "alice@example.com"}]},
+    "scimIdentity": {"username": "alice@example.com", "emails": [{"value": "alice@example.com"}]}}]}}}}}`,
+		},
+		{
+			ExpectedUri: "/graphql",
+			ExpectedBody: []byte(`{"query":` + string(query) +
+				`,"variables":{"after":"Y3Vyc29yOjE=","first":100,"login":"example"}}` + "\n"),
+			StatusCode: 200,
+			ResponseBody: `{"data": {"organization": {"samlIdentityProvider": {"externalIdentities": {
+  "pageInfo": {"hasNextPage": false, "endCursor": "Y3Vyc29yOjI="},
+  "nodes": [{"guid": "b2", "user": null,
+    "samlIdentity": {"nameId": "bob@example.com", "username": "bob", "emails": []},
+    "scimIdentity": null}]}}}}}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubOrganizationExternalIdentities().Schema, map[string]interface{}{})
+	if err := dataSourceGithubOrganizationExternalIdentitiesRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	identities := d.Get("identities").([]interface{})
+	if len(identities) != 2 {
+		t.Fatalf("Expected the identities of both pages, got %d", len(identities))
+	}
+	alice := identities[0].(map[string]interface{})
+	if alice["login"] != "alice" || alice["scim_username"] != "alice@example.com" {
+		t.Fatalf("Expected the identity of alice, got %v", alice)
+	}
+	bob := identities[1].(map[string]interface{})
+	if bob["login"] != "" || bob["saml_name_id"] != "bob@example.com" {
+		t.Fatalf("Expected the unlinked identity of bob, got %v", bob)
+	}
+}
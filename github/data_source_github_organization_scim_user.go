@@ -0,0 +1,158 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const scimMediaType = "application/scim+json"
+
+type scimUserEmail struct {
+	Value   *string `json:"value,omitempty"`
+	Primary *bool   `json:"primary,omitempty"`
+	Type    *string `json:"type,omitempty"`
+}
+
+type scimUser struct {
+	ID         *string `json:"id,omitempty"`
+	ExternalID *string `json:"externalId,omitempty"`
+	UserName   *string `json:"userName,omitempty"`
+	Name       *struct {
+		GivenName  *string `json:"givenName,omitempty"`
+		FamilyName *string `json:"familyName,omitempty"`
+	} `json:"name,omitempty"`
+	Emails []*scimUserEmail `json:"emails,omitempty"`
+	Active *bool            `json:"active,omitempty"`
+}
+
+type scimUserList struct {
+	TotalResults int         `json:"totalResults"`
+	Resources    []*scimUser `json:"Resources"`
+}
+
+func dataSourceGithubOrganizationScimUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationScimUserRead,
+
+		Schema: map[string]*schema.Schema{
+			"scim_user_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"user_name", "email"},
+			},
+			"user_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"scim_user_id", "email"},
+			},
+			"email": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"scim_user_id", "user_name"},
+			},
+			"external_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"given_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"family_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"emails": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationScimUserRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	user := new(scimUser)
+	if id, ok := d.GetOk("scim_user_id"); ok {
+		log.Printf("[DEBUG] Reading SCIM identity %s of organization: %s", id.(string), orgName)
+		_, err := scimRequest(ctx, meta, "GET", fmt.Sprintf("scim/v2/organizations/%s/Users/%s", orgName, id.(string)), user)
+		if err != nil {
+			return err
+		}
+	} else {
+		var filter string
+		if v, ok := d.GetOk("user_name"); ok {
+			filter = fmt.Sprintf("userName eq %q", v.(string))
+		} else if v, ok := d.GetOk("email"); ok {
+			filter = fmt.Sprintf("emails eq %q", v.(string))
+		} else {
+			return fmt.Errorf("One of scim_user_id, user_name or email must be set")
+		}
+
+		log.Printf("[DEBUG] Finding SCIM identity of organization %s: %s", orgName, filter)
+		users := new(scimUserList)
+		_, err := scimRequest(ctx, meta, "GET", fmt.Sprintf("scim/v2/organizations/%s/Users?filter=%s",
+			orgName, url.QueryEscape(filter)), users)
+		if err != nil {
+			return err
+		}
+		if len(users.Resources) != 1 {
+			return fmt.Errorf("Expected exactly one SCIM identity matching %s in organization %s, found %d",
+				filter, orgName, len(users.Resources))
+		}
+		user = users.Resources[0]
+	}
+
+	emails := []string{}
+	for _, e := range user.Emails {
+		if e.Value != nil {
+			emails = append(emails, *e.Value)
+		}
+	}
+
+	d.SetId(*user.ID)
+	d.Set("scim_user_id", user.ID)
+	d.Set("user_name", user.UserName)
+	d.Set("external_id", user.ExternalID)
+	if user.Name != nil {
+		d.Set("given_name", user.Name.GivenName)
+		d.Set("family_name", user.Name.FamilyName)
+	}
+	d.Set("emails", emails)
+	d.Set("active", user.Active != nil && *user.Active)
+
+	return nil
+}
+
+// scimRequest issues a request against the SCIM API, which only speaks its
+// own media type.
+func scimRequest(ctx context.Context, meta interface{}, method, urlStr string, v interface{}) (*github.Response, error) {
+	client := meta.(*Organization).client
+
+	req, err := client.NewRequest(method, urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", scimMediaType)
+
+	return client.Do(ctx, req, v)
+}
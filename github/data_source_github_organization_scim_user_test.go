@@ -0,0 +1,39 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubOrganizationScimUserDataSource_userName(t *testing.T) {
+	// Requires an organization with SAML single sign-on and SCIM provisioning
+	userName := os.Getenv("GITHUB_TEST_SCIM_USER_NAME")
+	if userName == "" {
+		t.Skip("GITHUB_TEST_SCIM_USER_NAME must be set for this acceptance test")
+	}
+	dsn := "data.github_organization_scim_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "github_organization_scim_user" "test" {
+  user_name = "%s"
+}
+`, userName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsn, "user_name", userName),
+					resource.TestCheckResourceAttrSet(dsn, "scim_user_id"),
+					resource.TestCheckResourceAttrSet(dsn, "emails.#"),
+				),
+			},
+		},
+	})
+}
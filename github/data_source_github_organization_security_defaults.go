@@ -0,0 +1,101 @@
+package github
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// organizationSecurityDefaults are the security and analysis features which
+// the organization enables for new repositories.
+type organizationSecurityDefaults struct {
+	AdvancedSecurity             *bool `json:"advanced_security_enabled_for_new_repositories,omitempty"`
+	DependabotAlerts             *bool `json:"dependabot_alerts_enabled_for_new_repositories,omitempty"`
+	DependabotSecurityUpdates    *bool `json:"dependabot_security_updates_enabled_for_new_repositories,omitempty"`
+	DependencyGraph              *bool `json:"dependency_graph_enabled_for_new_repositories,omitempty"`
+	SecretScanning               *bool `json:"secret_scanning_enabled_for_new_repositories,omitempty"`
+	SecretScanningPushProtection *bool `json:"secret_scanning_push_protection_enabled_for_new_repositories,omitempty"`
+}
+
+func dataSourceGithubOrganizationSecurityDefaults() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationSecurityDefaultsRead,
+
+		Schema: map[string]*schema.Schema{
+			"advanced_security_enabled_for_new_repositories": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"dependabot_alerts_enabled_for_new_repositories": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"dependabot_security_updates_enabled_for_new_repositories": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"dependency_graph_enabled_for_new_repositories": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"secret_scanning_enabled_for_new_repositories": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"secret_scanning_push_protection_enabled_for_new_repositories": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			// The statuses of the defaults, in the form the
+			// security_and_analysis block of github_repository takes them
+			"security_and_analysis": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationSecurityDefaultsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	// The settings are only returned to owners of the organization
+	log.Printf("[DEBUG] Reading security defaults of organization: %s", orgName)
+	defaults := new(organizationSecurityDefaults)
+	_, err = apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s", orgName), nil, defaults)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(orgName)
+	d.Set("advanced_security_enabled_for_new_repositories", defaults.AdvancedSecurity)
+	d.Set("dependabot_alerts_enabled_for_new_repositories", defaults.DependabotAlerts)
+	d.Set("dependabot_security_updates_enabled_for_new_repositories", defaults.DependabotSecurityUpdates)
+	d.Set("dependency_graph_enabled_for_new_repositories", defaults.DependencyGraph)
+	d.Set("secret_scanning_enabled_for_new_repositories", defaults.SecretScanning)
+	d.Set("secret_scanning_push_protection_enabled_for_new_repositories", defaults.SecretScanningPushProtection)
+	d.Set("security_and_analysis", map[string]string{
+		"advanced_security":               securityDefaultStatus(defaults.AdvancedSecurity),
+		"secret_scanning":                 securityDefaultStatus(defaults.SecretScanning),
+		"secret_scanning_push_protection": securityDefaultStatus(defaults.SecretScanningPushProtection),
+		"dependabot_security_updates":     securityDefaultStatus(defaults.DependabotSecurityUpdates),
+	})
+
+	return nil
+}
+
+func securityDefaultStatus(enabled *bool) string {
+	if enabled != nil && *enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
@@ -0,0 +1,43 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGithubOrganizationSecurityDefaultsRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/orgs/example",
+			ResponseBody: `{"login": "example", "advanced_security_enabled_for_new_repositories": false,
+				"secret_scanning_enabled_for_new_repositories": true, "dependabot_alerts_enabled_for_new_repositories": true}`,
+			StatusCode: 200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubOrganizationSecurityDefaults().Schema, map[string]interface{}{})
+	if err := dataSourceGithubOrganizationSecurityDefaultsRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if !d.Get("secret_scanning_enabled_for_new_repositories").(bool) {
+		t.Fatal("Expected secret scanning to be enabled for new repositories")
+	}
+	if d.Get("secret_scanning_push_protection_enabled_for_new_repositories").(bool) {
+		t.Fatal("Expected push protection not to be enabled for new repositories")
+	}
+	if v := d.Get("security_and_analysis.secret_scanning"); v != "enabled" {
+		t.Fatalf("Expected the status of secret scanning to be enabled, got %v", v)
+	}
+	if v := d.Get("security_and_analysis.advanced_security"); v != "disabled" {
+		t.Fatalf("Expected the status of advanced security to be disabled, got %v", v)
+	}
+}
@@ -0,0 +1,119 @@
+package github
+
+import (
+	"log"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubOrganizationTeams() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubOrganizationTeamsRead,
+
+		Schema: map[string]*schema.Schema{
+			"include_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"max_results": maxResultsSchema(),
+			"teams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"node_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"privacy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"parent_slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"members": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationTeamsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
+	includeMembers := d.Get("include_members").(bool)
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Reading teams of organization: %s", orgName)
+	result, err := listPages(meta, d.Get("max_results").(int), func(opt listOptions) (interface{}, *github.Response, error) {
+		return client.Teams.ListTeams(ctx, orgName, &opt.ListOptions)
+	})
+	if err != nil {
+		return err
+	}
+
+	teams := make([]interface{}, 0, len(result))
+	for _, v := range result {
+		t := v.(*github.Team)
+		team := map[string]interface{}{
+			"id":          t.GetID(),
+			"node_id":     t.GetNodeID(),
+			"slug":        t.GetSlug(),
+			"name":        t.GetName(),
+			"description": t.GetDescription(),
+			"privacy":     t.GetPrivacy(),
+			"parent_id":   t.GetParent().GetID(),
+			"parent_slug": t.GetParent().GetSlug(),
+		}
+
+		// Listing members takes a request per team, so it is opt-in
+		if includeMembers {
+			members, err := listGithubTeamMembers(ctx, meta, t.GetID())
+			if err != nil {
+				return err
+			}
+			team["members"] = members
+		}
+
+		teams = append(teams, team)
+	}
+
+	d.SetId(orgName)
+	d.Set("teams", teams)
+
+	return nil
+}
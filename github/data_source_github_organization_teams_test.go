@@ -0,0 +1,51 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubOrganizationTeamsDataSource_basic(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	parentName := fmt.Sprintf("tf-acc-test-parent-%s", rs)
+	childName := fmt.Sprintf("tf-acc-test-child-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubOrganizationTeamsDataSourceConfig(parentName, childName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.github_organization_teams.test", "teams.#"),
+					resource.TestCheckResourceAttrSet("data.github_organization_teams.test", "teams.0.slug"),
+					resource.TestCheckResourceAttrSet("data.github_organization_teams.test", "teams.0.node_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubOrganizationTeamsDataSourceConfig(parentName, childName string) string {
+	return fmt.Sprintf(`
+resource "github_team" "parent" {
+  name = "%s"
+}
+
+resource "github_team" "child" {
+  name           = "%s"
+  parent_team_id = "${github_team.parent.id}"
+}
+
+data "github_organization_teams" "test" {
+  include_members = true
+
+  depends_on = ["github_team.child"]
+}
+`, parentName, childName)
+}
@@ -0,0 +1,102 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubRef() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRefRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ref": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sha": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubRefRead(d *schema.ResourceData, meta interface{}) error {
+	ref, err := resolveGithubRef(d, meta, strings.TrimPrefix(d.Get("ref").(string), "refs/"))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(ref.ID)
+	d.Set("sha", ref.SHA)
+	d.Set("node_id", ref.NodeID)
+	d.Set("object_type", ref.ObjectType)
+
+	return nil
+}
+
+type resolvedRef struct {
+	ID         string
+	SHA        string
+	TagSHA     string
+	ObjectType string
+	NodeID     string
+}
+
+// resolveGithubRef resolves a fully qualified ref such as "heads/master" to
+// the object it points to. Annotated tags are peeled to the commit they tag,
+// keeping the SHA of the tag object itself in TagSHA.
+func resolveGithubRef(d *schema.ResourceData, meta interface{}, ref string) (*resolvedRef, error) {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	if v, ok := d.GetOk("owner"); ok {
+		owner = v.(string)
+	}
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Reading ref: %s/%s (%s)", owner, repoName, ref)
+	reference, _, err := client.Git.GetRef(ctx, owner, repoName, ref)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading ref %q of repository %s/%s: %s", ref, owner, repoName, err)
+	}
+
+	resolved := &resolvedRef{
+		ID:         fmt.Sprintf("%s/%s:%s", owner, repoName, reference.GetRef()),
+		SHA:        reference.GetObject().GetSHA(),
+		ObjectType: reference.GetObject().GetType(),
+		NodeID:     reference.GetNodeID(),
+	}
+	if resolved.ObjectType == "tag" {
+		tag, _, err := client.Git.GetTag(ctx, owner, repoName, resolved.SHA)
+		if err != nil {
+			return nil, err
+		}
+		resolved.TagSHA = resolved.SHA
+		resolved.SHA = tag.GetObject().GetSHA()
+		resolved.ObjectType = tag.GetObject().GetType()
+	}
+
+	return resolved, nil
+}
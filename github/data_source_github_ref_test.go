@@ -0,0 +1,79 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubRefDataSource_basic(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-ref-%s", rs)
+	shaRe := regexp.MustCompile("^[0-9a-f]{40}$")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubRefDataSourceConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.github_ref.test", "sha", shaRe),
+					resource.TestCheckResourceAttr("data.github_ref.test", "object_type", "commit"),
+					resource.TestMatchResourceAttr("data.github_ref.test", "node_id", regexp.MustCompile("^.+$")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubRefDataSource_missing(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-ref-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckGithubRefDataSourceMissingConfig(repoName),
+				ExpectError: regexp.MustCompile("no match found for this ref"),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubRefDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+data "github_ref" "test" {
+  repository = "${github_repository.test.name}"
+  ref        = "heads/${github_repository.test.default_branch}"
+}
+`, repoName)
+}
+
+func testAccCheckGithubRefDataSourceMissingConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+data "github_ref" "test" {
+  repository = "${github_repository.test.name}"
+  ref        = "heads/does-not-exist"
+}
+`, repoName)
+}
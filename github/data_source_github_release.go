@@ -0,0 +1,201 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubRelease() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubReleaseRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"retrieve_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "latest",
+				ValidateFunc: validateValueFunc([]string{"latest", "tag", "id"}),
+			},
+			"release_tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"release_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tag_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_commitish": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"body": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"draft": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"prerelease": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"published_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tarball_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zipball_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"browser_download_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubReleaseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	if v, ok := d.GetOk("owner"); ok {
+		owner = v.(string)
+	}
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	var release *github.RepositoryRelease
+	var err error
+	switch d.Get("retrieve_by").(string) {
+	case "latest":
+		log.Printf("[DEBUG] Reading latest release: %s/%s", owner, repoName)
+		release, _, err = client.Repositories.GetLatestRelease(ctx, owner, repoName)
+	case "tag":
+		tag := d.Get("release_tag").(string)
+		if tag == "" {
+			return fmt.Errorf("`release_tag` must be set when `retrieve_by` is %q.", "tag")
+		}
+		log.Printf("[DEBUG] Reading release by tag: %s/%s (%s)", owner, repoName, tag)
+		release, _, err = client.Repositories.GetReleaseByTag(ctx, owner, repoName, tag)
+	case "id":
+		id := int64(d.Get("release_id").(int))
+		if id == 0 {
+			return fmt.Errorf("`release_id` must be set when `retrieve_by` is %q.", "id")
+		}
+		log.Printf("[DEBUG] Reading release by ID: %s/%s (%d)", owner, repoName, id)
+		release, _, err = client.Repositories.GetRelease(ctx, owner, repoName, id)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(release.GetID(), 10))
+	d.Set("release_id", release.GetID())
+	d.Set("release_tag", release.GetTagName())
+	d.Set("tag_name", release.GetTagName())
+	d.Set("target_commitish", release.GetTargetCommitish())
+	d.Set("name", release.GetName())
+	d.Set("body", release.GetBody())
+	d.Set("draft", release.GetDraft())
+	d.Set("prerelease", release.GetPrerelease())
+	if release.CreatedAt != nil {
+		d.Set("created_at", release.CreatedAt.Format(time.RFC3339))
+	}
+	if release.PublishedAt != nil {
+		d.Set("published_at", release.PublishedAt.Format(time.RFC3339))
+	}
+	d.Set("url", release.GetURL())
+	d.Set("html_url", release.GetHTMLURL())
+	d.Set("tarball_url", release.GetTarballURL())
+	d.Set("zipball_url", release.GetZipballURL())
+	d.Set("assets", flattenReleaseAssets(release.Assets))
+
+	return nil
+}
+
+func flattenReleaseAssets(assets []github.ReleaseAsset) []interface{} {
+	result := make([]interface{}, 0, len(assets))
+	for _, a := range assets {
+		result = append(result, map[string]interface{}{
+			"id":                   a.GetID(),
+			"name":                 a.GetName(),
+			"label":                a.GetLabel(),
+			"content_type":         a.GetContentType(),
+			"size":                 a.GetSize(),
+			"url":                  a.GetURL(),
+			"browser_download_url": a.GetBrowserDownloadURL(),
+		})
+	}
+	return result
+}
@@ -0,0 +1,87 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubReleaseDataSource_latest(t *testing.T) {
+	// Releases cannot be created by the provider, so the test reads the
+	// releases of a public repository
+	owner := "hashicorp"
+	repoName := "terraform"
+	if v := os.Getenv("GITHUB_TEST_RELEASE_REPOSITORY"); v != "" {
+		var err error
+		owner, repoName, err = splitRepoFullName(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubReleaseDataSourceLatestConfig(owner, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.github_release.latest", "tag_name", regexp.MustCompile("^.+$")),
+					resource.TestCheckResourceAttr("data.github_release.latest", "draft", "false"),
+					resource.TestCheckResourceAttrPair("data.github_release.latest", "id", "data.github_release.by_tag", "id"),
+					resource.TestCheckResourceAttrPair("data.github_release.latest", "id", "data.github_release.by_id", "id"),
+					resource.TestCheckResourceAttrPair("data.github_release.latest", "assets.#", "data.github_release.by_tag", "assets.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGithubReleaseDataSource_missingTag(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "github_release" "test" {
+  owner       = "hashicorp"
+  repository  = "terraform"
+  retrieve_by = "tag"
+}
+`,
+				ExpectError: regexp.MustCompile("`release_tag` must be set"),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubReleaseDataSourceLatestConfig(owner, repoName string) string {
+	return fmt.Sprintf(`
+data "github_release" "latest" {
+  owner      = "%s"
+  repository = "%s"
+}
+
+data "github_release" "by_tag" {
+  owner       = "%s"
+  repository  = "%s"
+  retrieve_by = "tag"
+  release_tag = "${data.github_release.latest.tag_name}"
+}
+
+data "github_release" "by_id" {
+  owner       = "%s"
+  repository  = "%s"
+  retrieve_by = "id"
+  release_id  = "${data.github_release.latest.id}"
+}
+`, owner, repoName, owner, repoName, owner, repoName)
+}
@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"log"
 
 	"github.com/google/go-github/v28/github"
@@ -24,6 +23,7 @@ func dataSourceGithubRepositories() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice([]string{"stars", "fork", "updated"}, false),
 			},
+			"max_results": maxResultsSchema(),
 			"full_names": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
@@ -43,7 +43,7 @@ func dataSourceGithubRepositories() *schema.Resource {
 }
 
 func dataSourceGithubRepositoriesRead(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -51,47 +51,34 @@ func dataSourceGithubRepositoriesRead(d *schema.ResourceData, meta interface{})
 	client := meta.(*Organization).client
 
 	query := d.Get("query").(string)
-	opt := &github.SearchOptions{
-		Sort: d.Get("sort").(string),
-		ListOptions: github.ListOptions{
-			PerPage: 100,
-		},
-	}
+	sort := d.Get("sort").(string)
 
 	log.Printf("[DEBUG] Searching for GitHub repositories: %q", query)
-	fullNames, names, err := searchGithubRepositories(client, query, opt)
+	repos, err := listPages(meta, d.Get("max_results").(int), func(opt listOptions) (interface{}, *github.Response, error) {
+		results, resp, err := client.Search.Repositories(stopContext(meta), query, &github.SearchOptions{
+			Sort:        sort,
+			ListOptions: opt.ListOptions,
+		})
+		if err != nil {
+			return nil, resp, err
+		}
+		return results.Repositories, resp, nil
+	})
 	if err != nil {
 		return err
 	}
 
+	fullNames := make([]string, 0, len(repos))
+	names := make([]string, 0, len(repos))
+	for _, v := range repos {
+		repo := v.(github.Repository)
+		fullNames = append(fullNames, repo.GetFullName())
+		names = append(names, repo.GetName())
+	}
+
 	d.SetId(query)
 	d.Set("full_names", fullNames)
 	d.Set("names", names)
 
 	return nil
 }
-
-func searchGithubRepositories(client *github.Client, query string, opt *github.SearchOptions) ([]string, []string, error) {
-	fullNames := make([]string, 0)
-
-	names := make([]string, 0)
-
-	for {
-		results, resp, err := client.Search.Repositories(context.TODO(), query, opt)
-		if err != nil {
-			return fullNames, names, err
-		}
-
-		for _, repo := range results.Repositories {
-			fullNames = append(fullNames, repo.GetFullName())
-			names = append(names, repo.GetName())
-		}
-
-		if resp.NextPage == 0 {
-			break
-		}
-		opt.Page = resp.NextPage
-	}
-
-	return fullNames, names, nil
-}
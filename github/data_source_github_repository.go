@@ -1,11 +1,11 @@
 package github
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/google/go-github/v28/github"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -98,18 +98,126 @@ func dataSourceGithubRepository() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"repo_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"visibility": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_template": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"template": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repository": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"license": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"spdx_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"pages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"custom_404": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"branch": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// repositoryWithVisibility adds the visibility of a repository, which the
+// vendored go-github library does not decode yet.
+type repositoryWithVisibility struct {
+	github.Repository
+	Visibility *string `json:"visibility,omitempty"`
+}
+
 func dataSourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
 
 	client := meta.(*Organization).client
 	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
 	var repoName string
 
 	if fullName, ok := d.GetOk("full_name"); ok {
@@ -128,7 +236,8 @@ func dataSourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	log.Printf("[DEBUG] Reading GitHub repository %s/%s", orgName, repoName)
-	repo, _, err := client.Repositories.Get(context.TODO(), orgName, repoName)
+	repo := new(repositoryWithVisibility)
+	_, err = apiRequest(ctx, client, "GET", fmt.Sprintf("repos/%s/%s", orgName, repoName), nil, repo)
 	if err != nil {
 		return err
 	}
@@ -136,10 +245,12 @@ func dataSourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) er
 	d.SetId(repoName)
 
 	d.Set("name", repoName)
+	d.Set("repo_id", repo.GetID())
 	d.Set("description", repo.Description)
 	d.Set("homepage_url", repo.Homepage)
 	d.Set("private", repo.Private)
 	d.Set("has_issues", repo.HasIssues)
+	d.Set("has_projects", repo.HasProjects)
 	d.Set("has_wiki", repo.HasWiki)
 	d.Set("allow_merge_commit", repo.AllowMergeCommit)
 	d.Set("allow_squash_merge", repo.AllowSquashMerge)
@@ -153,15 +264,91 @@ func dataSourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("git_clone_url", repo.GitURL)
 	d.Set("http_clone_url", repo.CloneURL)
 	d.Set("archived", repo.Archived)
+	d.Set("node_id", repo.GetNodeID())
+	d.Set("is_template", repo.GetIsTemplate())
+	d.Set("template", flattenRepositoryTemplate(repo.TemplateRepository))
+	d.Set("license", flattenRepositoryLicense(repo.License))
+
+	// Older GitHub Enterprise releases do not report the visibility
+	if repo.Visibility != nil {
+		d.Set("visibility", repo.Visibility)
+	} else if repo.GetPrivate() {
+		d.Set("visibility", "private")
+	} else {
+		d.Set("visibility", "public")
+	}
 
 	err = d.Set("topics", flattenStringList(repo.Topics))
 	if err != nil {
 		return err
 	}
 
+	pages := []interface{}{}
+	if repo.GetHasPages() {
+		info, _, err := client.Repositories.GetPagesInfo(ctx, orgName, repoName)
+		if err != nil {
+			return err
+		}
+		pages = flattenRepositoryPages(info)
+	}
+	d.Set("pages", pages)
+
 	return nil
 }
 
+func flattenRepositoryTemplate(template *github.Repository) []interface{} {
+	if template == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"owner":      template.GetOwner().GetLogin(),
+			"repository": template.GetName(),
+		},
+	}
+}
+
+func flattenRepositoryLicense(license *github.License) []interface{} {
+	if license == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key":     license.GetKey(),
+			"name":    license.GetName(),
+			"spdx_id": license.GetSPDXID(),
+			"url":     license.GetURL(),
+		},
+	}
+}
+
+func flattenRepositoryPages(pages *github.Pages) []interface{} {
+	if pages == nil {
+		return []interface{}{}
+	}
+
+	source := []interface{}{}
+	if pages.Source != nil {
+		source = append(source, map[string]interface{}{
+			"branch": pages.Source.GetBranch(),
+			"path":   pages.Source.GetPath(),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"url":        pages.GetURL(),
+			"html_url":   pages.GetHTMLURL(),
+			"status":     pages.GetStatus(),
+			"cname":      pages.GetCNAME(),
+			"custom_404": pages.GetCustom404(),
+			"source":     source,
+		},
+	}
+}
+
 func splitRepoFullName(fullName string) (string, string, error) {
 	parts := strings.Split(fullName, "/")
 	if len(parts) != 2 {
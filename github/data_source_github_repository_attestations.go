@@ -0,0 +1,168 @@
+package github
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+type attestationEnvelope struct {
+	Payload     string `json:"payload"`
+	PayloadType string `json:"payloadType"`
+}
+
+type attestationBundle struct {
+	MediaType    string               `json:"mediaType"`
+	DSSEEnvelope *attestationEnvelope `json:"dsseEnvelope,omitempty"`
+}
+
+type attestation struct {
+	RepositoryID *int64          `json:"repository_id,omitempty"`
+	BundleURL    *string         `json:"bundle_url,omitempty"`
+	Bundle       json.RawMessage `json:"bundle,omitempty"`
+}
+
+type attestations struct {
+	Attestations []*attestation `json:"attestations"`
+}
+
+func dataSourceGithubRepositoryAttestations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRepositoryAttestationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subject_digest": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^sha256:[0-9a-f]{64}$`),
+					"must be a SHA-256 digest, e.g. sha256:<64 hexadecimal digits>"),
+			},
+			"predicate_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_results": maxResultsSchema(),
+			"attestations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bundle": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bundle_media_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bundle_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"predicate_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// attestationPredicateType returns the type of the predicate of the in-toto
+// statement signed in the DSSE envelope of an attestation bundle, e.g.
+// https://slsa.dev/provenance/v1 for build provenance.
+func attestationPredicateType(bundle *attestationBundle) string {
+	if bundle.DSSEEnvelope == nil {
+		return ""
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(bundle.DSSEEnvelope.Payload)
+	if err != nil {
+		return ""
+	}
+	var statement struct {
+		PredicateType string `json:"predicateType"`
+	}
+	if err := json.Unmarshal(payload, &statement); err != nil {
+		return ""
+	}
+
+	return statement.PredicateType
+}
+
+func dataSourceGithubRepositoryAttestationsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	digest := d.Get("subject_digest").(string)
+	ctx := stopContext(meta)
+
+	// Without a repository the attestations of every repository of the
+	// organization are listed
+	baseURL := fmt.Sprintf("orgs/%s/attestations/%s", owner, digest)
+	id := fmt.Sprintf("%s@%s", owner, digest)
+	if repoName, ok := d.GetOk("repository"); ok {
+		baseURL = fmt.Sprintf("repos/%s/%s/attestations/%s", owner, repoName.(string), digest)
+		id = fmt.Sprintf("%s/%s@%s", owner, repoName.(string), digest)
+	} else {
+		err := checkOrganization(meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	query := url.Values{}
+	if v, ok := d.GetOk("predicate_type"); ok {
+		query.Set("predicate_type", v.(string))
+	}
+
+	log.Printf("[DEBUG] Reading attestations: %s", id)
+	result, err := listPages(meta, d.Get("max_results").(int), func(opt listOptions) (interface{}, *github.Response, error) {
+		page := new(attestations)
+		resp, err := apiRequest(ctx, client, "GET", baseURL+"?"+cursorQuery(query, opt), nil, page)
+		return page.Attestations, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	list := make([]interface{}, 0, len(result))
+	for _, v := range result {
+		a := v.(*attestation)
+		bundle := new(attestationBundle)
+		if len(a.Bundle) > 0 {
+			if err := json.Unmarshal(a.Bundle, bundle); err != nil {
+				return fmt.Errorf("Error decoding attestation bundle of %s: %s", id, err)
+			}
+		}
+
+		list = append(list, map[string]interface{}{
+			"repository_id":     a.RepositoryID,
+			"bundle":            string(a.Bundle),
+			"bundle_media_type": bundle.MediaType,
+			"bundle_url":        a.BundleURL,
+			"predicate_type":    attestationPredicateType(bundle),
+		})
+	}
+
+	d.SetId(id)
+	d.Set("attestations", list)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package github
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAttestationPredicateType(t *testing.T) {
+	statement := `{"_type":"https://in-toto.io/Statement/v1","predicateType":"https://slsa.dev/provenance/v1"}`
+	bundle := &attestationBundle{}
+	if v := attestationPredicateType(bundle); v != "" {
+		t.Fatalf("Expected no predicate type without an envelope, got %q", v)
+	}
+
+	bundle.DSSEEnvelope = &attestationEnvelope{
+		Payload:     base64.StdEncoding.EncodeToString([]byte(statement)),
+		PayloadType: "application/vnd.in-toto+json",
+	}
+	if v := attestationPredicateType(bundle); v != "https://slsa.dev/provenance/v1" {
+		t.Fatalf("Expected the predicate type of the statement, got %q", v)
+	}
+}
+
+func TestAccGithubRepositoryAttestationsDataSource_basic(t *testing.T) {
+	// Attestations are only created by builds, so an existing one is needed
+	repoName := os.Getenv("GITHUB_TEST_ATTESTATION_REPOSITORY")
+	digest := os.Getenv("GITHUB_TEST_ATTESTATION_DIGEST")
+	if repoName == "" || digest == "" {
+		t.Skip("GITHUB_TEST_ATTESTATION_REPOSITORY and GITHUB_TEST_ATTESTATION_DIGEST must be set for this acceptance test")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "github_repository_attestations" "test" {
+  repository     = "%s"
+  subject_digest = "%s"
+}
+`, repoName, digest),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.github_repository_attestations.test", "attestations.0.bundle"),
+					resource.TestCheckResourceAttrSet("data.github_repository_attestations.test", "attestations.0.predicate_type"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,146 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// communityHealthFiles are the files which GitHub falls back to the
+// organization's .github repository for when a repository lacks them.
+var communityHealthFiles = []string{
+	"CODE_OF_CONDUCT.md",
+	"CONTRIBUTING.md",
+	"FUNDING.yml",
+	"ISSUE_TEMPLATE",
+	"PULL_REQUEST_TEMPLATE.md",
+	"SECURITY.md",
+	"SUPPORT.md",
+}
+
+// The repository whose health files every repository of the organization
+// inherits
+const communityHealthFallbackRepository = ".github"
+
+// communityHealthPaths returns the paths GitHub looks for a health file at,
+// in order of precedence.
+func communityHealthPaths(name string) []string {
+	return []string{".github/" + name, name, "docs/" + name}
+}
+
+func dataSourceGithubRepositoryCommunityHealthFiles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRepositoryCommunityHealthFilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"files": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"inherited": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"overridden": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"missing": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGithubRepositoryCommunityHealthFilesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Reading community health files: %s/%s", owner, repoName)
+	files := make([]interface{}, 0)
+	inherited, overridden, missing := []string{}, []string{}, []string{}
+	for _, name := range communityHealthFiles {
+		source := "none"
+		path, err := findCommunityHealthFile(ctx, client, owner, repoName, name)
+		if err != nil {
+			return err
+		}
+		if path != "" {
+			source = "repository"
+			overridden = append(overridden, name)
+		} else if repoName != communityHealthFallbackRepository {
+			path, err = findCommunityHealthFile(ctx, client, owner, communityHealthFallbackRepository, name)
+			if err != nil {
+				return err
+			}
+			if path != "" {
+				source = "organization"
+				inherited = append(inherited, name)
+			}
+		}
+		if source == "none" {
+			missing = append(missing, name)
+		}
+
+		files = append(files, map[string]interface{}{
+			"name":   name,
+			"source": source,
+			"path":   path,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repoName))
+	d.Set("files", files)
+	d.Set("inherited", inherited)
+	d.Set("overridden", overridden)
+	d.Set("missing", missing)
+
+	return nil
+}
+
+// findCommunityHealthFile returns the path of the given health file on the
+// default branch of a repository, or "" if the repository lacks it.
+func findCommunityHealthFile(ctx context.Context, client *github.Client, owner, repoName, name string) (string, error) {
+	for _, path := range communityHealthPaths(name) {
+		_, _, _, err := client.Repositories.GetContents(ctx, owner, repoName, path, nil)
+		if err == nil {
+			return path, nil
+		}
+		if ghErr, ok := err.(*github.ErrorResponse); !ok || ghErr.Response.StatusCode != http.StatusNotFound {
+			return "", err
+		}
+	}
+
+	return "", nil
+}
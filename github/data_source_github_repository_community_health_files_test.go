@@ -0,0 +1,52 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubRepositoryCommunityHealthFilesDataSource_basic(t *testing.T) {
+	dsn := "data.github_repository_community_health_files.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-health-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubRepositoryCommunityHealthFilesDataSourceConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsn, "files.#", "7"),
+					resource.TestCheckResourceAttr(dsn, "files.5.name", "SECURITY.md"),
+					resource.TestCheckResourceAttr(dsn, "files.5.source", "repository"),
+					resource.TestCheckResourceAttr(dsn, "files.5.path", ".github/SECURITY.md"),
+					resource.TestCheckResourceAttr(dsn, "overridden.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubRepositoryCommunityHealthFilesDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+resource "github_repository_community_files" "test" {
+  repository = "${github_repository.test.name}"
+  security   = "Please report vulnerabilities privately.\n"
+}
+
+data "github_repository_community_health_files" "test" {
+  repository = "${github_repository_community_files.test.repository}"
+}
+`, repoName)
+}
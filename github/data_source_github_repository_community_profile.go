@@ -0,0 +1,102 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubRepositoryCommunityProfile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRepositoryCommunityProfileRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"health_percentage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"has_code_of_conduct": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_contributing": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_issue_template": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_pull_request_template": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_license": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"has_readme": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"code_of_conduct": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"license": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubRepositoryCommunityProfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	// The community profile is only computed for public repositories
+	log.Printf("[DEBUG] Reading community profile of repository: %s/%s", owner, repoName)
+	metrics, _, err := client.Repositories.GetCommunityHealthMetrics(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	files := metrics.Files
+	if files == nil {
+		files = &github.CommunityHealthFiles{}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repoName))
+	d.Set("health_percentage", metrics.GetHealthPercentage())
+	d.Set("has_code_of_conduct", files.CodeOfConduct != nil)
+	d.Set("has_contributing", files.Contributing != nil)
+	d.Set("has_issue_template", files.IssueTemplate != nil)
+	d.Set("has_pull_request_template", files.PullRequestTemplate != nil)
+	d.Set("has_license", files.License != nil)
+	d.Set("has_readme", files.Readme != nil)
+	if files.CodeOfConduct != nil {
+		d.Set("code_of_conduct", files.CodeOfConduct.Key)
+	}
+	if files.License != nil {
+		d.Set("license", files.License.Key)
+	}
+	if metrics.UpdatedAt != nil {
+		d.Set("updated_at", metrics.UpdatedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
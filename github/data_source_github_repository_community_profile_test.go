@@ -0,0 +1,48 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGithubRepositoryCommunityProfileRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/example/api/community/profile",
+			StatusCode:  200,
+			ResponseBody: `{"health_percentage": 62, "updated_at": "2020-01-01T00:00:00Z", "files": {
+				"code_of_conduct": {"key": "contributor_covenant", "name": "Contributor Covenant"},
+				"contributing": null, "issue_template": null, "pull_request_template": null,
+				"license": {"key": "mit", "name": "MIT License"},
+				"readme": {"url": "https://api.github.com/repos/example/api/contents/README.md"}}}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubRepositoryCommunityProfile().Schema, map[string]interface{}{
+		"repository": "api",
+	})
+	if err := dataSourceGithubRepositoryCommunityProfileRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := d.Get("health_percentage"); v != 62 {
+		t.Fatalf("Unexpected health percentage %v", v)
+	}
+	if !d.Get("has_code_of_conduct").(bool) || d.Get("has_contributing").(bool) {
+		t.Fatal("Expected a code of conduct and no contributing guidelines")
+	}
+	if v := d.Get("code_of_conduct"); v != "contributor_covenant" {
+		t.Fatalf("Unexpected code of conduct %v", v)
+	}
+	if v := d.Get("updated_at"); v != "2020-01-01T00:00:00Z" {
+		t.Fatalf("Unexpected update time %v", v)
+	}
+}
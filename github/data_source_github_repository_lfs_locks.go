@@ -0,0 +1,144 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const lfsMediaType = "application/vnd.git-lfs+json"
+
+type lfsLockOwner struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type lfsLock struct {
+	ID       *string       `json:"id,omitempty"`
+	Path     *string       `json:"path,omitempty"`
+	LockedAt *string       `json:"locked_at,omitempty"`
+	Owner    *lfsLockOwner `json:"owner,omitempty"`
+}
+
+type lfsLocks struct {
+	Locks      []*lfsLock `json:"locks"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+}
+
+func dataSourceGithubRepositoryLfsLocks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRepositoryLfsLocksRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_results": maxResultsSchema(),
+			"locks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"locked_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubRepositoryLfsLocksRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	baseURL := lfsLocksURL(client, owner, repoName)
+	path, hasPath := d.GetOk("path")
+
+	log.Printf("[DEBUG] Reading LFS locks: %s/%s", owner, repoName)
+	result, err := listCursorPages(meta, d.Get("max_results").(int), func(opt listOptions) (interface{}, string, error) {
+		// The LFS API names its page size limit and its cursor cursor
+		query := url.Values{}
+		query.Set("limit", strconv.Itoa(opt.PerPage))
+		if hasPath {
+			query.Set("path", path.(string))
+		}
+		if opt.After != "" {
+			query.Set("cursor", opt.After)
+		}
+
+		req, err := client.NewRequest("GET", baseURL+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, "", err
+		}
+		req.Header.Set("Accept", lfsMediaType)
+
+		page := new(lfsLocks)
+		_, err = client.Do(ctx, req, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Locks, page.NextCursor, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	locks := make([]interface{}, 0, len(result))
+	for _, v := range result {
+		l := v.(*lfsLock)
+		lock := map[string]interface{}{
+			"id":        l.ID,
+			"path":      l.Path,
+			"locked_at": l.LockedAt,
+		}
+		if l.Owner != nil {
+			lock["owner"] = l.Owner.Name
+		}
+		locks = append(locks, lock)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repoName))
+	d.Set("locks", locks)
+
+	return nil
+}
+
+// lfsLocksURL returns the Git LFS locks endpoint of a repository. It is
+// served by the web host rather than the API, so it is derived from the
+// client's base URL for both github.com and GitHub Enterprise.
+func lfsLocksURL(client *github.Client, owner, repoName string) string {
+	u := *client.BaseURL
+	if u.Host == "api.github.com" {
+		u.Host = "github.com"
+	}
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/api/v3") + "/"
+
+	return fmt.Sprintf("%s%s/%s.git/info/lfs/locks", u.String(), owner, repoName)
+}
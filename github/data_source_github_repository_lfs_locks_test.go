@@ -0,0 +1,74 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestLfsLocksURL(t *testing.T) {
+	cases := []struct {
+		BaseURL  string
+		Expected string
+	}{
+		{
+			BaseURL:  "https://api.github.com/",
+			Expected: "https://github.com/octo/repo.git/info/lfs/locks",
+		},
+		{
+			BaseURL:  "https://ghe.example.com/api/v3/",
+			Expected: "https://ghe.example.com/octo/repo.git/info/lfs/locks",
+		},
+	}
+
+	for _, tc := range cases {
+		client := github.NewClient(nil)
+		u, err := url.Parse(tc.BaseURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.BaseURL = u
+
+		actual := lfsLocksURL(client, "octo", "repo")
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q, got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestAccGithubRepositoryLfsLocksDataSource_basic(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-lfs-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubRepositoryLfsLocksDataSourceConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.github_repository_lfs_locks.test", "locks.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubRepositoryLfsLocksDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+data "github_repository_lfs_locks" "test" {
+  repository = "${github_repository.test.name}"
+}
+`, repoName)
+}
@@ -0,0 +1,72 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubRepositoryLicense() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRepositoryLicenseRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spdx_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubRepositoryLicenseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Reading license of repository: %s/%s", owner, repoName)
+	license, _, err := client.Repositories.License(ctx, owner, repoName)
+	if err != nil {
+		// GitHub answers 404 for a repository without a license it detects,
+		// which leaves the attributes empty
+		ghErr, ok := err.(*github.ErrorResponse)
+		if !ok || ghErr.Response.StatusCode != http.StatusNotFound {
+			return err
+		}
+		log.Printf("[DEBUG] No license found in repository: %s/%s", owner, repoName)
+		license = &github.RepositoryLicense{}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repoName))
+	d.Set("path", license.GetPath())
+	d.Set("html_url", license.GetHTMLURL())
+	d.Set("key", license.GetLicense().GetKey())
+	d.Set("spdx_id", license.GetLicense().GetSPDXID())
+	d.Set("name", license.GetLicense().GetName())
+
+	return nil
+}
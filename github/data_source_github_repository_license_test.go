@@ -0,0 +1,51 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGithubRepositoryLicenseRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/example/api/license",
+			StatusCode:  200,
+			ResponseBody: `{"name": "LICENSE", "path": "LICENSE", "html_url": "https://github.com/example/api/blob/master/LICENSE",
+				"license": {"key": "apache-2.0", "name": "Apache License 2.0", "spdx_id": "Apache-2.0"}}`,
+		},
+		{
+			ExpectedUri:  "/repos/example/scratch/license",
+			StatusCode:   404,
+			ResponseBody: `{"message": "Not Found"}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubRepositoryLicense().Schema, map[string]interface{}{
+		"repository": "api",
+	})
+	if err := dataSourceGithubRepositoryLicenseRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Get("spdx_id"); v != "Apache-2.0" {
+		t.Fatalf("Expected the SPDX ID of the license, got %v", v)
+	}
+
+	// A repository without a license has none
+	d = schema.TestResourceDataRaw(t, dataSourceGithubRepositoryLicense().Schema, map[string]interface{}{
+		"repository": "scratch",
+	})
+	if err := dataSourceGithubRepositoryLicenseRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Get("spdx_id"); v != "" {
+		t.Fatalf("Expected no license, got %v", v)
+	}
+}
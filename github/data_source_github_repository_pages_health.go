@@ -0,0 +1,161 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type pagesHealthDomain struct {
+	Host                      *string `json:"host,omitempty"`
+	URI                       *string `json:"uri,omitempty"`
+	Nameservers               *string `json:"nameservers,omitempty"`
+	DNSResolves               *bool   `json:"dns_resolves,omitempty"`
+	IsProxied                 *bool   `json:"is_proxied,omitempty"`
+	IsCloudflareIP            *bool   `json:"is_cloudflare_ip,omitempty"`
+	IsFastlyIP                *bool   `json:"is_fastly_ip,omitempty"`
+	IsARecord                 *bool   `json:"is_a_record,omitempty"`
+	HasCNAMERecord            *bool   `json:"has_cname_record,omitempty"`
+	IsApexDomain              *bool   `json:"is_apex_domain,omitempty"`
+	ShouldBeARecord           *bool   `json:"should_be_a_record,omitempty"`
+	IsCNAMEToGithubUserDomain *bool   `json:"is_cname_to_github_user_domain,omitempty"`
+	IsPointedToPagesIP        *bool   `json:"is_pointed_to_github_pages_ip,omitempty"`
+	IsNonPagesIPPresent       *bool   `json:"is_non_github_pages_ip_present,omitempty"`
+	IsServedByPages           *bool   `json:"is_served_by_pages,omitempty"`
+	IsValid                   *bool   `json:"is_valid,omitempty"`
+	Reason                    *string `json:"reason,omitempty"`
+	RespondsToHTTPS           *bool   `json:"responds_to_https,omitempty"`
+	EnforcesHTTPS             *bool   `json:"enforces_https,omitempty"`
+	HTTPSError                *string `json:"https_error,omitempty"`
+	IsHTTPSEligible           *bool   `json:"is_https_eligible,omitempty"`
+	CAAError                  *string `json:"caa_error,omitempty"`
+}
+
+type pagesHealth struct {
+	Domain    *pagesHealthDomain `json:"domain,omitempty"`
+	AltDomain *pagesHealthDomain `json:"alt_domain,omitempty"`
+}
+
+// How long to wait for GitHub to finish a health check it has only queued
+const pagesHealthTimeout = 2 * time.Minute
+
+func dataSourceGithubRepositoryPagesHealth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRepositoryPagesHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"is_valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     pagesHealthDomainResource(),
+			},
+			"alt_domain": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     pagesHealthDomainResource(),
+			},
+		},
+	}
+}
+
+func pagesHealthDomainResource() *schema.Resource {
+	s := map[string]*schema.Schema{}
+	for _, k := range []string{"host", "uri", "nameservers", "reason", "https_error", "caa_error"} {
+		s[k] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+	for _, k := range []string{
+		"dns_resolves", "is_proxied", "is_cloudflare_ip", "is_fastly_ip",
+		"is_a_record", "has_cname_record", "is_apex_domain", "should_be_a_record",
+		"is_cname_to_github_user_domain", "is_pointed_to_github_pages_ip",
+		"is_non_github_pages_ip_present", "is_served_by_pages", "is_valid",
+		"responds_to_https", "enforces_https", "is_https_eligible",
+	} {
+		s[k] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{Schema: s}
+}
+
+func dataSourceGithubRepositoryPagesHealthRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	// The check only runs for custom domains, and GitHub answers with
+	// 202 Accepted until its result is available
+	log.Printf("[DEBUG] Reading Pages health check: %s/%s", owner, repoName)
+	health := new(pagesHealth)
+	err := resource.Retry(pagesHealthTimeout, func() *resource.RetryError {
+		_, err := apiRequest(ctx, client, "GET",
+			fmt.Sprintf("repos/%s/%s/pages/health", owner, repoName), nil, health)
+		if err != nil {
+			if _, ok := err.(*github.AcceptedError); ok {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repoName))
+	d.Set("is_valid", health.Domain != nil && health.Domain.IsValid != nil && *health.Domain.IsValid)
+	d.Set("domain", flattenPagesHealthDomain(health.Domain))
+	d.Set("alt_domain", flattenPagesHealthDomain(health.AltDomain))
+
+	return nil
+}
+
+func flattenPagesHealthDomain(h *pagesHealthDomain) []interface{} {
+	if h == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"host":                           h.Host,
+			"uri":                            h.URI,
+			"nameservers":                    h.Nameservers,
+			"reason":                         h.Reason,
+			"https_error":                    h.HTTPSError,
+			"caa_error":                      h.CAAError,
+			"dns_resolves":                   h.DNSResolves,
+			"is_proxied":                     h.IsProxied,
+			"is_cloudflare_ip":               h.IsCloudflareIP,
+			"is_fastly_ip":                   h.IsFastlyIP,
+			"is_a_record":                    h.IsARecord,
+			"has_cname_record":               h.HasCNAMERecord,
+			"is_apex_domain":                 h.IsApexDomain,
+			"should_be_a_record":             h.ShouldBeARecord,
+			"is_cname_to_github_user_domain": h.IsCNAMEToGithubUserDomain,
+			"is_pointed_to_github_pages_ip":  h.IsPointedToPagesIP,
+			"is_non_github_pages_ip_present": h.IsNonPagesIPPresent,
+			"is_served_by_pages":             h.IsServedByPages,
+			"is_valid":                       h.IsValid,
+			"responds_to_https":              h.RespondsToHTTPS,
+			"enforces_https":                 h.EnforcesHTTPS,
+			"is_https_eligible":              h.IsHTTPSEligible,
+		},
+	}
+}
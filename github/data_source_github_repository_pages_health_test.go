@@ -0,0 +1,43 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubRepositoryPagesHealthDataSource_basic(t *testing.T) {
+	// The health check requires Pages to be served from a custom domain
+	repoName := os.Getenv("GITHUB_TEST_PAGES_REPOSITORY")
+	if repoName == "" {
+		t.Skip("GITHUB_TEST_PAGES_REPOSITORY must be set for this acceptance test")
+	}
+	dsn := "data.github_repository_pages_health.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubRepositoryPagesHealthDataSourceConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsn, "domain.#", "1"),
+					resource.TestCheckResourceAttrSet(dsn, "domain.0.host"),
+					resource.TestCheckResourceAttrSet(dsn, "is_valid"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubRepositoryPagesHealthDataSourceConfig(repoName string) string {
+	return fmt.Sprintf(`
+data "github_repository_pages_health" "test" {
+  repository = "%s"
+}
+`, repoName)
+}
@@ -0,0 +1,192 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type ruleSuite struct {
+	ID               *int64     `json:"id,omitempty"`
+	ActorID          *int64     `json:"actor_id,omitempty"`
+	ActorName        *string    `json:"actor_name,omitempty"`
+	BeforeSHA        *string    `json:"before_sha,omitempty"`
+	AfterSHA         *string    `json:"after_sha,omitempty"`
+	Ref              *string    `json:"ref,omitempty"`
+	RepositoryID     *int64     `json:"repository_id,omitempty"`
+	RepositoryName   *string    `json:"repository_name,omitempty"`
+	PushedAt         *time.Time `json:"pushed_at,omitempty"`
+	Result           *string    `json:"result,omitempty"`
+	EvaluationResult *string    `json:"evaluation_result,omitempty"`
+}
+
+func dataSourceGithubRepositoryRuleSuites() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubRepositoryRuleSuitesRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"time_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "day",
+				ValidateFunc: validateValueFunc([]string{"hour", "day", "week", "month"}),
+			},
+			"actor_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"result": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validateValueFunc([]string{"pass", "fail", "bypass", "all"}),
+			},
+			"rule_suites": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"actor_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"actor_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"before_sha": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"after_sha": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ref": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repository_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pushed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"evaluation_result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"pass_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fail_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"bypass_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubRepositoryRuleSuitesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	query := url.Values{}
+	query.Set("time_period", d.Get("time_period").(string))
+	query.Set("rule_suite_result", d.Get("result").(string))
+	if v, ok := d.GetOk("ref"); ok {
+		query.Set("ref", v.(string))
+	}
+	if v, ok := d.GetOk("actor_name"); ok {
+		query.Set("actor_name", v.(string))
+	}
+
+	// Without a repository the rule suites of the organization's rulesets
+	// across all of its repositories are listed
+	baseURL := fmt.Sprintf("orgs/%s/rulesets/rule-suites", owner)
+	id := owner
+	if repoName, ok := d.GetOk("repository"); ok {
+		baseURL = fmt.Sprintf("repos/%s/%s/rulesets/rule-suites", owner, repoName.(string))
+		id = fmt.Sprintf("%s/%s", owner, repoName.(string))
+	} else {
+		err := checkOrganization(meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Reading rule suites: %s", id)
+	result, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		var page []*ruleSuite
+		resp, err := apiRequest(ctx, client, "GET", baseURL+"?"+pageQuery(query, opt), nil, &page)
+		return page, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	suites := []interface{}{}
+	counts := map[string]int{}
+	for _, v := range result {
+		s := v.(*ruleSuite)
+		pushedAt := ""
+		if s.PushedAt != nil {
+			pushedAt = s.PushedAt.Format(time.RFC3339)
+		}
+		if s.Result != nil {
+			counts[*s.Result]++
+		}
+
+		suites = append(suites, map[string]interface{}{
+			"id":                s.ID,
+			"actor_id":          s.ActorID,
+			"actor_name":        s.ActorName,
+			"before_sha":        s.BeforeSHA,
+			"after_sha":         s.AfterSHA,
+			"ref":               s.Ref,
+			"repository_name":   s.RepositoryName,
+			"pushed_at":         pushedAt,
+			"result":            s.Result,
+			"evaluation_result": s.EvaluationResult,
+		})
+	}
+
+	d.SetId(id)
+	d.Set("rule_suites", suites)
+	d.Set("pass_count", counts["pass"])
+	d.Set("fail_count", counts["fail"])
+	d.Set("bypass_count", counts["bypass"])
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubRepositoryRuleSuitesDataSource_basic(t *testing.T) {
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-rules-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+data "github_repository_rule_suites" "test" {
+  repository  = "${github_repository.test.name}"
+  time_period = "hour"
+}
+`, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.github_repository_rule_suites.test", "rule_suites.#", "0"),
+					resource.TestCheckResourceAttr("data.github_repository_rule_suites.test", "bypass_count", "0"),
+				),
+			},
+		},
+	})
+}
@@ -96,6 +96,11 @@ func testRepoCheck() resource.TestCheckFunc {
 		resource.TestCheckResourceAttr("data.github_repository.test", "topics.#", "2"),
 		resource.TestCheckResourceAttr("data.github_repository.test", "topics.0", "second-test-topic"),
 		resource.TestCheckResourceAttr("data.github_repository.test", "topics.1", "test-topic"),
+		resource.TestMatchResourceAttr("data.github_repository.test", "node_id", regexp.MustCompile("^.+$")),
+		resource.TestCheckResourceAttr("data.github_repository.test", "visibility", "public"),
+		resource.TestCheckResourceAttr("data.github_repository.test", "is_template", "false"),
+		resource.TestCheckResourceAttr("data.github_repository.test", "template.#", "0"),
+		resource.TestCheckResourceAttr("data.github_repository.test", "pages.#", "0"),
 	)
 }
 
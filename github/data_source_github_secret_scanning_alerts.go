@@ -0,0 +1,176 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// secretScanningAlert leaves out the secret of the alert, which shouldn't
+// end up in the state.
+type secretScanningAlert struct {
+	Number                int    `json:"number"`
+	State                 string `json:"state"`
+	SecretType            string `json:"secret_type"`
+	SecretTypeDisplayName string `json:"secret_type_display_name"`
+	Resolution            string `json:"resolution"`
+	HTMLURL               string `json:"html_url"`
+	CreatedAt             string `json:"created_at"`
+	// Only set in the listing of the organization
+	Repository *struct {
+		Name string `json:"name"`
+	} `json:"repository"`
+}
+
+func dataSourceGithubSecretScanningAlerts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubSecretScanningAlertsRead,
+
+		Schema: map[string]*schema.Schema{
+			// Lists the alerts of every repository of the organization
+			// unless a repository is given
+			"repository": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "open",
+				ValidateFunc: validateValueFunc([]string{"open", "resolved"}),
+			},
+			"secret_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resolutions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateValueFunc([]string{"false_positive", "wont_fix", "revoked", "pattern_edited", "pattern_deleted", "used_in_tests"}),
+				},
+			},
+			"max_results": maxResultsSchema(),
+			// Fails the read, and with it the plan, when any alert matches
+			"fail_on_alerts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"alerts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"repository": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_type_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resolution": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubSecretScanningAlertsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	alertsURL := fmt.Sprintf("orgs/%s/secret-scanning/alerts", owner)
+	id := owner
+	repoName, isRepository := d.GetOk("repository")
+	if isRepository {
+		alertsURL = fmt.Sprintf("repos/%s/%s/secret-scanning/alerts", owner, repoName.(string))
+		id = fmt.Sprintf("%s/%s", owner, repoName.(string))
+	} else {
+		err := checkOrganization(meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	query := url.Values{}
+	query.Set("state", d.Get("state").(string))
+	for key, param := range map[string]string{"secret_types": "secret_type", "resolutions": "resolution"} {
+		values := expandStringList(d.Get(key).(*schema.Set).List())
+		if len(values) > 0 {
+			sort.Strings(values)
+			query.Set(param, strings.Join(values, ","))
+		}
+	}
+
+	log.Printf("[DEBUG] Reading secret scanning alerts: %s (%s)", id, query.Get("state"))
+	result, err := listPages(meta, d.Get("max_results").(int), func(opt listOptions) (interface{}, *github.Response, error) {
+		var alerts []*secretScanningAlert
+		resp, err := apiRequest(ctx, client, "GET", alertsURL+"?"+pageQuery(query, opt), nil, &alerts)
+		return alerts, resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	alerts := []interface{}{}
+	for _, v := range result {
+		a := v.(*secretScanningAlert)
+		alertRepo := repoName
+		if a.Repository != nil {
+			alertRepo = a.Repository.Name
+		}
+		alerts = append(alerts, map[string]interface{}{
+			"number":                   a.Number,
+			"repository":               alertRepo,
+			"state":                    a.State,
+			"secret_type":              a.SecretType,
+			"secret_type_display_name": a.SecretTypeDisplayName,
+			"resolution":               a.Resolution,
+			"html_url":                 a.HTMLURL,
+			"created_at":               a.CreatedAt,
+		})
+	}
+
+	if d.Get("fail_on_alerts").(bool) && len(alerts) > 0 {
+		return fmt.Errorf("Found %d secret scanning alerts of %s matching the filters", len(alerts), id)
+	}
+
+	d.SetId(id)
+	d.Set("alerts", alerts)
+
+	return nil
+}
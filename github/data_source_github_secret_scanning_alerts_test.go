@@ -0,0 +1,35 @@
+package github
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGithubSecretScanningAlertsRead_failOnAlerts(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/orgs/example/secret-scanning/alerts?page=1&per_page=100&secret_type=aws_access_key_id%2Cgithub_personal_access_token&state=open",
+			StatusCode:  200,
+			ResponseBody: `[{"number": 3, "state": "open", "secret_type": "github_personal_access_token",
+				"secret_type_display_name": "GitHub Personal Access Token", "secret": "ghp_secret", "repository": {"name": "web"}}]`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubSecretScanningAlerts().Schema, map[string]interface{}{
+		"secret_types":   []interface{}{"github_personal_access_token", "aws_access_key_id"},
+		"fail_on_alerts": true,
+	})
+	err := dataSourceGithubSecretScanningAlertsRead(d, meta)
+	if err == nil || !strings.Contains(err.Error(), "Found 1 secret scanning alerts of example") {
+		t.Fatalf("Expected the read to fail because of the open alert, got %v", err)
+	}
+}
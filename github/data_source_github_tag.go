@@ -0,0 +1,64 @@
+package github
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubTag() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubTagRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tag": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sha": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tag_sha": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"annotated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubTagRead(d *schema.ResourceData, meta interface{}) error {
+	ref := "tags/" + d.Get("tag").(string)
+
+	resolved, err := resolveGithubRef(d, meta, ref)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resolved.ID)
+	d.Set("ref", "refs/"+ref)
+	d.Set("sha", resolved.SHA)
+	d.Set("tag_sha", resolved.TagSHA)
+	d.Set("annotated", resolved.TagSHA != "")
+	d.Set("node_id", resolved.NodeID)
+
+	return nil
+}
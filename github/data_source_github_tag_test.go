@@ -0,0 +1,38 @@
+package github
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubTagDataSource_annotated(t *testing.T) {
+	// Tags cannot be created by the provider, so the test reads an annotated
+	// tag of a public repository
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGithubTagDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.github_tag.test", "ref", "refs/tags/v0.12.0"),
+					resource.TestCheckResourceAttr("data.github_tag.test", "annotated", "true"),
+					resource.TestMatchResourceAttr("data.github_tag.test", "sha", regexp.MustCompile("^[0-9a-f]{40}$")),
+					resource.TestMatchResourceAttr("data.github_tag.test", "tag_sha", regexp.MustCompile("^[0-9a-f]{40}$")),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckGithubTagDataSourceConfig = `
+data "github_tag" "test" {
+  owner      = "hashicorp"
+  repository = "terraform"
+  tag        = "v0.12.0"
+}
+`
@@ -35,6 +35,10 @@ func dataSourceGithubTeam() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"members": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -49,25 +53,21 @@ func dataSourceGithubTeamRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Refreshing GitHub Team: %s", slug)
 
 	client := meta.(*Organization).client
-	ctx := context.Background()
+	ctx := stopContext(meta)
 
 	team, err := getGithubTeamBySlug(ctx, client, meta.(*Organization).name, slug)
 	if err != nil {
 		return err
 	}
 
-	member, _, err := client.Teams.ListTeamMembers(ctx, team.GetID(), nil)
+	members, err := listGithubTeamMembers(ctx, meta, team.GetID())
 	if err != nil {
 		return err
 	}
 
-	members := []string{}
-	for _, v := range member {
-		members = append(members, v.GetLogin())
-	}
-
 	d.SetId(strconv.FormatInt(team.GetID(), 10))
 	d.Set("name", team.GetName())
+	d.Set("node_id", team.GetNodeID())
 	d.Set("members", members)
 	d.Set("description", team.GetDescription())
 	d.Set("privacy", team.GetPrivacy())
@@ -76,6 +76,33 @@ func dataSourceGithubTeamRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// listGithubTeamMembers returns the logins of the members of the team with the
+// given ID.
+func listGithubTeamMembers(ctx context.Context, meta interface{}, teamID int64) ([]string, error) {
+	return listGithubTeamMembersWithRole(ctx, meta, teamID, "all")
+}
+
+// listGithubTeamMembersWithRole returns the logins of the members of the team
+// with the given ID holding role, which is member, maintainer or all.
+func listGithubTeamMembersWithRole(ctx context.Context, meta interface{}, teamID int64, role string) ([]string, error) {
+	users, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		var member []*github.User
+		resp, err := teamRequest(ctx, meta, "GET", teamID,
+			fmt.Sprintf("/members?role=%s&per_page=%d&page=%d", role, opt.PerPage, opt.Page), "", nil, &member)
+		return member, resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := []string{}
+	for _, v := range users {
+		members = append(members, v.(*github.User).GetLogin())
+	}
+
+	return members, nil
+}
+
 func getGithubTeamBySlug(ctx context.Context, client *github.Client, org string, slug string) (team *github.Team, err error) {
 	opt := &github.ListOptions{PerPage: 10}
 	for {
@@ -0,0 +1,98 @@
+package github
+
+import (
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubTeamMemberships() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubTeamMembershipsRead,
+
+		Schema: map[string]*schema.Schema{
+			"team_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"team_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"memberships": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"import_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubTeamMembershipsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	slug := d.Get("team_slug").(string)
+	ctx := stopContext(meta)
+
+	teamID, err := getTeamIDBySlug(meta, slug)
+	if err != nil {
+		return err
+	}
+	teamIDString := strconv.FormatInt(teamID, 10)
+
+	log.Printf("[DEBUG] Reading memberships of team: %s", slug)
+	members, err := listGithubTeamMembersWithRole(ctx, meta, teamID, "all")
+	if err != nil {
+		return err
+	}
+	maintainers, err := listGithubTeamMembersWithRole(ctx, meta, teamID, "maintainer")
+	if err != nil {
+		return err
+	}
+	isMaintainer := make(map[string]bool, len(maintainers))
+	for _, login := range maintainers {
+		isMaintainer[strings.ToLower(login)] = true
+	}
+
+	// Each membership comes with the ID github_team_membership imports it by
+	memberships := make([]interface{}, 0, len(members))
+	for _, login := range members {
+		username := login
+		role := "member"
+		if isMaintainer[strings.ToLower(login)] {
+			role = "maintainer"
+		}
+
+		memberships = append(memberships, map[string]interface{}{
+			"username":  login,
+			"role":      role,
+			"import_id": buildTwoPartID(&teamIDString, &username),
+		})
+	}
+
+	d.SetId(teamIDString)
+	d.Set("team_id", teamIDString)
+	d.Set("memberships", memberships)
+
+	return nil
+}
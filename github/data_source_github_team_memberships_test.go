@@ -0,0 +1,107 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceGithubTeamMembershipsRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/orgs/example/teams?per_page=10",
+			ResponseBody: `[{"id": 1234, "slug": "developers"}]`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/meta",
+			ResponseBody: `{}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/orgs/example",
+			ResponseBody: `{"id": 42, "login": "example"}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/organizations/42/team/1234/members?role=all&per_page=100&page=1",
+			ResponseBody: `[{"login": "octocat"}, {"login": "hubot"}]`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/organizations/42/team/1234/members?role=maintainer&per_page=100&page=1",
+			ResponseBody: `[{"login": "hubot"}]`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubTeamMemberships().Schema, map[string]interface{}{
+		"team_slug": "developers",
+	})
+	if err := dataSourceGithubTeamMembershipsRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []map[string]string{
+		{"username": "octocat", "role": "member", "import_id": "1234:octocat"},
+		{"username": "hubot", "role": "maintainer", "import_id": "1234:hubot"},
+	}
+	if n := d.Get("memberships.#").(int); n != len(expected) {
+		t.Fatalf("Expected %d memberships, got %d", len(expected), n)
+	}
+	for i, m := range expected {
+		for k, v := range m {
+			if got := d.Get(fmt.Sprintf("memberships.%d.%s", i, k)).(string); got != v {
+				t.Fatalf("Expected %s of membership %d to be %q, got %q", k, i, v, got)
+			}
+		}
+	}
+}
+
+func TestAccGithubTeamMembershipsDataSource_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	dn := "data.github_team_memberships.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubTeamMembershipsDataSourceConfig(randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dn, "team_id", "github_team.test", "id"),
+					// The creator of the team is a maintainer of it as well
+					resource.TestCheckResourceAttr(dn, "memberships.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGithubTeamMembershipsDataSourceConfig(randString string) string {
+	return fmt.Sprintf(`
+resource "github_team" "test" {
+  name = "tf-acc-test-memberships-%s"
+}
+
+resource "github_team_membership" "test" {
+  team_id  = "${github_team.test.id}"
+  username = %q
+}
+
+data "github_team_memberships" "test" {
+  team_slug  = "${github_team.test.slug}"
+  depends_on = ["github_team_membership.test"]
+}
+`, randString, testCollaborator)
+}
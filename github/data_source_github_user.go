@@ -1,7 +1,6 @@
 package github
 
 import (
-	"context"
 	"log"
 	"strconv"
 
@@ -83,6 +82,10 @@ func dataSourceGithubUser() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -100,7 +103,7 @@ func dataSourceGithubUserRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Refreshing GitHub User: %s", username)
 
 	client := meta.(*Organization).client
-	ctx := context.Background()
+	ctx := stopContext(meta)
 
 	user, _, err := client.Users.Get(ctx, username)
 	if err != nil {
@@ -128,6 +131,7 @@ func dataSourceGithubUserRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(strconv.FormatInt(user.GetID(), 10))
 	d.Set("login", user.GetLogin())
+	d.Set("node_id", user.GetNodeID())
 	d.Set("avatar_url", user.GetAvatarURL())
 	d.Set("gravatar_id", user.GetGravatarID())
 	d.Set("site_admin", user.GetSiteAdmin())
@@ -0,0 +1,108 @@
+package github
+
+import (
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGithubUserInvitations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubUserInvitationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"invitation_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"invitations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repository": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inviter": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permissions": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubUserInvitationsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	ctx := stopContext(meta)
+
+	// The repository is given as its full name, since invitations can come
+	// from any owner
+	repository := d.Get("repository").(string)
+
+	log.Printf("[DEBUG] Reading pending repository invitations")
+	result, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		return client.Users.ListInvitations(ctx, &opt.ListOptions)
+	})
+	if err != nil {
+		return err
+	}
+
+	ids := []string{}
+	invitations := []interface{}{}
+	for _, v := range result {
+		inv := v.(*github.RepositoryInvitation)
+		fullName := inv.GetRepo().GetFullName()
+		if repository != "" && !strings.EqualFold(repository, fullName) {
+			continue
+		}
+
+		id := strconv.FormatInt(inv.GetID(), 10)
+		invitation := map[string]interface{}{
+			"id":          id,
+			"repository":  fullName,
+			"inviter":     inv.GetInviter().GetLogin(),
+			"permissions": inv.GetPermissions(),
+		}
+		if inv.CreatedAt != nil {
+			invitation["created_at"] = inv.CreatedAt.Format(time.RFC3339)
+		}
+		ids = append(ids, id)
+		invitations = append(invitations, invitation)
+	}
+
+	if repository != "" {
+		d.SetId(repository)
+	} else {
+		d.SetId(resource.UniqueId())
+	}
+	d.Set("invitation_ids", ids)
+	d.Set("invitations", invitations)
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccGithubUserInvitationsDataSource_basic(t *testing.T) {
+	repoName := fmt.Sprintf("tf-acc-test-invitations-%s", acctest.RandString(5))
+
+	inviteeToken := os.Getenv("GITHUB_TEST_COLLABORATOR_TOKEN")
+	if inviteeToken == "" {
+		t.Skip("GITHUB_TEST_COLLABORATOR_TOKEN was not provided, skipping test")
+	}
+
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubUserInvitationsDataSourceConfig(inviteeToken, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.github_user_invitations.test", "invitations.#", "1"),
+					resource.TestCheckResourceAttr("data.github_user_invitations.test", "invitations.0.permissions", "write"),
+					resource.TestCheckResourceAttrPair("data.github_user_invitations.test", "invitation_ids.0",
+						"github_repository_collaborator.test", "invitation_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGithubUserInvitationsDataSourceConfig(inviteeToken, repoName string) string {
+	return fmt.Sprintf(`
+provider "github" {
+  alias = "main"
+}
+
+provider "github" {
+  alias = "invitee"
+  token = "%s"
+}
+
+resource "github_repository" "test" {
+  provider = "github.main"
+  name     = "%s"
+}
+
+resource "github_repository_collaborator" "test" {
+  provider   = "github.main"
+  repository = "${github_repository.test.name}"
+  username   = "%s"
+  permission = "push"
+}
+
+data "github_user_invitations" "test" {
+  provider   = "github.invitee"
+  repository = "${github_repository.test.full_name}"
+  depends_on = ["github_repository_collaborator.test"]
+}
+`, inviteeToken, repoName, testCollaborator)
+}
@@ -0,0 +1,112 @@
+package github
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// forOwner returns the meta of the provider for managing objects of the
+// given organization rather than the configured one, sharing its client.
+// The meta of each owner is kept so that what it caches, such as the routes
+// of the Teams API, is only looked up once.
+func (o *Organization) forOwner(owner string) *Organization {
+	if owner == "" || owner == o.name {
+		return o
+	}
+
+	o.ownersLock.Lock()
+	defer o.ownersLock.Unlock()
+
+	if o.owners == nil {
+		o.owners = map[string]*Organization{}
+	}
+	if org, ok := o.owners[owner]; ok {
+		return org
+	}
+
+	org := &Organization{
+		name:                owner,
+		client:              o.client,
+		StopContext:         o.StopContext,
+		organizationOptions: o.organizationOptions,
+	}
+	// Other owners are organizations
+	org.individual = false
+	org.persistLookups(o.lookupCacheFile)
+	o.owners[owner] = org
+
+	return org
+}
+
+// ownerMeta returns the meta for the owner an object is configured with.
+func ownerMeta(owner interface{}, meta interface{}) interface{} {
+	if s, ok := owner.(string); ok {
+		return meta.(*Organization).forOwner(s)
+	}
+	return meta
+}
+
+// ownerResources adds an owner argument, which overrides the organization of
+// the provider, to every resource and data source which does not have an
+// argument of that name already.
+func ownerResources(resources map[string]*schema.Resource, dataSource bool) {
+	for _, r := range resources {
+		if _, ok := r.Schema["owner"]; ok {
+			continue
+		}
+
+		r.Schema["owner"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    !dataSource,
+			Description: "The organization which owns the object, if not the organization of the provider.",
+		}
+
+		r.Create = ownerOperation(r.Create)
+		r.Read = ownerOperation(r.Read)
+		r.Update = ownerOperation(r.Update)
+		r.Delete = ownerOperation(r.Delete)
+		if r.Importer != nil && r.Importer.State != nil {
+			state := r.Importer.State
+			r.Importer = &schema.ResourceImporter{
+				State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+					// Imports are not configured, so the owner is named by
+					// the import ID
+					if owner, id, ok := parseImportOwner(d.Id()); ok {
+						d.SetId(id)
+						d.Set("owner", owner)
+					}
+					return state(d, ownerMeta(d.Get("owner"), meta))
+				},
+			}
+		}
+		if r.CustomizeDiff != nil {
+			diff := r.CustomizeDiff
+			r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+				return diff(d, ownerMeta(d.Get("owner"), meta))
+			}
+		}
+	}
+}
+
+// parseImportOwner splits an import ID of the form `owner::id` into the owner
+// and the ID of the resource. The separator is doubled as the IDs of many
+// resources have parts separated by single colons, or slashes.
+func parseImportOwner(id string) (string, string, bool) {
+	parts := strings.SplitN(id, "::", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+func ownerOperation(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		return f(d, ownerMeta(d.Get("owner"), meta))
+	}
+}
@@ -0,0 +1,96 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestOwnerResources(t *testing.T) {
+	var seen string
+	resources := map[string]*schema.Resource{
+		"github_example": {
+			Schema: map[string]*schema.Schema{},
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				seen = meta.(*Organization).name
+				return nil
+			},
+			Importer: &schema.ResourceImporter{
+				State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+					seen = meta.(*Organization).name
+					return []*schema.ResourceData{d}, nil
+				},
+			},
+		},
+		"github_owned": {
+			Schema: map[string]*schema.Schema{
+				"owner": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+	ownerResources(resources, true)
+
+	if resources["github_owned"].Schema["owner"].Required != true {
+		t.Fatal("Expected an existing owner argument to be kept")
+	}
+
+	meta := &Organization{name: "provider-org", organizationOptions: organizationOptions{conditionalRequests: true, maxConcurrentRequests: 4}}
+	r := resources["github_example"]
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	if err := r.Read(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if seen != "provider-org" {
+		t.Fatalf("Expected the organization of the provider, got %q", seen)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"owner": "other-org"})
+	if err := r.Read(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if seen != "other-org" {
+		t.Fatalf("Expected the overriding organization, got %q", seen)
+	}
+
+	// Imports name the owner in their ID
+	seen = ""
+	d = r.Data(nil)
+	d.SetId("other-org::example:42")
+	if _, err := r.Importer.State(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if seen != "other-org" || d.Get("owner").(string) != "other-org" || d.Id() != "example:42" {
+		t.Fatalf("Expected the owner of the import ID to import, got %q with owner %q and ID %q", seen, d.Get("owner"), d.Id())
+	}
+
+	d = r.Data(nil)
+	d.SetId("example:42")
+	if _, err := r.Importer.State(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if seen != "provider-org" || d.Get("owner").(string) != "" {
+		t.Fatalf("Expected the organization of the provider to import, got %q", seen)
+	}
+
+	if meta.forOwner("other-org") != meta.forOwner("other-org") {
+		t.Fatal("Expected the meta of an owner to be reused")
+	}
+	if meta.forOwner("provider-org") != meta {
+		t.Fatal("Expected the meta of the provider for its own organization")
+	}
+	if meta.forOwner("other-org").conditionalRequests != meta.conditionalRequests {
+		t.Fatal("Expected the meta of an owner to make the same conditional requests")
+	}
+	if meta.forOwner("other-org").maxConcurrentRequests != meta.maxConcurrentRequests {
+		t.Fatal("Expected the meta of an owner to make as many concurrent requests")
+	}
+
+	individual := &Organization{name: "octocat", organizationOptions: organizationOptions{individual: true, perPage: 10}}
+	if org := individual.forOwner("other-org"); org.individual || org.perPage != 10 {
+		t.Fatal("Expected the meta of an owner to be an organization with the options of the provider")
+	}
+}
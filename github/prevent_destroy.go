@@ -0,0 +1,51 @@
+package github
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// preventDestroy adds prevent_destroy_api_side and allow_destroy to r, and
+// refuses to delete what it manages while either prevent_destroy_api_side or
+// the prevent_destroy of the provider is set, unless allow_destroy is. Unlike
+// the lifecycle of Terraform, this holds for every configuration the resource
+// is removed from. destroys tells whether a delete destroys anything, if it
+// does not always.
+func preventDestroy(r *schema.Resource, destroys func(*schema.ResourceData) bool) *schema.Resource {
+	r.Schema["prevent_destroy_api_side"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Refuse to destroy the object unless allow_destroy is set.",
+	}
+	r.Schema["allow_destroy"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Destroy the object even if prevent_destroy_api_side or the prevent_destroy of the provider is set.",
+	}
+
+	read := r.Read
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		if err := read(d, meta); err != nil || d.Id() == "" {
+			return err
+		}
+
+		// Imported objects get the defaults
+		d.Set("prevent_destroy_api_side", d.Get("prevent_destroy_api_side").(bool))
+		d.Set("allow_destroy", d.Get("allow_destroy").(bool))
+		return nil
+	}
+
+	del := r.Delete
+	r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+		prevented := meta.(*Organization).preventDestroy || d.Get("prevent_destroy_api_side").(bool)
+		if prevented && !d.Get("allow_destroy").(bool) && (destroys == nil || destroys(d)) {
+			return fmt.Errorf("destroying it is prevented; apply allow_destroy = true before destroying it")
+		}
+		return del(d, meta)
+	}
+
+	return r
+}
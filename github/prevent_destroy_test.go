@@ -0,0 +1,68 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestPreventDestroy(t *testing.T) {
+	deleted := false
+	r := preventDestroy(&schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			deleted = true
+			return nil
+		},
+		Schema: map[string]*schema.Schema{},
+	}, nil)
+
+	cases := []struct {
+		preventDestroy bool
+		config         map[string]interface{}
+		deleted        bool
+	}{
+		{false, map[string]interface{}{}, true},
+		{true, map[string]interface{}{}, false},
+		{false, map[string]interface{}{"prevent_destroy_api_side": true}, false},
+		{true, map[string]interface{}{"allow_destroy": true}, true},
+		{false, map[string]interface{}{"prevent_destroy_api_side": true, "allow_destroy": true}, true},
+	}
+	for _, c := range cases {
+		deleted = false
+		meta := &Organization{name: "example", organizationOptions: organizationOptions{preventDestroy: c.preventDestroy}}
+		d := schema.TestResourceDataRaw(t, r.Schema, c.config)
+		d.SetId("example")
+
+		err := r.Delete(d, meta)
+		if deleted != c.deleted || (err == nil) != c.deleted {
+			t.Fatalf("Expected a delete with prevent_destroy %t and %v to delete %t, got %t and %v",
+				c.preventDestroy, c.config, c.deleted, deleted, err)
+		}
+	}
+}
+
+func TestPreventDestroy_archivedRepository(t *testing.T) {
+	r := preventDestroy(resourceGithubRepository(&repositoryDefaults{}), repositoryDestroyed)
+	meta := &Organization{name: "example", organizationOptions: organizationOptions{preventDestroy: true}}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name": "example",
+	})
+	d.SetId("example")
+	if err := r.Delete(d, meta); err == nil {
+		t.Fatal("Expected deleting the repository to be prevented")
+	}
+
+	// Archiving the repository instead destroys nothing, so it is not
+	// prevented; the archived repository is left in place
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":               "example",
+		"archive_on_destroy": true,
+		"archived":           true,
+	})
+	d.SetId("example")
+	if err := r.Delete(d, meta); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,11 +1,18 @@
 package github
 
 import (
+	"fmt"
+	"regexp"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func Provider() terraform.ResourceProvider {
+	defaults := &repositoryDefaults{}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"token": {
@@ -43,40 +50,213 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				Description: descriptions["anonymous"],
 			},
+			"api_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("GITHUB_API_VERSION", ""),
+				Description:  descriptions["api_version"],
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date such as 2022-11-28"),
+			},
+			"audit_log_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GITHUB_AUDIT_LOG_FILE", ""),
+				Description: descriptions["audit_log_file"],
+			},
+			"disable_conditional_requests": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GITHUB_DISABLE_CONDITIONAL_REQUESTS", false),
+				Description: descriptions["disable_conditional_requests"],
+			},
+			"validate_references": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GITHUB_VALIDATE_REFERENCES", false),
+				Description: descriptions["validate_references"],
+			},
+			"log_requests": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GITHUB_LOG_REQUESTS", false),
+				Description: descriptions["log_requests"],
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("GITHUB_MAX_CONCURRENT_REQUESTS", 1),
+				Description:  descriptions["max_concurrent_requests"],
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"per_page": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("GITHUB_PER_PAGE", maxPerPage),
+				Description:  descriptions["per_page"],
+				ValidateFunc: validation.IntBetween(1, maxPerPage),
+			},
+			"prevent_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GITHUB_PREVENT_DESTROY", false),
+				Description: descriptions["prevent_destroy"],
+			},
+			"eventual_consistency_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("GITHUB_EVENTUAL_CONSISTENCY_TIMEOUT", "1m"),
+				Description:  descriptions["eventual_consistency_timeout"],
+				ValidateFunc: validateDuration,
+			},
+			"lookup_cache_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("GITHUB_LOOKUP_CACHE_FILE", ""),
+				Description: descriptions["lookup_cache_file"],
+			},
+			"lookup_cache_ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("GITHUB_LOOKUP_CACHE_TTL", "24h"),
+				Description:  descriptions["lookup_cache_ttl"],
+				ValidateFunc: validateDuration,
+			},
+			"repository_defaults": repositoryDefaultsSchema(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"github_branch_protection":        resourceGithubBranchProtection(),
-			"github_issue_label":              resourceGithubIssueLabel(),
-			"github_membership":               resourceGithubMembership(),
-			"github_organization_block":       resourceOrganizationBlock(),
-			"github_organization_project":     resourceGithubOrganizationProject(),
-			"github_organization_webhook":     resourceGithubOrganizationWebhook(),
-			"github_project_column":           resourceGithubProjectColumn(),
-			"github_repository_collaborator":  resourceGithubRepositoryCollaborator(),
-			"github_repository_deploy_key":    resourceGithubRepositoryDeployKey(),
-			"github_repository_project":       resourceGithubRepositoryProject(),
-			"github_repository_webhook":       resourceGithubRepositoryWebhook(),
-			"github_repository":               resourceGithubRepository(),
-			"github_team_membership":          resourceGithubTeamMembership(),
-			"github_team_repository":          resourceGithubTeamRepository(),
-			"github_team":                     resourceGithubTeam(),
-			"github_user_gpg_key":             resourceGithubUserGpgKey(),
-			"github_user_invitation_accepter": resourceGithubUserInvitationAccepter(),
-			"github_user_ssh_key":             resourceGithubUserSshKey(),
+			"github_actions_organization_oidc_subject_claim_customization_template": requireOrganization(resourceGithubActionsOrganizationOidcSubjectClaimCustomizationTemplate()),
+			"github_actions_organization_permissions":                               requireOrganization(resourceGithubActionsOrganizationPermissions()),
+			"github_actions_organization_workflow_permissions":                      requireOrganization(resourceGithubActionsOrganizationWorkflowPermissions()),
+			"github_actions_repository_oidc_subject_claim_customization_template":   resourceGithubActionsRepositoryOidcSubjectClaimCustomizationTemplate(),
+			"github_actions_repository_permissions":                                 resourceGithubActionsRepositoryPermissions(),
+			"github_actions_repository_workflow_permissions":                        resourceGithubActionsRepositoryWorkflowPermissions(),
+			"github_actions_runner_group":                                           requireOrganization(resourceGithubActionsRunnerGroup()),
+			"github_actions_workflow_dispatch":                                      resourceGithubActionsWorkflowDispatch(),
+			"github_app":                                                            resourceGithubApp(),
+			"github_branch_protection_v3":                                           resourceGithubBranchProtectionV3(),
+			"github_branch_protection":                                              resourceGithubBranchProtection(),
+			"github_code_scanning_default_setup":                                    resourceGithubCodeScanningDefaultSetup(),
+			"github_codespaces_organization_secret":                                 requireOrganization(resourceGithubCodespacesOrganizationSecret()),
+			"github_codespaces_organization_settings":                               requireOrganization(resourceGithubCodespacesOrganizationSettings()),
+			"github_codespaces_secret":                                              resourceGithubCodespacesSecret(),
+			"github_codespaces_user_secret":                                         resourceGithubCodespacesUserSecret(),
+			"github_deployment_status":                                              resourceGithubDeploymentStatus(),
+			"github_deployment":                                                     resourceGithubDeployment(),
+			"github_emu_group_mapping":                                              requireOrganization(checkReferences(resourceGithubEmuGroupMapping(), "team_slug", "")),
+			"github_enterprise_actions_permissions":                                 resourceGithubEnterpriseActionsPermissions(),
+			"github_enterprise_actions_runner_group":                                resourceGithubEnterpriseActionsRunnerGroup(),
+			"github_enterprise_pre_receive_environment":                             requireEnterpriseServer(resourceGithubEnterprisePreReceiveEnvironment()),
+			"github_enterprise_pre_receive_hook":                                    requireEnterpriseServer(resourceGithubEnterprisePreReceiveHook()),
+			"github_interaction_limit":                                              resourceGithubInteractionLimit(),
+			"github_issue_label":                                                    resourceGithubIssueLabel(),
+			"github_membership":                                                     preventDestroy(requireOrganization(checkReferences(resourceGithubMembership(), "", "username")), membershipDestroyed),
+			"github_organization_announcement":                                      resourceGithubOrganizationAnnouncement(),
+			"github_organization_block":                                             requireOrganization(checkReferences(resourceOrganizationBlock(), "", "username")),
+			"github_organization_custom_property":                                   requireOrganization(resourceGithubOrganizationCustomProperty()),
+			"github_organization_ip_allow_list_entry":                               resourceGithubOrganizationIpAllowListEntry(),
+			"github_organization_ip_allow_list":                                     resourceGithubOrganizationIpAllowList(),
+			"github_organization_moderators":                                        requireOrganization(checkReferences(resourceGithubOrganizationModerators(), "teams", "users")),
+			"github_organization_pre_receive_hook":                                  requireEnterpriseServer(requireOrganization(resourceGithubOrganizationPreReceiveHook())),
+			"github_organization_profile_readme":                                    requireOrganization(resourceGithubOrganizationProfileReadme()),
+			"github_organization_project":                                           requireOrganization(resourceGithubOrganizationProject()),
+			"github_organization_role_team":                                         requireOrganization(checkReferences(resourceGithubOrganizationRoleTeam(), "team_slug", "")),
+			"github_organization_role_user":                                         requireOrganization(checkReferences(resourceGithubOrganizationRoleUser(), "", "login")),
+			"github_organization_ruleset":                                           requireOrganization(resourceGithubOrganizationRuleset()),
+			"github_organization_scim_user_deprovision":                             requireOrganization(resourceGithubOrganizationScimUserDeprovision()),
+			"github_organization_secret_scanning":                                   requireOrganization(resourceGithubOrganizationSecretScanning()),
+			"github_organization_ssh_certificate_authority":                         requireOrganization(resourceGithubOrganizationSshCertificateAuthority()),
+			"github_organization_webhook":                                           requireOrganization(resourceGithubOrganizationWebhook()),
+			"github_package_retention":                                              resourceGithubPackageRetention(),
+			"github_project_column":                                                 resourceGithubProjectColumn(),
+			"github_repository_check_run":                                           resourceGithubRepositoryCheckRun(),
+			"github_repository_collaborators":                                       resourceGithubRepositoryCollaborators(),
+			"github_repository_collaborator":                                        checkReferences(resourceGithubRepositoryCollaborator(), "", "username"),
+			"github_repository_commit_status":                                       resourceGithubRepositoryCommitStatus(),
+			"github_repository_community_files":                                     resourceGithubRepositoryCommunityFiles(),
+			"github_repository_custom_property":                                     requireOrganization(resourceGithubRepositoryCustomProperty()),
+			"github_repository_deploy_key":                                          resourceGithubRepositoryDeployKey(),
+			"github_repository_deployment_branch_policy":                            resourceGithubRepositoryDeploymentBranchPolicy(),
+			"github_repository_environment":                                         resourceGithubRepositoryEnvironment(),
+			"github_repository_import_lfs":                                          resourceGithubRepositoryImportLfs(),
+			"github_repository_policy":                                              requireOrganization(resourceGithubRepositoryPolicy()),
+			"github_repository_pre_receive_hook":                                    requireEnterpriseServer(resourceGithubRepositoryPreReceiveHook()),
+			"github_repository_project":                                             resourceGithubRepositoryProject(),
+			"github_repository_secret_scanning":                                     resourceGithubRepositorySecretScanning(),
+			"github_repository_subscription":                                        resourceGithubRepositorySubscription(),
+			"github_repository_transfer":                                            resourceGithubRepositoryTransfer(),
+			"github_repository_webhook":                                             resourceGithubRepositoryWebhook(),
+			"github_repository_workflow":                                            resourceGithubRepositoryWorkflow(),
+			"github_repository":                                                     preventDestroy(resourceGithubRepository(defaults), repositoryDestroyed),
+			"github_team_membership":                                                requireOrganization(checkReferences(resourceGithubTeamMembership(), "team_id", "username")),
+			"github_team_repository":                                                requireOrganization(checkReferences(resourceGithubTeamRepository(), "team_id", "")),
+			"github_team":                                                           preventDestroy(requireOrganization(resourceGithubTeam()), nil),
+			"github_user_gpg_key":                                                   resourceGithubUserGpgKey(),
+			"github_user_invitation_accepter":                                       resourceGithubUserInvitationAccepter(),
+			"github_user_ssh_key":                                                   resourceGithubUserSshKey(),
+			"github_user_starred_repository":                                        resourceGithubUserStarredRepository(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"github_collaborators": dataSourceGithubCollaborators(),
-			"github_ip_ranges":     dataSourceGithubIpRanges(),
-			"github_repositories":  dataSourceGithubRepositories(),
-			"github_repository":    dataSourceGithubRepository(),
-			"github_team":          dataSourceGithubTeam(),
-			"github_user":          dataSourceGithubUser(),
+			"github_actions_environment_public_key":      dataSourceGithubActionsEnvironmentPublicKey(),
+			"github_actions_public_key":                  dataSourceGithubActionsPublicKey(),
+			"github_actions_runner_registration_token":   dataSourceGithubActionsRunnerRegistrationToken(),
+			"github_actions_runner_usage":                dataSourceGithubActionsRunnerUsage(),
+			"github_actions_secrets_inventory":           dataSourceGithubActionsSecretsInventory(),
+			"github_app_installation_token":              dataSourceGithubAppInstallationToken(),
+			"github_app_installation":                    dataSourceGithubAppInstallation(),
+			"github_app":                                 dataSourceGithubApp(),
+			"github_branch":                              dataSourceGithubBranch(),
+			"github_code_scanning_alerts":                dataSourceGithubCodeScanningAlerts(),
+			"github_codespaces_machines":                 dataSourceGithubCodespacesMachines(),
+			"github_codespaces_public_key":               dataSourceGithubCodespacesPublicKey(),
+			"github_collaborators":                       dataSourceGithubCollaborators(),
+			"github_dependabot_public_key":               dataSourceGithubDependabotPublicKey(),
+			"github_dependency_graph_sbom":               dataSourceGithubDependencyGraphSBOM(),
+			"github_enterprise_consumed_licenses":        dataSourceGithubEnterpriseConsumedLicenses(),
+			"github_enterprise_license":                  dataSourceGithubEnterpriseLicense(),
+			"github_external_group":                      dataSourceGithubExternalGroup(),
+			"github_ip_ranges":                           dataSourceGithubIpRanges(),
+			"github_organization_audit_log":              dataSourceGithubOrganizationAuditLog(),
+			"github_organization_custom_property_values": dataSourceGithubOrganizationCustomPropertyValues(),
+			"github_organization_dependabot_alerts":      dataSourceGithubOrganizationDependabotAlerts(),
+			"github_organization_external_identities":    dataSourceGithubOrganizationExternalIdentities(),
+			"github_organization_scim_user":              dataSourceGithubOrganizationScimUser(),
+			"github_organization_security_defaults":      dataSourceGithubOrganizationSecurityDefaults(),
+			"github_organization_teams":                  dataSourceGithubOrganizationTeams(),
+			"github_ref":                                 dataSourceGithubRef(),
+			"github_release":                             dataSourceGithubRelease(),
+			"github_repositories":                        dataSourceGithubRepositories(),
+			"github_repository_attestations":             dataSourceGithubRepositoryAttestations(),
+			"github_repository_community_health_files":   dataSourceGithubRepositoryCommunityHealthFiles(),
+			"github_repository_community_profile":        dataSourceGithubRepositoryCommunityProfile(),
+			"github_repository_lfs_locks":                dataSourceGithubRepositoryLfsLocks(),
+			"github_repository_license":                  dataSourceGithubRepositoryLicense(),
+			"github_repository_pages_health":             dataSourceGithubRepositoryPagesHealth(),
+			"github_repository_rule_suites":              dataSourceGithubRepositoryRuleSuites(),
+			"github_repository":                          dataSourceGithubRepository(),
+			"github_secret_scanning_alerts":              dataSourceGithubSecretScanningAlerts(),
+			"github_tag":                                 dataSourceGithubTag(),
+			"github_team_memberships":                    dataSourceGithubTeamMemberships(),
+			"github_team":                                dataSourceGithubTeam(),
+			"github_user_invitations":                    dataSourceGithubUserInvitations(),
+			"github_user":                                dataSourceGithubUser(),
 		},
 	}
 
-	p.ConfigureFunc = providerConfigure(p)
+	// Errors are described before they are audited, so the audit log
+	// records them in full
+	apiErrorResources(p.ResourcesMap, false)
+	apiErrorResources(p.DataSourcesMap, true)
+
+	// Operations are audited for the owner they apply to
+	timeoutResources(p.ResourcesMap)
+	conditionalReadResources(p.ResourcesMap)
+	auditResources(p.ResourcesMap)
+	ownerResources(p.ResourcesMap, false)
+	ownerResources(p.DataSourcesMap, true)
+
+	p.ConfigureFunc = providerConfigure(p, defaults)
 
 	return p
 }
@@ -103,11 +283,56 @@ func init() {
 		"anonymous": "Authenticate without a token.  When `anonymous`" +
 			"is true, the provider will not be able to access resources" +
 			"that require authentication.",
+
+		"api_version": "The version of the GitHub REST API to pin " +
+			"requests to, e.g. `2022-11-28`.",
+
+		"audit_log_file": "The path of a file which a JSON record of " +
+			"every create, update and delete operation is appended to.",
+
+		"disable_conditional_requests": "Read every resource in full, rather than " +
+			"only those which changed since they were last read.",
+
+		"validate_references": "Check that the teams and users resources refer " +
+			"to exist while planning.",
+
+		"log_requests": "Log every API request at TRACE level, and a " +
+			"summary of the requests once Terraform is done with the provider.",
+
+		"max_concurrent_requests": "How many read requests may be made at " +
+			"once, e.g. to list the pages of large teams concurrently.",
+
+		"per_page": "How many items are requested per page of the lists " +
+			"the provider reads.",
+
+		"prevent_destroy": "Refuse to destroy repositories, teams and " +
+			"memberships unless they set allow_destroy.",
+
+		"eventual_consistency_timeout": "How long requests are retried while " +
+			"GitHub does not find what the provider just created, e.g. `30s`.",
+
+		"lookup_cache_file": "The path of a file which the IDs of teams, " +
+			"repositories and users are kept in across runs, keyed by their name.",
+
+		"lookup_cache_ttl": "How long the IDs kept in lookup_cache_file are " +
+			"used before they are resolved again, e.g. `24h`.",
+
+		"repository_defaults": "Settings inherited by every `github_repository` " +
+			"which does not set them itself.",
 	}
 }
 
-func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
+func providerConfigure(p *schema.Provider, defaults *repositoryDefaults) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
+		lookupCacheTTL, err := time.ParseDuration(d.Get("lookup_cache_ttl").(string))
+		if err != nil {
+			return nil, fmt.Errorf("lookup_cache_ttl: %s", err)
+		}
+		consistencyTimeout, err := time.ParseDuration(d.Get("eventual_consistency_timeout").(string))
+		if err != nil {
+			return nil, fmt.Errorf("eventual_consistency_timeout: %s", err)
+		}
+
 		config := Config{
 			Token:        d.Get("token").(string),
 			Organization: d.Get("organization").(string),
@@ -115,6 +340,21 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			Insecure:     d.Get("insecure").(bool),
 			Individual:   d.Get("individual").(bool),
 			Anonymous:    d.Get("anonymous").(bool),
+			APIVersion:   d.Get("api_version").(string),
+			AuditLogFile: d.Get("audit_log_file").(string),
+
+			DisableConditionalRequests: d.Get("disable_conditional_requests").(bool),
+			ValidateReferences:         d.Get("validate_references").(bool),
+			LogRequests:                d.Get("log_requests").(bool),
+			MaxConcurrentRequests:      d.Get("max_concurrent_requests").(int),
+			PerPage:                    d.Get("per_page").(int),
+			ConsistencyTimeout:         consistencyTimeout,
+			PreventDestroy:             d.Get("prevent_destroy").(bool),
+
+			LookupCacheFile: d.Get("lookup_cache_file").(string),
+			LookupCacheTTL:  lookupCacheTTL,
+
+			StopContext: p.StopContext(),
 		}
 
 		meta, err := config.Client()
@@ -122,7 +362,8 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			return nil, err
 		}
 
-		meta.(*Organization).StopContext = p.StopContext()
+		defaults.configure(d)
+		meta.(*Organization).repositoryDefaults = defaults
 
 		return meta, nil
 	}
@@ -106,6 +106,37 @@ func TestProvider_individual(t *testing.T) {
 	})
 }
 
+func TestProvider_individualRepository(t *testing.T) {
+	repoName := fmt.Sprintf("tf-acc-test-individual-%d", rand.Int())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// Repositories of an individual account are owned by the authenticated user
+				Config: configProviderOrganization("", true) + fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+`, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository.test", "full_name", fmt.Sprintf("%s/%s", testUser, repoName)),
+				),
+			},
+			{
+				// Resources which only exist within an organization are reported when planning
+				Config:             configProviderOrganization("", true) + testAccGithubMembershipConfig(testCollaborator),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ExpectError:        regexp.MustCompile("This resource requires GitHub organization to be set on the provider."),
+			},
+		},
+	})
+}
+
 func TestProvider_anonymous(t *testing.T) {
 
 	username := "hashibot"
@@ -0,0 +1,89 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// checkReferences makes r check, when validate_references is set, that the
+// teams and users it refers to exist while planning, so a typo fails the plan
+// rather than the apply after other resources were changed. teamKey names the
+// attribute holding team IDs or slugs and userKey the one holding user logins,
+// either as a string or a set of strings, or "" for none. Only values which
+// are known and change are checked, so an unchanged plan makes no requests.
+func checkReferences(r *schema.Resource, teamKey, userKey string) *schema.Resource {
+	diff := r.CustomizeDiff
+	r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if meta.(*Organization).validateReferences {
+			for _, ref := range changedReferences(d, teamKey) {
+				if err := checkTeamExists(meta, teamKey, ref); err != nil {
+					return err
+				}
+			}
+			for _, login := range changedReferences(d, userKey) {
+				if err := checkUserExists(meta, userKey, login); err != nil {
+					return err
+				}
+			}
+		}
+		if diff != nil {
+			return diff(d, meta)
+		}
+		return nil
+	}
+
+	return r
+}
+
+// changedReferences returns the known values of key which are new in the plan.
+func changedReferences(d *schema.ResourceDiff, key string) []string {
+	if key == "" || !d.HasChange(key) || !d.NewValueKnown(key) {
+		return nil
+	}
+
+	switch v := d.Get(key).(type) {
+	case string:
+		if v != "" {
+			return []string{v}
+		}
+	case *schema.Set:
+		old, _ := d.GetChange(key)
+		return expandStringList(v.Difference(old.(*schema.Set)).List())
+	}
+
+	return nil
+}
+
+func checkTeamExists(meta interface{}, key, teamIDOrSlug string) error {
+	teamID, err := strconv.ParseInt(teamIDOrSlug, 10, 64)
+	if err != nil {
+		// Slugs are looked up among the teams of the organization
+		if _, err := getTeamIDBySlug(meta, teamIDOrSlug); err != nil {
+			return fmt.Errorf("%s: %s", key, err)
+		}
+		return nil
+	}
+
+	log.Printf("[DEBUG] Checking team %d exists", teamID)
+	_, err = teamRequest(stopContext(meta), meta, "GET", teamID, "", "", nil, nil)
+	if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%s: there is no team with ID %d in %s", key, teamID, meta.(*Organization).name)
+	}
+
+	return err
+}
+
+func checkUserExists(meta interface{}, key, login string) error {
+	log.Printf("[DEBUG] Checking user %s exists", login)
+	_, err := getUserID(meta, login)
+	if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%s: there is no GitHub user with the login %q", key, login)
+	}
+
+	return err
+}
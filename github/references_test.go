@@ -0,0 +1,57 @@
+package github
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestCheckReferences(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/meta",
+			StatusCode:   200,
+			ResponseBody: `{}`,
+		},
+		{
+			ExpectedUri:  "/orgs/example",
+			StatusCode:   200,
+			ResponseBody: `{"id": 99, "login": "example"}`,
+		},
+		{
+			ExpectedUri:  "/organizations/99/team/1234",
+			StatusCode:   200,
+			ResponseBody: `{"id": 1234, "slug": "core"}`,
+		},
+		{
+			ExpectedUri:  "/users/nobody",
+			StatusCode:   404,
+			ResponseBody: `{"message": "Not Found"}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	r := checkReferences(resourceGithubTeamMembership(), "team_id", "username")
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"team_id":  "1234",
+		"username": "nobody",
+	})
+
+	// Nothing is looked up unless the provider asks for it
+	if _, err := r.Diff(nil, config, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	meta.validateReferences = true
+	_, err := r.Diff(nil, config, meta)
+	if err == nil || !strings.Contains(err.Error(), `username: there is no GitHub user with the login "nobody"`) {
+		t.Fatalf("Expected the missing user to fail the plan, got %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+package github
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// repositoryDefaultKeys are the github_repository arguments whose value can
+// be inherited from the provider's `repository_defaults` block.
+var repositoryDefaultKeys = []string{
+	"private",
+	"has_issues",
+	"has_projects",
+	"has_wiki",
+	"has_downloads",
+	"allow_merge_commit",
+	"allow_squash_merge",
+	"allow_rebase_merge",
+}
+
+// repositoryDefaults holds the repository settings configured on the
+// provider. It is created together with the provider schema and filled in
+// once the provider is configured, which always happens before resources
+// are planned.
+type repositoryDefaults struct {
+	values map[string]interface{}
+	topics []string
+}
+
+func repositoryDefaultsSchema() *schema.Schema {
+	s := map[string]*schema.Schema{
+		"topics": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	for _, k := range repositoryDefaultKeys {
+		s[k] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["repository_defaults"],
+		Elem:        &schema.Resource{Schema: s},
+	}
+}
+
+func (r *repositoryDefaults) configure(d *schema.ResourceData) {
+	r.values = make(map[string]interface{})
+	r.topics = nil
+
+	if _, ok := d.GetOk("repository_defaults"); !ok {
+		return
+	}
+
+	for _, k := range repositoryDefaultKeys {
+		if v, ok := d.GetOkExists("repository_defaults.0." + k); ok {
+			r.values[k] = v
+		}
+	}
+	if v, ok := d.GetOk("repository_defaults.0.topics"); ok {
+		r.topics = expandStringList(v.(*schema.Set).List())
+	}
+}
+
+// defaultFunc returns the provider-level value of key, or fallback when the
+// provider does not configure one.
+func (r *repositoryDefaults) defaultFunc(key string, fallback interface{}) schema.SchemaDefaultFunc {
+	return func() (interface{}, error) {
+		if v, ok := r.values[key]; ok {
+			return v, nil
+		}
+		return fallback, nil
+	}
+}
+
+// mergeTopics adds the default topics to the configured ones.
+func (r *repositoryDefaults) mergeTopics(topics []string) []string {
+	if r == nil {
+		return topics
+	}
+
+	merged := append([]string{}, topics...)
+	for _, t := range r.topics {
+		if !containsString(merged, t) {
+			merged = append(merged, t)
+		}
+	}
+	return merged
+}
+
+// stripTopics removes the default topics which are not also configured on
+// the repository itself, so they never show up as a difference.
+func (r *repositoryDefaults) stripTopics(actual, configured []string) []string {
+	if r == nil {
+		return actual
+	}
+
+	stripped := []string{}
+	for _, t := range actual {
+		if containsString(r.topics, t) && !containsString(configured, t) {
+			continue
+		}
+		stripped = append(stripped, t)
+	}
+	return stripped
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
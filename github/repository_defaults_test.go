@@ -0,0 +1,45 @@
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRepositoryDefaultsTopics(t *testing.T) {
+	defaults := &repositoryDefaults{topics: []string{"managed", "shared"}}
+
+	merged := defaults.mergeTopics([]string{"own", "shared"})
+	if expected := []string{"own", "shared", "managed"}; !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("Expected merged topics %v, got %v", expected, merged)
+	}
+
+	stripped := defaults.stripTopics([]string{"own", "shared", "managed"}, []string{"own", "shared"})
+	if expected := []string{"own", "shared"}; !reflect.DeepEqual(stripped, expected) {
+		t.Fatalf("Expected stripped topics %v, got %v", expected, stripped)
+	}
+
+	var none *repositoryDefaults
+	if topics := none.mergeTopics([]string{"own"}); !reflect.DeepEqual(topics, []string{"own"}) {
+		t.Fatalf("Expected topics to be unchanged without defaults, got %v", topics)
+	}
+}
+
+func TestRepositoryDefaultsDefaultFunc(t *testing.T) {
+	defaults := &repositoryDefaults{values: map[string]interface{}{"has_issues": false}}
+
+	v, err := defaults.defaultFunc("has_issues", true)()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != false {
+		t.Fatalf("Expected configured default false, got %v", v)
+	}
+
+	v, err = defaults.defaultFunc("allow_merge_commit", true)()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != true {
+		t.Fatalf("Expected fallback true, got %v", v)
+	}
+}
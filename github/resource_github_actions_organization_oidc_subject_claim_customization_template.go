@@ -0,0 +1,123 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The claim keys of the subject of tokens issued to organizations which
+// have never customized it
+var defaultOidcSubjectClaimKeys = []string{"repo", "context"}
+
+func resourceGithubActionsOrganizationOidcSubjectClaimCustomizationTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubActionsOrganizationOidcSubjectClaimCustomizationTemplateCreateOrUpdate,
+		Read:   resourceGithubActionsOrganizationOidcSubjectClaimCustomizationTemplateRead,
+		Update: resourceGithubActionsOrganizationOidcSubjectClaimCustomizationTemplateCreateOrUpdate,
+		Delete: resourceGithubActionsOrganizationOidcSubjectClaimCustomizationTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"include_claim_keys": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubActionsOrganizationOidcSubjectClaimCustomizationTemplateCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	claimKeys := expandStringList(d.Get("include_claim_keys").([]interface{}))
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	log.Printf("[DEBUG] Updating OIDC subject claim customization: %s", orgName)
+	_, err = apiRequest(ctx, client, "PUT",
+		fmt.Sprintf("orgs/%s/actions/oidc/customization/sub", orgName),
+		&oidcSubjectClaimCustomization{IncludeClaimKeys: claimKeys}, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(orgName)
+
+	return untilFound(d, meta, resourceGithubActionsOrganizationOidcSubjectClaimCustomizationTemplateRead)
+}
+
+func resourceGithubActionsOrganizationOidcSubjectClaimCustomizationTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading OIDC subject claim customization: %s", orgName)
+	customization := new(oidcSubjectClaimCustomization)
+	resp, err := apiRequest(ctx, client, "GET",
+		fmt.Sprintf("orgs/%s/actions/oidc/customization/sub", orgName), nil, customization)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing OIDC subject claim customization %s from state because the organization no longer exists in GitHub",
+					orgName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("include_claim_keys", customization.IncludeClaimKeys)
+
+	return nil
+}
+
+func resourceGithubActionsOrganizationOidcSubjectClaimCustomizationTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Resetting OIDC subject claim customization: %s", orgName)
+	_, err = apiRequest(ctx, client, "PUT",
+		fmt.Sprintf("orgs/%s/actions/oidc/customization/sub", orgName),
+		&oidcSubjectClaimCustomization{IncludeClaimKeys: defaultOidcSubjectClaimKeys}, nil)
+	return err
+}
@@ -0,0 +1,37 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubActionsOrganizationOidcSubjectClaimCustomizationTemplate_basic(t *testing.T) {
+	rn := "github_actions_organization_oidc_subject_claim_customization_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubActionsOrganizationOidcSubjectClaimCustomizationTemplateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "id", testOrganization),
+					resource.TestCheckResourceAttr(rn, "include_claim_keys.#", "3"),
+					resource.TestCheckResourceAttr(rn, "include_claim_keys.2", "environment"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccGithubActionsOrganizationOidcSubjectClaimCustomizationTemplateConfig = `
+resource "github_actions_organization_oidc_subject_claim_customization_template" "test" {
+  include_claim_keys = ["repo", "context", "environment"]
+}
+`
@@ -0,0 +1,240 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type actionsEnabledRepositories struct {
+	TotalCount   int                  `json:"total_count,omitempty"`
+	Repositories []*github.Repository `json:"repositories,omitempty"`
+}
+
+type actionsSelectedRepositoryIDs struct {
+	SelectedRepositoryIDs []int64 `json:"selected_repository_ids"`
+}
+
+func resourceGithubActionsOrganizationPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubActionsOrganizationPermissionsCreateOrUpdate,
+		Read:   resourceGithubActionsOrganizationPermissionsRead,
+		Update: resourceGithubActionsOrganizationPermissionsCreateOrUpdate,
+		Delete: resourceGithubActionsOrganizationPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled_repositories": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateValueFunc([]string{"all", "none", "selected"}),
+			},
+			"enabled_repositories_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"allowed_actions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validateValueFunc([]string{"all", "local_only", "selected"}),
+			},
+			"allowed_actions_config": actionsAllowedConfigSchema(),
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubActionsOrganizationPermissionsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	enabledRepositories := d.Get("enabled_repositories").(string)
+	allowedActions := d.Get("allowed_actions").(string)
+	allowed := expandActionsAllowed(d)
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	var repoIDs []int64
+	vL := d.Get("enabled_repositories_config").([]interface{})
+	if len(vL) > 0 && vL[0] != nil {
+		if enabledRepositories != "selected" {
+			return fmt.Errorf("`enabled_repositories_config` can only be set when `enabled_repositories` is %q.", "selected")
+		}
+		for _, id := range vL[0].(map[string]interface{})["repository_ids"].(*schema.Set).List() {
+			repoIDs = append(repoIDs, int64(id.(int)))
+		}
+	}
+	if allowed != nil && allowedActions != "selected" {
+		return fmt.Errorf("`allowed_actions_config` can only be set when `allowed_actions` is %q.", "selected")
+	}
+
+	permissions := &actionsPermissions{EnabledRepositories: github.String(enabledRepositories)}
+	if enabledRepositories != "none" {
+		permissions.AllowedActions = github.String(allowedActions)
+	}
+
+	log.Printf("[DEBUG] Updating Actions permissions: %s", orgName)
+	_, err = apiRequest(ctx, client, "PUT", fmt.Sprintf("orgs/%s/actions/permissions", orgName), permissions, nil)
+	if err != nil {
+		return err
+	}
+
+	if enabledRepositories == "selected" {
+		log.Printf("[DEBUG] Updating Actions enabled repositories: %s", orgName)
+		_, err = apiRequest(ctx, client, "PUT", fmt.Sprintf("orgs/%s/actions/permissions/repositories", orgName),
+			&actionsSelectedRepositoryIDs{SelectedRepositoryIDs: append([]int64{}, repoIDs...)}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if enabledRepositories != "none" && allowed != nil {
+		log.Printf("[DEBUG] Updating Actions allowed actions: %s", orgName)
+		_, err = apiRequest(ctx, client, "PUT", fmt.Sprintf("orgs/%s/actions/permissions/selected-actions", orgName), allowed, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(orgName)
+
+	return untilFound(d, meta, resourceGithubActionsOrganizationPermissionsRead)
+}
+
+func resourceGithubActionsOrganizationPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading Actions permissions: %s", orgName)
+	permissions := new(actionsPermissions)
+	resp, err := apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/actions/permissions", orgName), nil, permissions)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return readGithubActionsOrganizationPermissionsSelections(d, meta)
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing Actions permissions %s from state because the organization no longer exists in GitHub",
+					orgName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("enabled_repositories", permissions.EnabledRepositories)
+	if permissions.AllowedActions != nil {
+		d.Set("allowed_actions", permissions.AllowedActions)
+	}
+
+	return readGithubActionsOrganizationPermissionsSelections(d, meta)
+}
+
+// readGithubActionsOrganizationPermissionsSelections records the repositories
+// and actions selected by the policy in state, which the etag of the policy
+// does not cover.
+func readGithubActionsOrganizationPermissionsSelections(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	orgName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	enabledRepositories := d.Get("enabled_repositories").(string)
+
+	if enabledRepositories == "selected" {
+		repoIDs := []interface{}{}
+		repositories, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+			repos := new(actionsEnabledRepositories)
+			resp, err := apiRequest(ctx, client, "GET",
+				fmt.Sprintf("orgs/%s/actions/permissions/repositories?%s", orgName, pageQuery(url.Values{}, opt)), nil, repos)
+			return repos.Repositories, resp, err
+		})
+		if err != nil {
+			return err
+		}
+		for _, v := range repositories {
+			repoIDs = append(repoIDs, int(v.(*github.Repository).GetID()))
+		}
+
+		d.Set("enabled_repositories_config", []interface{}{
+			map[string]interface{}{
+				"repository_ids": schema.NewSet(schema.HashInt, repoIDs),
+			},
+		})
+	} else {
+		d.Set("enabled_repositories_config", []interface{}{})
+	}
+
+	// No actions are allowed while Actions are disabled
+	if enabledRepositories != "none" && d.Get("allowed_actions").(string) == "selected" {
+		allowed := new(actionsAllowed)
+		_, err := apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/actions/permissions/selected-actions", orgName), nil, allowed)
+		if err != nil {
+			return err
+		}
+		d.Set("allowed_actions_config", flattenActionsAllowed(allowed))
+	} else {
+		d.Set("allowed_actions_config", []interface{}{})
+	}
+
+	return nil
+}
+
+func resourceGithubActionsOrganizationPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// Restore the permissive defaults of a new organization
+	permissions := &actionsPermissions{
+		EnabledRepositories: github.String("all"),
+		AllowedActions:      github.String("all"),
+	}
+
+	log.Printf("[DEBUG] Resetting Actions permissions: %s", orgName)
+	_, err = apiRequest(ctx, client, "PUT", fmt.Sprintf("orgs/%s/actions/permissions", orgName), permissions, nil)
+	return err
+}
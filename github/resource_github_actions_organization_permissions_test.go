@@ -0,0 +1,92 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubActionsOrganizationPermissions_basic(t *testing.T) {
+	rn := "github_actions_organization_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubActionsOrganizationPermissionsConfig("all", "local_only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "id", testOrganization),
+					resource.TestCheckResourceAttr(rn, "enabled_repositories", "all"),
+					resource.TestCheckResourceAttr(rn, "allowed_actions", "local_only"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGithubActionsOrganizationPermissions_selected(t *testing.T) {
+	rn := "github_actions_organization_permissions.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-actions-%s", rs)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubActionsOrganizationPermissionsSelectedConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "enabled_repositories", "selected"),
+					resource.TestCheckResourceAttr(rn, "enabled_repositories_config.0.repository_ids.#", "1"),
+					resource.TestCheckResourceAttr(rn, "allowed_actions", "selected"),
+					resource.TestCheckResourceAttr(rn, "allowed_actions_config.0.github_owned_allowed", "true"),
+					resource.TestCheckResourceAttr(rn, "allowed_actions_config.0.verified_allowed", "true"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubActionsOrganizationPermissionsConfig(enabledRepositories, allowedActions string) string {
+	return fmt.Sprintf(`
+resource "github_actions_organization_permissions" "test" {
+  enabled_repositories = "%s"
+  allowed_actions      = "%s"
+}
+`, enabledRepositories, allowedActions)
+}
+
+func testAccGithubActionsOrganizationPermissionsSelectedConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_actions_organization_permissions" "test" {
+  enabled_repositories = "selected"
+  allowed_actions      = "selected"
+
+  enabled_repositories_config {
+    repository_ids = ["${github_repository.test.repo_id}"]
+  }
+
+  allowed_actions_config {
+    github_owned_allowed = true
+    verified_allowed     = true
+  }
+}
+`, repoName)
+}
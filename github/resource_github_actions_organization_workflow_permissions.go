@@ -0,0 +1,112 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubActionsOrganizationWorkflowPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubActionsOrganizationWorkflowPermissionsCreateOrUpdate,
+		Read:   resourceGithubActionsOrganizationWorkflowPermissionsRead,
+		Update: resourceGithubActionsOrganizationWorkflowPermissionsCreateOrUpdate,
+		Delete: resourceGithubActionsOrganizationWorkflowPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: actionsWorkflowPermissionsSchema(),
+	}
+}
+
+func resourceGithubActionsOrganizationWorkflowPermissionsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	log.Printf("[DEBUG] Updating Actions workflow permissions: %s", orgName)
+	_, err = apiRequest(ctx, client, "PUT",
+		fmt.Sprintf("orgs/%s/actions/permissions/workflow", orgName), expandActionsWorkflowPermissions(d), nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(orgName)
+
+	return untilFound(d, meta, resourceGithubActionsOrganizationWorkflowPermissionsRead)
+}
+
+func resourceGithubActionsOrganizationWorkflowPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading Actions workflow permissions: %s", orgName)
+	permissions := new(actionsWorkflowPermissions)
+	resp, err := apiRequest(ctx, client, "GET",
+		fmt.Sprintf("orgs/%s/actions/permissions/workflow", orgName), nil, permissions)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing Actions workflow permissions %s from state because the organization no longer exists in GitHub",
+					orgName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("default_workflow_permissions", permissions.DefaultWorkflowPermissions)
+	d.Set("can_approve_pull_request_reviews", permissions.CanApprovePullRequestReviews)
+
+	return nil
+}
+
+func resourceGithubActionsOrganizationWorkflowPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// Restore the restrictive defaults of a new organization
+	permissions := &actionsWorkflowPermissions{
+		DefaultWorkflowPermissions:   github.String("read"),
+		CanApprovePullRequestReviews: github.Bool(false),
+	}
+
+	log.Printf("[DEBUG] Resetting Actions workflow permissions: %s", orgName)
+	_, err = apiRequest(ctx, client, "PUT",
+		fmt.Sprintf("orgs/%s/actions/permissions/workflow", orgName), permissions, nil)
+	return err
+}
@@ -0,0 +1,48 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubActionsOrganizationWorkflowPermissions_basic(t *testing.T) {
+	rn := "github_actions_organization_workflow_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubActionsOrganizationWorkflowPermissionsConfig("write", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "id", testOrganization),
+					resource.TestCheckResourceAttr(rn, "default_workflow_permissions", "write"),
+					resource.TestCheckResourceAttr(rn, "can_approve_pull_request_reviews", "true"),
+				),
+			},
+			{
+				Config: testAccGithubActionsOrganizationWorkflowPermissionsConfig("read", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "default_workflow_permissions", "read"),
+					resource.TestCheckResourceAttr(rn, "can_approve_pull_request_reviews", "false"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubActionsOrganizationWorkflowPermissionsConfig(permissions string, canApprove bool) string {
+	return fmt.Sprintf(`
+resource "github_actions_organization_workflow_permissions" "test" {
+  default_workflow_permissions     = "%s"
+  can_approve_pull_request_reviews = %t
+}
+`, permissions, canApprove)
+}
@@ -0,0 +1,130 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type oidcSubjectClaimCustomization struct {
+	UseDefault       *bool    `json:"use_default,omitempty"`
+	IncludeClaimKeys []string `json:"include_claim_keys,omitempty"`
+}
+
+func resourceGithubActionsRepositoryOidcSubjectClaimCustomizationTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubActionsRepositoryOidcSubjectClaimCustomizationTemplateCreateOrUpdate,
+		Read:   resourceGithubActionsRepositoryOidcSubjectClaimCustomizationTemplateRead,
+		Update: resourceGithubActionsRepositoryOidcSubjectClaimCustomizationTemplateCreateOrUpdate,
+		Delete: resourceGithubActionsRepositoryOidcSubjectClaimCustomizationTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"use_default": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"include_claim_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubActionsRepositoryOidcSubjectClaimCustomizationTemplateCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	useDefault := d.Get("use_default").(bool)
+	claimKeys := expandStringList(d.Get("include_claim_keys").([]interface{}))
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	if useDefault && len(claimKeys) > 0 {
+		return fmt.Errorf("`include_claim_keys` cannot be set when `use_default` is true.")
+	}
+	if !useDefault && len(claimKeys) == 0 {
+		return fmt.Errorf("`include_claim_keys` must be set when `use_default` is false.")
+	}
+
+	log.Printf("[DEBUG] Updating OIDC subject claim customization: %s/%s", owner, repoName)
+	_, err := apiRequest(ctx, client, "PUT",
+		fmt.Sprintf("repos/%s/%s/actions/oidc/customization/sub", owner, repoName),
+		&oidcSubjectClaimCustomization{UseDefault: github.Bool(useDefault), IncludeClaimKeys: claimKeys}, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+
+	return untilFound(d, meta, resourceGithubActionsRepositoryOidcSubjectClaimCustomizationTemplateRead)
+}
+
+func resourceGithubActionsRepositoryOidcSubjectClaimCustomizationTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading OIDC subject claim customization: %s/%s", owner, repoName)
+	customization := new(oidcSubjectClaimCustomization)
+	resp, err := apiRequest(ctx, client, "GET",
+		fmt.Sprintf("repos/%s/%s/actions/oidc/customization/sub", owner, repoName), nil, customization)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing OIDC subject claim customization %s/%s from state because the repository no longer exists in GitHub",
+					owner, repoName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("repository", repoName)
+	d.Set("use_default", customization.UseDefault)
+	d.Set("include_claim_keys", customization.IncludeClaimKeys)
+
+	return nil
+}
+
+func resourceGithubActionsRepositoryOidcSubjectClaimCustomizationTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Resetting OIDC subject claim customization: %s/%s", owner, repoName)
+	_, err := apiRequest(ctx, client, "PUT",
+		fmt.Sprintf("repos/%s/%s/actions/oidc/customization/sub", owner, repoName),
+		&oidcSubjectClaimCustomization{UseDefault: github.Bool(true)}, nil)
+	return err
+}
@@ -0,0 +1,70 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubActionsRepositoryOidcSubjectClaimCustomizationTemplate_basic(t *testing.T) {
+	rn := "github_actions_repository_oidc_subject_claim_customization_template.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-oidc-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubActionsRepositoryOidcSubjectClaimCustomizationTemplateConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "use_default", "false"),
+					resource.TestCheckResourceAttr(rn, "include_claim_keys.#", "2"),
+					resource.TestCheckResourceAttr(rn, "include_claim_keys.0", "repo"),
+					resource.TestCheckResourceAttr(rn, "include_claim_keys.1", "job_workflow_ref"),
+				),
+			},
+			{
+				Config: testAccGithubActionsRepositoryOidcSubjectClaimCustomizationTemplateDefaultConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "use_default", "true"),
+					resource.TestCheckResourceAttr(rn, "include_claim_keys.#", "0"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubActionsRepositoryOidcSubjectClaimCustomizationTemplateConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_actions_repository_oidc_subject_claim_customization_template" "test" {
+  repository         = "${github_repository.test.name}"
+  use_default        = false
+  include_claim_keys = ["repo", "job_workflow_ref"]
+}
+`, repoName)
+}
+
+func testAccGithubActionsRepositoryOidcSubjectClaimCustomizationTemplateDefaultConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_actions_repository_oidc_subject_claim_customization_template" "test" {
+  repository  = "${github_repository.test.name}"
+  use_default = true
+}
+`, repoName)
+}
@@ -0,0 +1,236 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type actionsPermissions struct {
+	Enabled             *bool   `json:"enabled,omitempty"`
+	EnabledRepositories *string `json:"enabled_repositories,omitempty"`
+	AllowedActions      *string `json:"allowed_actions,omitempty"`
+}
+
+type actionsAllowed struct {
+	GithubOwnedAllowed *bool    `json:"github_owned_allowed,omitempty"`
+	VerifiedAllowed    *bool    `json:"verified_allowed,omitempty"`
+	PatternsAllowed    []string `json:"patterns_allowed"`
+}
+
+func actionsAllowedConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"github_owned_allowed": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+				"verified_allowed": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"patterns_allowed": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandActionsAllowed(d *schema.ResourceData) *actionsAllowed {
+	vL := d.Get("allowed_actions_config").([]interface{})
+	if len(vL) == 0 || vL[0] == nil {
+		return nil
+	}
+
+	m := vL[0].(map[string]interface{})
+	return &actionsAllowed{
+		GithubOwnedAllowed: github.Bool(m["github_owned_allowed"].(bool)),
+		VerifiedAllowed:    github.Bool(m["verified_allowed"].(bool)),
+		PatternsAllowed:    expandStringList(m["patterns_allowed"].(*schema.Set).List()),
+	}
+}
+
+func flattenActionsAllowed(allowed *actionsAllowed) []interface{} {
+	if allowed == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"github_owned_allowed": allowed.GithubOwnedAllowed != nil && *allowed.GithubOwnedAllowed,
+			"verified_allowed":     allowed.VerifiedAllowed != nil && *allowed.VerifiedAllowed,
+			"patterns_allowed":     schema.NewSet(schema.HashString, flattenStringList(allowed.PatternsAllowed)),
+		},
+	}
+}
+
+func resourceGithubActionsRepositoryPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubActionsRepositoryPermissionsCreateOrUpdate,
+		Read:   resourceGithubActionsRepositoryPermissionsRead,
+		Update: resourceGithubActionsRepositoryPermissionsCreateOrUpdate,
+		Delete: resourceGithubActionsRepositoryPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"allowed_actions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validateValueFunc([]string{"all", "local_only", "selected"}),
+			},
+			"allowed_actions_config": actionsAllowedConfigSchema(),
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubActionsRepositoryPermissionsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	enabled := d.Get("enabled").(bool)
+	allowedActions := d.Get("allowed_actions").(string)
+	allowed := expandActionsAllowed(d)
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	if allowed != nil && allowedActions != "selected" {
+		return fmt.Errorf("`allowed_actions_config` can only be set when `allowed_actions` is %q.", "selected")
+	}
+
+	permissions := &actionsPermissions{Enabled: github.Bool(enabled)}
+	if enabled {
+		permissions.AllowedActions = github.String(allowedActions)
+	}
+
+	log.Printf("[DEBUG] Updating Actions permissions: %s/%s", owner, repoName)
+	_, err := apiRequest(ctx, client, "PUT",
+		fmt.Sprintf("repos/%s/%s/actions/permissions", owner, repoName), permissions, nil)
+	if err != nil {
+		return err
+	}
+
+	if enabled && allowed != nil {
+		log.Printf("[DEBUG] Updating Actions allowed actions: %s/%s", owner, repoName)
+		_, err = apiRequest(ctx, client, "PUT",
+			fmt.Sprintf("repos/%s/%s/actions/permissions/selected-actions", owner, repoName), allowed, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(repoName)
+
+	return untilFound(d, meta, resourceGithubActionsRepositoryPermissionsRead)
+}
+
+func resourceGithubActionsRepositoryPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading Actions permissions: %s/%s", owner, repoName)
+	permissions := new(actionsPermissions)
+	resp, err := apiRequest(ctx, client, "GET",
+		fmt.Sprintf("repos/%s/%s/actions/permissions", owner, repoName), nil, permissions)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return readGithubActionsRepositoryPermissionsAllowed(d, meta)
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing Actions permissions %s/%s from state because the repository no longer exists in GitHub",
+					owner, repoName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("repository", repoName)
+	d.Set("enabled", permissions.Enabled)
+	if permissions.AllowedActions != nil {
+		d.Set("allowed_actions", permissions.AllowedActions)
+	}
+
+	return readGithubActionsRepositoryPermissionsAllowed(d, meta)
+}
+
+// readGithubActionsRepositoryPermissionsAllowed records the actions selected
+// by the policy in state, which the etag of the policy does not cover.
+func readGithubActionsRepositoryPermissionsAllowed(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+
+	// The allowed actions can only be read back while the policy is "selected"
+	if d.Get("enabled").(bool) && d.Get("allowed_actions").(string) == "selected" {
+		allowed := new(actionsAllowed)
+		_, err := apiRequest(context.WithValue(stopContext(meta), ctxId, d.Id()), client, "GET",
+			fmt.Sprintf("repos/%s/%s/actions/permissions/selected-actions", owner, repoName), nil, allowed)
+		if err != nil {
+			return err
+		}
+		d.Set("allowed_actions_config", flattenActionsAllowed(allowed))
+	} else {
+		d.Set("allowed_actions_config", []interface{}{})
+	}
+
+	return nil
+}
+
+func resourceGithubActionsRepositoryPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// Restore the permissive defaults of a new repository
+	permissions := &actionsPermissions{
+		Enabled:        github.Bool(true),
+		AllowedActions: github.String("all"),
+	}
+
+	log.Printf("[DEBUG] Resetting Actions permissions: %s/%s", owner, repoName)
+	_, err := apiRequest(ctx, client, "PUT",
+		fmt.Sprintf("repos/%s/%s/actions/permissions", owner, repoName), permissions, nil)
+	return err
+}
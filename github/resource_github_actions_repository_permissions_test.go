@@ -0,0 +1,76 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubActionsRepositoryPermissions_basic(t *testing.T) {
+	rn := "github_actions_repository_permissions.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-actions-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubActionsRepositoryPermissionsConfig(repoName, "local_only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "enabled", "true"),
+					resource.TestCheckResourceAttr(rn, "allowed_actions", "local_only"),
+					resource.TestCheckResourceAttr(rn, "allowed_actions_config.#", "0"),
+				),
+			},
+			{
+				Config: testAccGithubActionsRepositoryPermissionsSelectedConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "allowed_actions", "selected"),
+					resource.TestCheckResourceAttr(rn, "allowed_actions_config.#", "1"),
+					resource.TestCheckResourceAttr(rn, "allowed_actions_config.0.github_owned_allowed", "true"),
+					resource.TestCheckResourceAttr(rn, "allowed_actions_config.0.verified_allowed", "false"),
+					resource.TestCheckResourceAttr(rn, "allowed_actions_config.0.patterns_allowed.#", "2"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubActionsRepositoryPermissionsConfig(repoName, allowedActions string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_actions_repository_permissions" "test" {
+  repository      = "${github_repository.test.name}"
+  allowed_actions = "%s"
+}
+`, repoName, allowedActions)
+}
+
+func testAccGithubActionsRepositoryPermissionsSelectedConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_actions_repository_permissions" "test" {
+  repository      = "${github_repository.test.name}"
+  allowed_actions = "selected"
+
+  allowed_actions_config {
+    github_owned_allowed = true
+    patterns_allowed     = ["actions/cache@*", "hashicorp/setup-terraform@*"]
+  }
+}
+`, repoName)
+}
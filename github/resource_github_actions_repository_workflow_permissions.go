@@ -0,0 +1,140 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type actionsWorkflowPermissions struct {
+	DefaultWorkflowPermissions   *string `json:"default_workflow_permissions,omitempty"`
+	CanApprovePullRequestReviews *bool   `json:"can_approve_pull_request_reviews,omitempty"`
+}
+
+func actionsWorkflowPermissionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"default_workflow_permissions": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "read",
+			ValidateFunc: validateValueFunc([]string{"read", "write"}),
+		},
+		"can_approve_pull_request_reviews": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"etag": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func expandActionsWorkflowPermissions(d *schema.ResourceData) *actionsWorkflowPermissions {
+	return &actionsWorkflowPermissions{
+		DefaultWorkflowPermissions:   github.String(d.Get("default_workflow_permissions").(string)),
+		CanApprovePullRequestReviews: github.Bool(d.Get("can_approve_pull_request_reviews").(bool)),
+	}
+}
+
+func resourceGithubActionsRepositoryWorkflowPermissions() *schema.Resource {
+	s := actionsWorkflowPermissionsSchema()
+	s["repository"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceGithubActionsRepositoryWorkflowPermissionsCreateOrUpdate,
+		Read:   resourceGithubActionsRepositoryWorkflowPermissionsRead,
+		Update: resourceGithubActionsRepositoryWorkflowPermissionsCreateOrUpdate,
+		Delete: resourceGithubActionsRepositoryWorkflowPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceGithubActionsRepositoryWorkflowPermissionsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	log.Printf("[DEBUG] Updating Actions workflow permissions: %s/%s", owner, repoName)
+	_, err := apiRequest(ctx, client, "PUT",
+		fmt.Sprintf("repos/%s/%s/actions/permissions/workflow", owner, repoName), expandActionsWorkflowPermissions(d), nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+
+	return untilFound(d, meta, resourceGithubActionsRepositoryWorkflowPermissionsRead)
+}
+
+func resourceGithubActionsRepositoryWorkflowPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading Actions workflow permissions: %s/%s", owner, repoName)
+	permissions := new(actionsWorkflowPermissions)
+	resp, err := apiRequest(ctx, client, "GET",
+		fmt.Sprintf("repos/%s/%s/actions/permissions/workflow", owner, repoName), nil, permissions)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing Actions workflow permissions %s/%s from state because the repository no longer exists in GitHub",
+					owner, repoName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("repository", repoName)
+	d.Set("default_workflow_permissions", permissions.DefaultWorkflowPermissions)
+	d.Set("can_approve_pull_request_reviews", permissions.CanApprovePullRequestReviews)
+
+	return nil
+}
+
+func resourceGithubActionsRepositoryWorkflowPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// Restore the restrictive defaults of a new repository
+	permissions := &actionsWorkflowPermissions{
+		DefaultWorkflowPermissions:   github.String("read"),
+		CanApprovePullRequestReviews: github.Bool(false),
+	}
+
+	log.Printf("[DEBUG] Resetting Actions workflow permissions: %s/%s", owner, repoName)
+	_, err := apiRequest(ctx, client, "PUT",
+		fmt.Sprintf("repos/%s/%s/actions/permissions/workflow", owner, repoName), permissions, nil)
+	return err
+}
@@ -0,0 +1,55 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubActionsRepositoryWorkflowPermissions_basic(t *testing.T) {
+	rn := "github_actions_repository_workflow_permissions.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-workflow-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubActionsRepositoryWorkflowPermissionsConfig(repoName, "write", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "default_workflow_permissions", "write"),
+					resource.TestCheckResourceAttr(rn, "can_approve_pull_request_reviews", "true"),
+				),
+			},
+			{
+				Config: testAccGithubActionsRepositoryWorkflowPermissionsConfig(repoName, "read", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "default_workflow_permissions", "read"),
+					resource.TestCheckResourceAttr(rn, "can_approve_pull_request_reviews", "false"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubActionsRepositoryWorkflowPermissionsConfig(repoName, permissions string, canApprove bool) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_actions_repository_workflow_permissions" "test" {
+  repository                       = "${github_repository.test.name}"
+  default_workflow_permissions     = "%s"
+  can_approve_pull_request_reviews = %t
+}
+`, repoName, permissions, canApprove)
+}
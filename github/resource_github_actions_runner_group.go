@@ -0,0 +1,286 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type actionsRunnerGroup struct {
+	ID                       *int64   `json:"id,omitempty"`
+	Name                     *string  `json:"name,omitempty"`
+	Visibility               *string  `json:"visibility,omitempty"`
+	Default                  *bool    `json:"default,omitempty"`
+	Inherited                *bool    `json:"inherited,omitempty"`
+	RunnersURL               *string  `json:"runners_url,omitempty"`
+	SelectedRepositoriesURL  *string  `json:"selected_repositories_url,omitempty"`
+	AllowsPublicRepositories *bool    `json:"allows_public_repositories,omitempty"`
+	RestrictedToWorkflows    *bool    `json:"restricted_to_workflows,omitempty"`
+	SelectedWorkflows        []string `json:"selected_workflows"`
+	SelectedRepositoryIDs    []int64  `json:"selected_repository_ids,omitempty"`
+}
+
+func resourceGithubActionsRunnerGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubActionsRunnerGroupCreate,
+		Read:   resourceGithubActionsRunnerGroupRead,
+		Update: resourceGithubActionsRunnerGroupUpdate,
+		Delete: resourceGithubActionsRunnerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateValueFunc([]string{"all", "selected", "private"}),
+			},
+			"selected_repository_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Set:      schema.HashInt,
+			},
+			"allows_public_repositories": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"restricted_to_workflows": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"selected_workflows": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"inherited": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"runners_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"selected_repositories_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubActionsRunnerGroupObject(d *schema.ResourceData) (*actionsRunnerGroup, error) {
+	visibility := d.Get("visibility").(string)
+	group := &actionsRunnerGroup{
+		Name:                     github.String(d.Get("name").(string)),
+		Visibility:               github.String(visibility),
+		AllowsPublicRepositories: github.Bool(d.Get("allows_public_repositories").(bool)),
+		RestrictedToWorkflows:    github.Bool(d.Get("restricted_to_workflows").(bool)),
+		SelectedWorkflows:        expandStringList(d.Get("selected_workflows").([]interface{})),
+	}
+
+	ids := d.Get("selected_repository_ids").(*schema.Set).List()
+	if len(ids) > 0 && visibility != "selected" {
+		return nil, fmt.Errorf("`selected_repository_ids` can only be set when `visibility` is %q.", "selected")
+	}
+	if len(group.SelectedWorkflows) > 0 && !*group.RestrictedToWorkflows {
+		return nil, fmt.Errorf("`selected_workflows` can only be set when `restricted_to_workflows` is true.")
+	}
+
+	return group, nil
+}
+
+func expandRunnerGroupRepositoryIDs(d *schema.ResourceData) []int64 {
+	ids := []int64{}
+	for _, id := range d.Get("selected_repository_ids").(*schema.Set).List() {
+		ids = append(ids, int64(id.(int)))
+	}
+	return ids
+}
+
+func resourceGithubActionsRunnerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	group, err := resourceGithubActionsRunnerGroupObject(d)
+	if err != nil {
+		return err
+	}
+	if *group.Visibility == "selected" {
+		group.SelectedRepositoryIDs = expandRunnerGroupRepositoryIDs(d)
+	}
+
+	log.Printf("[DEBUG] Creating Actions runner group: %s (%s)", *group.Name, orgName)
+	created := new(actionsRunnerGroup)
+	_, err = apiRequest(ctx, client, "POST", fmt.Sprintf("orgs/%s/actions/runner-groups", orgName), group, created)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(*created.ID, 10))
+
+	return untilFound(d, meta, resourceGithubActionsRunnerGroupRead)
+}
+
+func resourceGithubActionsRunnerGroupRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading Actions runner group: %s (%s)", d.Id(), orgName)
+	group := new(actionsRunnerGroup)
+	resp, err := apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/actions/runner-groups/%d", orgName, id), nil, group)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return readGithubActionsRunnerGroupRepositories(d, meta, id)
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing Actions runner group %s from state because it no longer exists in GitHub",
+					d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("name", group.Name)
+	d.Set("visibility", group.Visibility)
+	d.Set("allows_public_repositories", group.AllowsPublicRepositories)
+	d.Set("restricted_to_workflows", group.RestrictedToWorkflows)
+	d.Set("selected_workflows", group.SelectedWorkflows)
+	d.Set("default", group.Default)
+	d.Set("inherited", group.Inherited)
+	d.Set("runners_url", group.RunnersURL)
+	d.Set("selected_repositories_url", group.SelectedRepositoriesURL)
+
+	return readGithubActionsRunnerGroupRepositories(d, meta, id)
+}
+
+// readGithubActionsRunnerGroupRepositories records the repositories selected
+// by the runner group in state, which the etag of the group does not cover.
+func readGithubActionsRunnerGroupRepositories(d *schema.ResourceData, meta interface{}, id int64) error {
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+
+	repoIDs := []interface{}{}
+	if d.Get("visibility").(string) == "selected" {
+		ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+		repositories, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+			repos := new(actionsEnabledRepositories)
+			resp, err := apiRequest(ctx, client, "GET",
+				fmt.Sprintf("orgs/%s/actions/runner-groups/%d/repositories?%s", orgName, id, pageQuery(url.Values{}, opt)), nil, repos)
+			return repos.Repositories, resp, err
+		})
+		if err != nil {
+			return err
+		}
+		for _, v := range repositories {
+			repoIDs = append(repoIDs, int(v.(*github.Repository).GetID()))
+		}
+	}
+	d.Set("selected_repository_ids", schema.NewSet(schema.HashInt, repoIDs))
+
+	return nil
+}
+
+func resourceGithubActionsRunnerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	group, err := resourceGithubActionsRunnerGroupObject(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Actions runner group: %s (%s)", d.Id(), orgName)
+	_, err = apiRequest(ctx, client, "PATCH", fmt.Sprintf("orgs/%s/actions/runner-groups/%d", orgName, id), group, nil)
+	if err != nil {
+		return err
+	}
+
+	if *group.Visibility == "selected" && d.HasChange("selected_repository_ids") {
+		log.Printf("[DEBUG] Updating Actions runner group repositories: %s (%s)", d.Id(), orgName)
+		_, err = apiRequest(ctx, client, "PUT", fmt.Sprintf("orgs/%s/actions/runner-groups/%d/repositories", orgName, id),
+			&actionsSelectedRepositoryIDs{SelectedRepositoryIDs: expandRunnerGroupRepositoryIDs(d)}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubActionsRunnerGroupRead(d, meta)
+}
+
+func resourceGithubActionsRunnerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting Actions runner group: %s (%s)", d.Id(), orgName)
+	_, err = apiRequest(ctx, client, "DELETE", fmt.Sprintf("orgs/%s/actions/runner-groups/%d", orgName, id), nil, nil)
+	return err
+}
@@ -0,0 +1,144 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubActionsRunnerGroup_basic(t *testing.T) {
+	rn := "github_actions_runner_group.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupName := fmt.Sprintf("tf-acc-test-runners-%s", rs)
+	repoName := fmt.Sprintf("tf-acc-test-runners-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubActionsRunnerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubActionsRunnerGroupConfig(groupName, "all"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "name", groupName),
+					resource.TestCheckResourceAttr(rn, "visibility", "all"),
+					resource.TestCheckResourceAttr(rn, "default", "false"),
+					resource.TestCheckResourceAttr(rn, "allows_public_repositories", "false"),
+				),
+			},
+			{
+				Config: testAccGithubActionsRunnerGroupSelectedConfig(groupName, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "visibility", "selected"),
+					resource.TestCheckResourceAttr(rn, "selected_repository_ids.#", "1"),
+					resource.TestCheckResourceAttr(rn, "restricted_to_workflows", "true"),
+					resource.TestCheckResourceAttr(rn, "selected_workflows.#", "1"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGithubActionsRunnerGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+	orgName := testAccProvider.Meta().(*Organization).name
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_actions_runner_group" {
+			continue
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return unconvertibleIdErr(rs.Primary.ID, err)
+		}
+
+		resp, err := apiRequest(context.TODO(), conn, "GET",
+			fmt.Sprintf("orgs/%s/actions/runner-groups/%d", orgName, id), nil, nil)
+		if err == nil {
+			return fmt.Errorf("Actions runner group %s still exists", rs.Primary.ID)
+		}
+		if resp != nil && resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+
+	return nil
+}
+
+func testAccGithubActionsRunnerGroupConfig(groupName, visibility string) string {
+	return fmt.Sprintf(`
+resource "github_actions_runner_group" "test" {
+  name       = "%s"
+  visibility = "%s"
+}
+`, groupName, visibility)
+}
+
+func testAccGithubActionsRunnerGroupSelectedConfig(groupName, repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_actions_runner_group" "test" {
+  name                    = "%s"
+  visibility              = "selected"
+  selected_repository_ids = ["${github_repository.test.repo_id}"]
+  restricted_to_workflows = true
+  selected_workflows      = ["%s/${github_repository.test.name}/.github/workflows/deploy.yml@refs/heads/master"]
+}
+`, repoName, groupName, testOrganization)
+}
+
+func TestGithubActionsRunnerGroupReadNotModified(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:     "/orgs/example/actions/runner-groups/7",
+			ExpectedHeaders: map[string]string{"If-None-Match": `"abc"`},
+			StatusCode:      304,
+		},
+		{
+			ExpectedUri:  "/orgs/example/actions/runner-groups/7/repositories?page=1&per_page=100",
+			StatusCode:   200,
+			ResponseBody: `{"total_count": 1, "repositories": [{"id": 42}]}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(&http.Client{Transport: NewEtagTransport(http.DefaultTransport)})
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{conditionalRequests: true}}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubActionsRunnerGroup().Schema, map[string]interface{}{
+		"name":       "runners",
+		"visibility": "selected",
+	})
+	d.SetId("7")
+	d.Set("etag", `"abc"`)
+
+	if err := resourceGithubActionsRunnerGroupRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	// The repositories are not covered by the etag of the group
+	ids := d.Get("selected_repository_ids").(*schema.Set)
+	if ids.Len() != 1 || !ids.Contains(42) {
+		t.Fatalf("Expected the selected repositories to be read again, got %v", ids.List())
+	}
+}
@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type workflowDispatchEvent struct {
+	Ref    string            `json:"ref"`
+	Inputs map[string]string `json:"inputs,omitempty"`
+}
+
+// A workflow dispatch triggers a workflow_dispatch event of a workflow when
+// it is created, e.g. to bootstrap a repository once it was created. It has
+// the ID `<repository>:<workflow>`, where the workflow is its file name or ID.
+func resourceGithubActionsWorkflowDispatch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubActionsWorkflowDispatchCreate,
+		Read:   resourceGithubActionsWorkflowDispatchRead,
+		Update: resourceGithubActionsWorkflowDispatchUpdate,
+		Delete: resourceGithubActionsWorkflowDispatchDelete,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"workflow": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ref": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"inputs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"redispatch_on_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"dispatched_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dispatchGithubActionsWorkflow(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	workflow := d.Get("workflow").(string)
+
+	event := &workflowDispatchEvent{Ref: d.Get("ref").(string)}
+	if v, ok := d.GetOk("inputs"); ok {
+		event.Inputs = map[string]string{}
+		for k, input := range v.(map[string]interface{}) {
+			event.Inputs[k] = input.(string)
+		}
+	}
+
+	log.Printf("[DEBUG] Dispatching workflow %s: %s/%s@%s", workflow, owner, repoName, event.Ref)
+	_, err := apiRequest(ctx, client, "POST",
+		fmt.Sprintf("repos/%s/%s/actions/workflows/%s/dispatches", owner, repoName, url.PathEscape(workflow)), event, nil)
+	if err != nil {
+		return err
+	}
+
+	d.Set("dispatched_at", time.Now().UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceGithubActionsWorkflowDispatchCreate(d *schema.ResourceData, meta interface{}) error {
+	repoName := d.Get("repository").(string)
+	workflow := d.Get("workflow").(string)
+	ctx := stopContext(meta)
+
+	err := dispatchGithubActionsWorkflow(ctx, d, meta)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&repoName, &workflow))
+
+	return untilFound(d, meta, resourceGithubActionsWorkflowDispatchRead)
+}
+
+func resourceGithubActionsWorkflowDispatchRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, workflow, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// The runs a dispatch started are not tracked; only the workflow has to
+	// remain for the dispatch to be kept in state
+	log.Printf("[DEBUG] Reading workflow %s: %s/%s", workflow, owner, repoName)
+	_, err = apiRequest(ctx, client, "GET",
+		fmt.Sprintf("repos/%s/%s/actions/workflows/%s", owner, repoName, url.PathEscape(workflow)), nil, nil)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing workflow dispatch %s from state because the workflow no longer exists in GitHub",
+				d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("repository", repoName)
+	d.Set("workflow", workflow)
+
+	return nil
+}
+
+func resourceGithubActionsWorkflowDispatchUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// Without redispatch_on_change, changes are only recorded for the next
+	// dispatch, i.e. when the resource is replaced
+	if d.Get("redispatch_on_change").(bool) && (d.HasChange("ref") || d.HasChange("inputs")) {
+		err := dispatchGithubActionsWorkflow(ctx, d, meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubActionsWorkflowDispatchRead(d, meta)
+}
+
+func resourceGithubActionsWorkflowDispatchDelete(d *schema.ResourceData, meta interface{}) error {
+	// A dispatched event cannot be taken back
+	log.Printf("[DEBUG] Removing workflow dispatch %s from state; the runs it started remain in GitHub", d.Id())
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestGithubActionsWorkflowDispatchCreate(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/example/infra/actions/workflows/bootstrap.yml/dispatches",
+			ExpectedMethod: "POST",
+			ExpectedBody:   []byte(`{"ref":"main","inputs":{"environment":"staging"}}` + "\n"),
+			StatusCode:     204,
+		},
+		{
+			ExpectedUri:  "/repos/example/infra/actions/workflows/bootstrap.yml",
+			StatusCode:   200,
+			ResponseBody: `{"id": 161335, "path": ".github/workflows/bootstrap.yml", "state": "active"}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubActionsWorkflowDispatch().Schema, map[string]interface{}{
+		"repository": "infra",
+		"workflow":   "bootstrap.yml",
+		"ref":        "main",
+		"inputs":     map[string]interface{}{"environment": "staging"},
+	})
+	if err := resourceGithubActionsWorkflowDispatchCreate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "infra:bootstrap.yml" {
+		t.Fatalf("Unexpected ID %q", d.Id())
+	}
+	if d.Get("dispatched_at").(string) == "" {
+		t.Fatal("Expected the time of the dispatch to be recorded")
+	}
+}
+
+func TestGithubActionsWorkflowDispatchReadDeletedWorkflow(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/repos/example/infra/actions/workflows/bootstrap.yml",
+			StatusCode:   404,
+			ResponseBody: `{"message": "Not Found"}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubActionsWorkflowDispatch().Schema, map[string]interface{}{})
+	d.SetId("infra:bootstrap.yml")
+	if err := resourceGithubActionsWorkflowDispatchRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("Expected the dispatch of a deleted workflow to be removed from state, got %q", d.Id())
+	}
+}
@@ -0,0 +1,153 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// appManifestConversion is the GitHub App created from a manifest along with
+// its credentials, which are only ever returned by the conversion.
+type appManifestConversion struct {
+	githubApp
+	ClientID      *string `json:"client_id,omitempty"`
+	ClientSecret  *string `json:"client_secret,omitempty"`
+	WebhookSecret *string `json:"webhook_secret,omitempty"`
+	PEM           *string `json:"pem,omitempty"`
+}
+
+func resourceGithubApp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubAppCreate,
+		Read:   resourceGithubAppRead,
+		Delete: resourceGithubAppDelete,
+
+		Schema: map[string]*schema.Schema{
+			"code": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"app_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"webhook_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"pem": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceGithubAppCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	ctx := stopContext(meta)
+
+	// The code is only valid for an hour and can only be converted once
+	log.Printf("[DEBUG] Converting GitHub App manifest code")
+	app := new(appManifestConversion)
+	_, err := apiRequest(ctx, client, "POST",
+		fmt.Sprintf("app-manifests/%s/conversions", d.Get("code").(string)), nil, app)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(*app.ID, 10))
+	d.Set("client_id", app.ClientID)
+	d.Set("client_secret", app.ClientSecret)
+	d.Set("webhook_secret", app.WebhookSecret)
+	d.Set("pem", app.PEM)
+
+	return untilFound(d, meta, resourceGithubAppRead)
+}
+
+func resourceGithubAppRead(d *schema.ResourceData, meta interface{}) error {
+	appID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// Private apps can only be read by the app itself
+	client, err := newAppClient(meta, appID, d.Get("pem").(string))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading GitHub App: %d", appID)
+	app := new(githubApp)
+	_, err = apiRequest(ctx, client, "GET", "app", nil, app)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing GitHub App %d from state because it no longer exists in GitHub", appID)
+				d.SetId("")
+				return nil
+			}
+			// e.g. a private key which was revoked, rather than a deleted app
+			if ghErr.Response.StatusCode == http.StatusUnauthorized {
+				return fmt.Errorf("GitHub rejected the private key of GitHub App %d set by `pem`: %s", appID, ghErr.Message)
+			}
+		}
+		return err
+	}
+
+	d.Set("app_id", app.ID)
+	d.Set("node_id", app.NodeID)
+	d.Set("slug", app.Slug)
+	d.Set("name", app.Name)
+	d.Set("owner", app.Owner.GetLogin())
+	d.Set("html_url", app.HTMLURL)
+
+	return nil
+}
+
+func resourceGithubAppDelete(d *schema.ResourceData, meta interface{}) error {
+	// There is no API to delete an app
+	log.Printf("[WARN] GitHub App %s must be deleted in its settings on GitHub: %s", d.Id(), d.Get("html_url").(string))
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package github
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccGithubApp_basic(t *testing.T) {
+	// The code is obtained by submitting a manifest in a browser
+	code := os.Getenv("GITHUB_TEST_APP_MANIFEST_CODE")
+	if code == "" {
+		t.Skip("GITHUB_TEST_APP_MANIFEST_CODE must be set for this acceptance test")
+	}
+	rn := "github_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "github_app" "test" {
+  code = "%s"
+}
+`, code),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(rn, "app_id"),
+					resource.TestCheckResourceAttrSet(rn, "slug"),
+					resource.TestCheckResourceAttrSet(rn, "client_id"),
+					resource.TestCheckResourceAttrSet(rn, "client_secret"),
+					resource.TestCheckResourceAttrSet(rn, "pem"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGithubAppRead_notFound(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}))
+
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/app",
+			ResponseBody: `{"message": "A JSON web token could not be decoded"}`,
+			StatusCode:   401,
+		},
+		{
+			ExpectedUri:  "/app",
+			ResponseBody: `{"message": "Integration not found"}`,
+			StatusCode:   404,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubApp().Schema, map[string]interface{}{})
+	d.SetId("1234")
+	d.Set("pem", privateKey)
+
+	// A rejected private key does not mean the app was deleted
+	err = resourceGithubAppRead(d, meta)
+	if err == nil || !strings.Contains(err.Error(), "rejected the private key") || d.Id() != "1234" {
+		t.Fatalf("Expected the rejected private key to be reported, got %v", err)
+	}
+
+	if err := resourceGithubAppRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "" {
+		t.Fatal("Expected the app to be removed from state once it is not found")
+	}
+}
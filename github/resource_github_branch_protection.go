@@ -87,7 +87,8 @@ func resourceGithubBranchProtection() *schema.Resource {
 						"dismissal_users": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Elem:     &schema.Schema{Type: schema.TypeString, DiffSuppressFunc: caseInsensitive()},
+							Set:      hashStringCaseInsensitive,
 						},
 						"dismissal_teams": {
 							Type:     schema.TypeSet,
@@ -116,7 +117,8 @@ func resourceGithubBranchProtection() *schema.Resource {
 						"users": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Elem:     &schema.Schema{Type: schema.TypeString, DiffSuppressFunc: caseInsensitive()},
+							Set:      hashStringCaseInsensitive,
 						},
 						"teams": {
 							Type:     schema.TypeSet,
@@ -145,7 +147,7 @@ func resourceGithubBranchProtection() *schema.Resource {
 }
 
 func resourceGithubBranchProtectionCreate(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -160,7 +162,7 @@ func resourceGithubBranchProtectionCreate(d *schema.ResourceData, meta interface
 	if err != nil {
 		return err
 	}
-	ctx := context.Background()
+	ctx := stopContext(meta)
 
 	log.Printf("[DEBUG] Creating branch protection: %s/%s (%s)",
 		orgName, repoName, branch)
@@ -184,11 +186,11 @@ func resourceGithubBranchProtectionCreate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	return resourceGithubBranchProtectionRead(d, meta)
+	return untilFound(d, meta, resourceGithubBranchProtectionRead)
 }
 
 func resourceGithubBranchProtectionRead(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -201,10 +203,8 @@ func resourceGithubBranchProtectionRead(d *schema.ResourceData, meta interface{}
 	}
 	orgName := meta.(*Organization).name
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading branch protection: %s/%s (%s)",
 		orgName, repoName, branch)
@@ -254,7 +254,7 @@ func resourceGithubBranchProtectionRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGithubBranchProtectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -271,7 +271,7 @@ func resourceGithubBranchProtectionUpdate(d *schema.ResourceData, meta interface
 	}
 
 	orgName := meta.(*Organization).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Updating branch protection: %s/%s (%s)",
 		orgName, repoName, branch)
@@ -310,7 +310,7 @@ func resourceGithubBranchProtectionUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceGithubBranchProtectionDelete(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -322,7 +322,7 @@ func resourceGithubBranchProtectionDelete(d *schema.ResourceData, meta interface
 	}
 
 	orgName := meta.(*Organization).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting branch protection: %s/%s (%s)", orgName, repoName, branch)
 	_, err = client.Repositories.RemoveBranchProtection(ctx,
@@ -384,10 +384,7 @@ func requireSignedCommitsRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	orgName := meta.(*Organization).name
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Reading branch protection signed commit status: %s/%s (%s)", orgName, repoName, branch)
 	signedCommitStatus, _, err := client.Repositories.GetSignaturesProtectedBranch(ctx,
@@ -410,10 +407,7 @@ func requireSignedCommitsUpdate(d *schema.ResourceData, meta interface{}) (err e
 	}
 	orgName := meta.(*Organization).name
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	if requiredSignedCommit {
 		log.Printf("[DEBUG] Enabling branch protection signed commit: %s/%s (%s) - $s", orgName, repoName, branch)
@@ -445,7 +439,7 @@ func flattenAndSetRequiredPullRequestReviews(d *schema.ResourceData, protection
 		return d.Set("required_pull_request_reviews", []interface{}{
 			map[string]interface{}{
 				"dismiss_stale_reviews":           rprr.DismissStaleReviews,
-				"dismissal_users":                 schema.NewSet(schema.HashString, users),
+				"dismissal_users":                 schema.NewSet(hashStringCaseInsensitive, users),
 				"dismissal_teams":                 schema.NewSet(schema.HashString, teams),
 				"require_code_owner_reviews":      rprr.RequireCodeOwnerReviews,
 				"required_approving_review_count": rprr.RequiredApprovingReviewCount,
@@ -475,7 +469,7 @@ func flattenAndSetRestrictions(d *schema.ResourceData, protection *github.Protec
 
 		return d.Set("restrictions", []interface{}{
 			map[string]interface{}{
-				"users": schema.NewSet(schema.HashString, users),
+				"users": schema.NewSet(hashStringCaseInsensitive, users),
 				"teams": schema.NewSet(schema.HashString, teams),
 			},
 		})
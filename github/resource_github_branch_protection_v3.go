@@ -0,0 +1,442 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// The settings are sent even when they are nil, because updating the
+// protection replaces all of it and only an explicit null removes a setting.
+type branchProtectionV3Request struct {
+	RequiredStatusChecks           *github.RequiredStatusChecks      `json:"required_status_checks"`
+	EnforceAdmins                  bool                              `json:"enforce_admins"`
+	RequiredPullRequestReviews     *branchProtectionV3ReviewsRequest `json:"required_pull_request_reviews"`
+	Restrictions                   *branchProtectionV3Actors         `json:"restrictions"`
+	RequiredLinearHistory          bool                              `json:"required_linear_history"`
+	AllowForcePushes               bool                              `json:"allow_force_pushes"`
+	AllowDeletions                 bool                              `json:"allow_deletions"`
+	RequiredConversationResolution bool                              `json:"required_conversation_resolution"`
+}
+
+type branchProtectionV3ReviewsRequest struct {
+	DismissalRestrictions        *branchProtectionV3Actors `json:"dismissal_restrictions,omitempty"`
+	DismissStaleReviews          bool                      `json:"dismiss_stale_reviews"`
+	RequireCodeOwnerReviews      bool                      `json:"require_code_owner_reviews"`
+	RequiredApprovingReviewCount int                       `json:"required_approving_review_count"`
+	RequireLastPushApproval      bool                      `json:"require_last_push_approval"`
+	BypassPullRequestAllowances  *branchProtectionV3Actors `json:"bypass_pull_request_allowances,omitempty"`
+}
+
+// branchProtectionV3Actors lists users by login, and teams and apps by slug.
+type branchProtectionV3Actors struct {
+	Users []string `json:"users"`
+	Teams []string `json:"teams"`
+	Apps  []string `json:"apps"`
+}
+
+type branchProtectionV3 struct {
+	RequiredStatusChecks           *github.RequiredStatusChecks `json:"required_status_checks,omitempty"`
+	EnforceAdmins                  *branchProtectionV3Setting   `json:"enforce_admins,omitempty"`
+	RequiredPullRequestReviews     *branchProtectionV3Reviews   `json:"required_pull_request_reviews,omitempty"`
+	Restrictions                   *branchProtectionV3ActorList `json:"restrictions,omitempty"`
+	RequiredSignatures             *branchProtectionV3Setting   `json:"required_signatures,omitempty"`
+	RequiredLinearHistory          *branchProtectionV3Setting   `json:"required_linear_history,omitempty"`
+	AllowForcePushes               *branchProtectionV3Setting   `json:"allow_force_pushes,omitempty"`
+	AllowDeletions                 *branchProtectionV3Setting   `json:"allow_deletions,omitempty"`
+	RequiredConversationResolution *branchProtectionV3Setting   `json:"required_conversation_resolution,omitempty"`
+}
+
+type branchProtectionV3Setting struct {
+	Enabled bool `json:"enabled"`
+}
+
+type branchProtectionV3Reviews struct {
+	DismissalRestrictions        *branchProtectionV3ActorList `json:"dismissal_restrictions,omitempty"`
+	DismissStaleReviews          bool                         `json:"dismiss_stale_reviews"`
+	RequireCodeOwnerReviews      bool                         `json:"require_code_owner_reviews"`
+	RequiredApprovingReviewCount int                          `json:"required_approving_review_count"`
+	RequireLastPushApproval      bool                         `json:"require_last_push_approval"`
+	BypassPullRequestAllowances  *branchProtectionV3ActorList `json:"bypass_pull_request_allowances,omitempty"`
+}
+
+type branchProtectionV3ActorList struct {
+	Users []*branchProtectionV3Actor `json:"users"`
+	Teams []*branchProtectionV3Actor `json:"teams"`
+	Apps  []*branchProtectionV3Actor `json:"apps"`
+}
+
+type branchProtectionV3Actor struct {
+	Login string `json:"login,omitempty"`
+	Slug  string `json:"slug,omitempty"`
+}
+
+func branchProtectionV3ActorsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"users": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString, DiffSuppressFunc: caseInsensitive()},
+			Set:      hashStringCaseInsensitive,
+		},
+		"teams": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"apps": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func resourceGithubBranchProtectionV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubBranchProtectionV3CreateOrUpdate,
+		Read:   resourceGithubBranchProtectionV3Read,
+		Update: resourceGithubBranchProtectionV3CreateOrUpdate,
+		Delete: resourceGithubBranchProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"branch": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"required_status_checks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"strict": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"contexts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"required_pull_request_reviews": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dismiss_stale_reviews": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"dismissal_users": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"dismissal_teams": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"dismissal_apps": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"require_code_owner_reviews": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"required_approving_review_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 6),
+						},
+						"require_last_push_approval": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"bypass_pull_request_allowances": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: branchProtectionV3ActorsSchema(),
+							},
+						},
+					},
+				},
+			},
+			"restrictions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: branchProtectionV3ActorsSchema(),
+				},
+			},
+			"enforce_admins": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"require_signed_commits": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"required_linear_history": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"allow_force_pushes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"allow_deletions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"require_conversation_resolution": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandBranchProtectionV3Actors(m map[string]interface{}, prefix string) *branchProtectionV3Actors {
+	return &branchProtectionV3Actors{
+		Users: expandNestedSet(m, prefix+"users"),
+		Teams: expandNestedSet(m, prefix+"teams"),
+		Apps:  expandNestedSet(m, prefix+"apps"),
+	}
+}
+
+func flattenBranchProtectionV3Actors(actors *branchProtectionV3ActorList) map[string]interface{} {
+	users := []interface{}{}
+	teams := []interface{}{}
+	apps := []interface{}{}
+	if actors != nil {
+		for _, u := range actors.Users {
+			users = append(users, u.Login)
+		}
+		for _, t := range actors.Teams {
+			teams = append(teams, t.Slug)
+		}
+		for _, a := range actors.Apps {
+			apps = append(apps, a.Slug)
+		}
+	}
+
+	return map[string]interface{}{
+		"users": schema.NewSet(hashStringCaseInsensitive, users),
+		"teams": schema.NewSet(schema.HashString, teams),
+		"apps":  schema.NewSet(schema.HashString, apps),
+	}
+}
+
+func buildBranchProtectionV3Request(d *schema.ResourceData) *branchProtectionV3Request {
+	req := &branchProtectionV3Request{
+		EnforceAdmins:                  d.Get("enforce_admins").(bool),
+		RequiredLinearHistory:          d.Get("required_linear_history").(bool),
+		AllowForcePushes:               d.Get("allow_force_pushes").(bool),
+		AllowDeletions:                 d.Get("allow_deletions").(bool),
+		RequiredConversationResolution: d.Get("require_conversation_resolution").(bool),
+	}
+
+	if v := d.Get("required_status_checks").([]interface{}); len(v) > 0 {
+		req.RequiredStatusChecks = &github.RequiredStatusChecks{Contexts: []string{}}
+		if v[0] != nil {
+			m := v[0].(map[string]interface{})
+			req.RequiredStatusChecks.Strict = m["strict"].(bool)
+			req.RequiredStatusChecks.Contexts = expandNestedSet(m, "contexts")
+		}
+	}
+
+	if v := d.Get("required_pull_request_reviews").([]interface{}); len(v) > 0 {
+		req.RequiredPullRequestReviews = &branchProtectionV3ReviewsRequest{RequiredApprovingReviewCount: 1}
+		if v[0] != nil {
+			m := v[0].(map[string]interface{})
+			reviews := req.RequiredPullRequestReviews
+			reviews.DismissalRestrictions = expandBranchProtectionV3Actors(m, "dismissal_")
+			reviews.DismissStaleReviews = m["dismiss_stale_reviews"].(bool)
+			reviews.RequireCodeOwnerReviews = m["require_code_owner_reviews"].(bool)
+			reviews.RequiredApprovingReviewCount = m["required_approving_review_count"].(int)
+			reviews.RequireLastPushApproval = m["require_last_push_approval"].(bool)
+			if b := m["bypass_pull_request_allowances"].([]interface{}); len(b) > 0 && b[0] != nil {
+				reviews.BypassPullRequestAllowances = expandBranchProtectionV3Actors(b[0].(map[string]interface{}), "")
+			} else {
+				reviews.BypassPullRequestAllowances = &branchProtectionV3Actors{
+					Users: []string{},
+					Teams: []string{},
+					Apps:  []string{},
+				}
+			}
+		}
+	}
+
+	// The API won't initialize the lists as empty when they are not sent
+	if v := d.Get("restrictions").([]interface{}); len(v) > 0 {
+		req.Restrictions = &branchProtectionV3Actors{Users: []string{}, Teams: []string{}, Apps: []string{}}
+		if v[0] != nil {
+			req.Restrictions = expandBranchProtectionV3Actors(v[0].(map[string]interface{}), "")
+		}
+	}
+
+	return req
+}
+
+func branchProtectionURL(owner, repoName, branch string) string {
+	return fmt.Sprintf("repos/%s/%s/branches/%s/protection", owner, repoName, branch)
+}
+
+func resourceGithubBranchProtectionV3CreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	branch := d.Get("branch").(string)
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	log.Printf("[DEBUG] Setting branch protection: %s/%s (%s)", orgName, repoName, branch)
+	_, err = apiRequest(ctx, client, "PUT", branchProtectionURL(orgName, repoName, branch),
+		buildBranchProtectionV3Request(d), nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&repoName, &branch))
+
+	if err = requireSignedCommitsUpdate(d, meta); err != nil {
+		return err
+	}
+
+	return untilFound(d, meta, resourceGithubBranchProtectionV3Read)
+}
+
+func resourceGithubBranchProtectionV3Read(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	repoName, branch, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	orgName := meta.(*Organization).name
+
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading branch protection: %s/%s (%s)", orgName, repoName, branch)
+	protection := new(branchProtectionV3)
+	resp, err := apiRequest(ctx, client, "GET", branchProtectionURL(orgName, repoName, branch), nil, protection)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing branch protection %s/%s (%s) from state because it no longer exists in GitHub",
+					orgName, repoName, branch)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("repository", repoName)
+	d.Set("branch", branch)
+
+	enabled := func(s *branchProtectionV3Setting) bool {
+		return s != nil && s.Enabled
+	}
+	d.Set("enforce_admins", enabled(protection.EnforceAdmins))
+	d.Set("require_signed_commits", enabled(protection.RequiredSignatures))
+	d.Set("required_linear_history", enabled(protection.RequiredLinearHistory))
+	d.Set("allow_force_pushes", enabled(protection.AllowForcePushes))
+	d.Set("allow_deletions", enabled(protection.AllowDeletions))
+	d.Set("require_conversation_resolution", enabled(protection.RequiredConversationResolution))
+
+	if rsc := protection.RequiredStatusChecks; rsc != nil {
+		d.Set("required_status_checks", []interface{}{map[string]interface{}{
+			"strict":   rsc.Strict,
+			"contexts": schema.NewSet(schema.HashString, flattenStringList(rsc.Contexts)),
+		}})
+	} else {
+		d.Set("required_status_checks", []interface{}{})
+	}
+
+	if rprr := protection.RequiredPullRequestReviews; rprr != nil {
+		dismissal := flattenBranchProtectionV3Actors(rprr.DismissalRestrictions)
+		bypass := []interface{}{}
+		if b := rprr.BypassPullRequestAllowances; b != nil && len(b.Users)+len(b.Teams)+len(b.Apps) > 0 {
+			bypass = append(bypass, flattenBranchProtectionV3Actors(b))
+		}
+		d.Set("required_pull_request_reviews", []interface{}{map[string]interface{}{
+			"dismiss_stale_reviews":           rprr.DismissStaleReviews,
+			"dismissal_users":                 dismissal["users"],
+			"dismissal_teams":                 dismissal["teams"],
+			"dismissal_apps":                  dismissal["apps"],
+			"require_code_owner_reviews":      rprr.RequireCodeOwnerReviews,
+			"required_approving_review_count": rprr.RequiredApprovingReviewCount,
+			"require_last_push_approval":      rprr.RequireLastPushApproval,
+			"bypass_pull_request_allowances":  bypass,
+		}})
+	} else {
+		d.Set("required_pull_request_reviews", []interface{}{})
+	}
+
+	if protection.Restrictions != nil {
+		d.Set("restrictions", []interface{}{flattenBranchProtectionV3Actors(protection.Restrictions)})
+	} else {
+		d.Set("restrictions", []interface{}{})
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestResourceGithubBranchProtectionV3(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/example/app/branches/main/protection",
+			ExpectedMethod: "PUT",
+			ExpectedBody: []byte(`{"required_status_checks":null,"enforce_admins":true,` +
+				`"required_pull_request_reviews":{"dismissal_restrictions":{"users":[],"teams":[],"apps":[]},` +
+				`"dismiss_stale_reviews":false,"require_code_owner_reviews":false,"required_approving_review_count":1,` +
+				`"require_last_push_approval":false,"bypass_pull_request_allowances":{"users":[],"teams":[],"apps":["ci-bot"]}},` +
+				`"restrictions":{"users":[],"teams":[],"apps":["deployer"]},"required_linear_history":false,` +
+				`"allow_force_pushes":false,"allow_deletions":false,"required_conversation_resolution":true}` + "\n"),
+			StatusCode:   200,
+			ResponseBody: `{}`,
+		},
+		{
+			ExpectedUri:    "/repos/example/app/branches/main/protection/required_signatures",
+			ExpectedMethod: "DELETE",
+			StatusCode:     204,
+		},
+		{
+			ExpectedUri:    "/repos/example/app/branches/main/protection",
+			ExpectedMethod: "GET",
+			StatusCode:     200,
+			ResponseBody: `{
+  "enforce_admins": {"enabled": true},
+  "required_pull_request_reviews": {
+    "dismissal_restrictions": {"users": [], "teams": [], "apps": []},
+    "required_approving_review_count": 1,
+    "bypass_pull_request_allowances": {"users": [], "teams": [], "apps": [{"slug": "ci-bot"}]}
+  },
+  "restrictions": {"users": [], "teams": [], "apps": [{"slug": "deployer"}]},
+  "required_signatures": {"enabled": false},
+  "required_conversation_resolution": {"enabled": true}
+}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubBranchProtectionV3().Schema, map[string]interface{}{
+		"repository":     "app",
+		"branch":         "main",
+		"enforce_admins": true,
+		"required_pull_request_reviews": []interface{}{map[string]interface{}{
+			"bypass_pull_request_allowances": []interface{}{map[string]interface{}{
+				"apps": []interface{}{"ci-bot"},
+			}},
+		}},
+		"restrictions": []interface{}{map[string]interface{}{
+			"apps": []interface{}{"deployer"},
+		}},
+		"require_conversation_resolution": true,
+	})
+	if err := resourceGithubBranchProtectionV3CreateOrUpdate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "app:main" {
+		t.Fatalf("Expected ID app:main, got %q", d.Id())
+	}
+	if apps := d.Get("required_pull_request_reviews.0.bypass_pull_request_allowances.0.apps").(*schema.Set); !apps.Contains("ci-bot") {
+		t.Fatalf("Expected ci-bot to be allowed to bypass reviews, got %v", apps.List())
+	}
+	if apps := d.Get("restrictions.0.apps").(*schema.Set); !apps.Contains("deployer") {
+		t.Fatalf("Expected deployer to be allowed to push, got %v", apps.List())
+	}
+}
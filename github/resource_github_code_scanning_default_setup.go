@@ -0,0 +1,160 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type codeScanningDefaultSetup struct {
+	State      *string  `json:"state,omitempty"`
+	QuerySuite *string  `json:"query_suite,omitempty"`
+	Languages  []string `json:"languages,omitempty"`
+}
+
+func resourceGithubCodeScanningDefaultSetup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubCodeScanningDefaultSetupCreateOrUpdate,
+		Read:   resourceGithubCodeScanningDefaultSetupRead,
+		Update: resourceGithubCodeScanningDefaultSetupCreateOrUpdate,
+		Delete: resourceGithubCodeScanningDefaultSetupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"query_suite": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "default",
+				ValidateFunc: validateValueFunc([]string{"default", "extended"}),
+			},
+			// When no languages are configured GitHub analyses every
+			// supported language it detects in the repository.
+			"languages": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validateValueFunc([]string{
+						"c-cpp", "csharp", "go", "java-kotlin", "javascript-typescript",
+						"python", "ruby", "swift",
+					}),
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func codeScanningDefaultSetupURL(owner, repoName string) string {
+	return fmt.Sprintf("repos/%s/%s/code-scanning/default-setup", owner, repoName)
+}
+
+func resourceGithubCodeScanningDefaultSetupCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	setup := &codeScanningDefaultSetup{
+		State:      github.String("configured"),
+		QuerySuite: github.String(d.Get("query_suite").(string)),
+		Languages:  expandStringList(d.Get("languages").(*schema.Set).List()),
+	}
+
+	// The API answers with 202 Accepted while the first analysis is queued
+	log.Printf("[DEBUG] Configuring code scanning default setup: %s/%s", owner, repoName)
+	_, err := apiRequest(ctx, client, "PATCH", codeScanningDefaultSetupURL(owner, repoName), setup, nil)
+	if err != nil {
+		if _, ok := err.(*github.AcceptedError); !ok {
+			return err
+		}
+	}
+
+	d.SetId(repoName)
+
+	return untilFound(d, meta, resourceGithubCodeScanningDefaultSetupRead)
+}
+
+func resourceGithubCodeScanningDefaultSetupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading code scanning default setup: %s/%s", owner, repoName)
+	setup := new(codeScanningDefaultSetup)
+	resp, err := apiRequest(ctx, client, "GET", codeScanningDefaultSetupURL(owner, repoName), nil, setup)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing code scanning default setup %s/%s from state because the repository no longer exists in GitHub",
+					owner, repoName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	if setup.State != nil && *setup.State == "not-configured" {
+		log.Printf("[WARN] Removing code scanning default setup %s/%s from state because it is no longer configured",
+			owner, repoName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("repository", repoName)
+	d.Set("state", setup.State)
+	d.Set("query_suite", setup.QuerySuite)
+	d.Set("languages", flattenStringList(setup.Languages))
+
+	return nil
+}
+
+func resourceGithubCodeScanningDefaultSetupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	setup := &codeScanningDefaultSetup{
+		State: github.String("not-configured"),
+	}
+
+	log.Printf("[DEBUG] Disabling code scanning default setup: %s/%s", owner, repoName)
+	_, err := apiRequest(ctx, client, "PATCH", codeScanningDefaultSetupURL(owner, repoName), setup, nil)
+	if _, ok := err.(*github.AcceptedError); ok {
+		return nil
+	}
+	return err
+}
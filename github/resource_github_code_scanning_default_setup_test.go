@@ -0,0 +1,57 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubCodeScanningDefaultSetup_basic(t *testing.T) {
+	rn := "github_code_scanning_default_setup.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-codeql-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubCodeScanningDefaultSetupConfig(repoName, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "repository", repoName),
+					resource.TestCheckResourceAttr(rn, "state", "configured"),
+					resource.TestCheckResourceAttr(rn, "query_suite", "default"),
+					resource.TestCheckResourceAttr(rn, "languages.#", "1"),
+				),
+			},
+			{
+				Config: testAccGithubCodeScanningDefaultSetupConfig(repoName, "extended"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "query_suite", "extended"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubCodeScanningDefaultSetupConfig(repoName, querySuite string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+resource "github_code_scanning_default_setup" "test" {
+  repository  = "${github_repository.test.name}"
+  query_suite = "%s"
+  languages   = ["python"]
+}
+`, repoName, querySuite)
+}
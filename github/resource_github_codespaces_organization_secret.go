@@ -0,0 +1,127 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubCodespacesOrganizationSecret() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceGithubCodespacesOrganizationSecretCreateOrUpdate,
+		Read:          resourceGithubCodespacesOrganizationSecretRead,
+		Update:        resourceGithubCodespacesOrganizationSecretCreateOrUpdate,
+		Delete:        resourceGithubCodespacesOrganizationSecretDelete,
+		CustomizeDiff: resourceGithubCodespacesOrganizationSecretDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: codespacesSecretSchema(map[string]*schema.Schema{
+			"visibility": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateValueFunc([]string{"all", "private", "selected"}),
+			},
+			"selected_repository_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Set:      schema.HashInt,
+			},
+		}),
+	}
+}
+
+func resourceGithubCodespacesOrganizationSecretDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("visibility").(string) != "selected" && d.Get("selected_repository_ids").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("`selected_repository_ids` can only be set when `visibility` is \"selected\"")
+	}
+
+	return nil
+}
+
+func resourceGithubCodespacesOrganizationSecretCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	secret := &codespacesSecret{
+		Visibility: github.String(d.Get("visibility").(string)),
+	}
+	if *secret.Visibility == "selected" {
+		secret.SelectedRepositoryIDs = expandSelectedRepositoryIDs(d.Get("selected_repository_ids").(*schema.Set))
+	}
+
+	err = setCodespacesSecret(ctx, d, client, fmt.Sprintf("orgs/%s/codespaces/secrets", orgName), secret)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("secret_name").(string))
+
+	return untilFound(d, meta, resourceGithubCodespacesOrganizationSecretRead)
+}
+
+func resourceGithubCodespacesOrganizationSecretRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	baseURL := fmt.Sprintf("orgs/%s/codespaces/secrets", orgName)
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	secret, err := readCodespacesSecret(ctx, d, client, baseURL, d.Id())
+	if err != nil {
+		return err
+	}
+	if secret == nil {
+		log.Printf("[WARN] Removing Codespaces organization secret %s from state because it no longer exists in GitHub",
+			d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	ids := []interface{}{}
+	if secret.Visibility != nil && *secret.Visibility == "selected" {
+		ids, err = listCodespacesSecretRepositories(ctx, meta, baseURL, d.Id())
+		if err != nil {
+			return err
+		}
+	}
+
+	d.Set("visibility", secret.Visibility)
+	d.Set("selected_repository_ids", schema.NewSet(schema.HashInt, ids))
+
+	return nil
+}
+
+func resourceGithubCodespacesOrganizationSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting Codespaces organization secret: %s (%s)", d.Id(), orgName)
+	_, err = apiRequest(ctx, client, "DELETE", fmt.Sprintf("orgs/%s/codespaces/secrets/%s", orgName, d.Id()), nil, nil)
+
+	return err
+}
@@ -0,0 +1,54 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestResourceGithubCodespacesOrganizationSecretCreate(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/orgs/example/codespaces/secrets/API_TOKEN",
+			ExpectedMethod: "PUT",
+			ExpectedBody:   []byte(`{"encrypted_value":"c2VhbGVk","key_id":"568250167242549743","visibility":"selected","selected_repository_ids":[1296269]}` + "\n"),
+			StatusCode:     201,
+		},
+		{
+			ExpectedUri:  "/orgs/example/codespaces/secrets/API_TOKEN",
+			ResponseBody: `{"name": "API_TOKEN", "visibility": "selected", "created_at": "2024-01-10T10:00:00Z", "updated_at": "2024-01-10T10:00:00Z"}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/orgs/example/codespaces/secrets/API_TOKEN/repositories?page=1&per_page=100",
+			ResponseBody: `{"total_count": 1, "repositories": [{"id": 1296269, "name": "api"}]}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubCodespacesOrganizationSecret().Schema, map[string]interface{}{
+		"secret_name":             "API_TOKEN",
+		"encrypted_value":         "c2VhbGVk",
+		"key_id":                  "568250167242549743",
+		"visibility":              "selected",
+		"selected_repository_ids": []interface{}{1296269},
+	})
+	if err := resourceGithubCodespacesOrganizationSecretCreateOrUpdate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "API_TOKEN" {
+		t.Fatalf("Expected ID API_TOKEN, got %q", d.Id())
+	}
+	ids := d.Get("selected_repository_ids").(*schema.Set)
+	if ids.Len() != 1 || !ids.Contains(1296269) {
+		t.Fatalf("Expected the selected repository to be read, got %v", ids.List())
+	}
+}
@@ -0,0 +1,109 @@
+package github
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type codespacesAccess struct {
+	Visibility        string   `json:"visibility"`
+	SelectedUsernames []string `json:"selected_usernames,omitempty"`
+}
+
+func resourceGithubCodespacesOrganizationSettings() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceGithubCodespacesOrganizationSettingsCreateOrUpdate,
+		Read:          resourceGithubCodespacesOrganizationSettingsRead,
+		Update:        resourceGithubCodespacesOrganizationSettingsCreateOrUpdate,
+		Delete:        resourceGithubCodespacesOrganizationSettingsDelete,
+		CustomizeDiff: resourceGithubCodespacesOrganizationSettingsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"visibility": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validateValueFunc([]string{
+					"disabled",
+					"selected_members",
+					"all_members",
+					"all_members_and_outside_collaborators",
+				}),
+			},
+			"selected_usernames": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceGithubCodespacesOrganizationSettingsDiff(d *schema.ResourceDiff, meta interface{}) error {
+	selected := d.Get("selected_usernames").(*schema.Set).Len() > 0
+	if d.Get("visibility").(string) == "selected_members" {
+		if !selected {
+			return fmt.Errorf("`selected_usernames` must be set when `visibility` is \"selected_members\"")
+		}
+	} else if selected {
+		return fmt.Errorf("`selected_usernames` can only be set when `visibility` is \"selected_members\"")
+	}
+
+	return nil
+}
+
+func setCodespacesAccess(meta interface{}, access *codespacesAccess) error {
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Setting Codespaces access to %s: %s", access.Visibility, orgName)
+	_, err := apiRequest(ctx, client, "PUT", fmt.Sprintf("orgs/%s/codespaces/access", orgName), access, nil)
+
+	return err
+}
+
+func resourceGithubCodespacesOrganizationSettingsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	err = setCodespacesAccess(meta, &codespacesAccess{
+		Visibility:        d.Get("visibility").(string),
+		SelectedUsernames: expandStringList(d.Get("selected_usernames").(*schema.Set).List()),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(meta.(*Organization).name)
+
+	return untilFound(d, meta, resourceGithubCodespacesOrganizationSettingsRead)
+}
+
+func resourceGithubCodespacesOrganizationSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	// There is no API to read who can use Codespaces, so the configured
+	// settings are kept as they were applied
+	log.Printf("[DEBUG] Codespaces access of %s cannot be read from GitHub", d.Id())
+
+	return nil
+}
+
+func resourceGithubCodespacesOrganizationSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	// Codespaces are disabled for organizations by default
+	return setCodespacesAccess(meta, &codespacesAccess{Visibility: "disabled"})
+}
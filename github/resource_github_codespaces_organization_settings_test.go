@@ -0,0 +1,47 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestResourceGithubCodespacesOrganizationSettings(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/orgs/example/codespaces/access",
+			ExpectedMethod: "PUT",
+			ExpectedBody:   []byte(`{"visibility":"selected_members","selected_usernames":["octocat"]}` + "\n"),
+			StatusCode:     204,
+		},
+		{
+			ExpectedUri:    "/orgs/example/codespaces/access",
+			ExpectedMethod: "PUT",
+			ExpectedBody:   []byte(`{"visibility":"disabled"}` + "\n"),
+			StatusCode:     204,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubCodespacesOrganizationSettings().Schema, map[string]interface{}{
+		"visibility":         "selected_members",
+		"selected_usernames": []interface{}{"octocat"},
+	})
+	if err := resourceGithubCodespacesOrganizationSettingsCreateOrUpdate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "example" {
+		t.Fatalf("Expected ID example, got %q", d.Id())
+	}
+
+	// Destroying the settings disables Codespaces again
+	if err := resourceGithubCodespacesOrganizationSettingsDelete(d, meta); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,277 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+type codespacesSecret struct {
+	Name                  *string `json:"name,omitempty"`
+	EncryptedValue        *string `json:"encrypted_value,omitempty"`
+	KeyID                 *string `json:"key_id,omitempty"`
+	Visibility            *string `json:"visibility,omitempty"`
+	SelectedRepositoryIDs []int64 `json:"selected_repository_ids,omitempty"`
+	CreatedAt             *string `json:"created_at,omitempty"`
+	UpdatedAt             *string `json:"updated_at,omitempty"`
+}
+
+type codespacesSecretRepositories struct {
+	TotalCount   int                  `json:"total_count"`
+	Repositories []*github.Repository `json:"repositories"`
+}
+
+// codespacesSecretSchema returns the arguments shared by the Codespaces
+// secrets of every scope. GitHub only accepts values encrypted with the
+// public key of the scope as a libsodium sealed box, so a plaintext_value is
+// encrypted before it is sent.
+func codespacesSecretSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["secret_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`),
+			"must only contain alphanumeric characters or underscores and not start with a number"),
+	}
+	s["encrypted_value"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"plaintext_value"},
+	}
+	s["plaintext_value"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{"encrypted_value"},
+	}
+	s["key_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+	s["created_at"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["updated_at"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return s
+}
+
+// setCodespacesSecret creates or updates the secret at baseURL/name. A
+// plaintext_value is encrypted with the current public key of the scope.
+// Unless the configuration names the key an encrypted_value was encrypted
+// with, it is taken to be the current public key too.
+func setCodespacesSecret(ctx context.Context, d *schema.ResourceData, client *github.Client, baseURL string, secret *codespacesSecret) error {
+	name := d.Get("secret_name").(string)
+	encryptedValue, hasEncryptedValue := d.GetOk("encrypted_value")
+	plaintextValue, hasPlaintextValue := d.GetOk("plaintext_value")
+	if !hasEncryptedValue && !hasPlaintextValue {
+		return fmt.Errorf("one of `encrypted_value` or `plaintext_value` must be set for Codespaces secret %s", name)
+	}
+
+	keyID := d.Get("key_id").(string)
+	if keyID == "" || hasPlaintextValue {
+		key := new(secretsPublicKey)
+		_, err := apiRequest(ctx, client, "GET", baseURL+"/public-key", nil, key)
+		if err != nil {
+			return err
+		}
+		keyID = key.GetKeyID()
+
+		if hasPlaintextValue {
+			encrypted, err := encryptSecretValue(key, plaintextValue.(string))
+			if err != nil {
+				return err
+			}
+			encryptedValue = encrypted
+		}
+	}
+
+	secret.EncryptedValue = github.String(encryptedValue.(string))
+	secret.KeyID = github.String(keyID)
+
+	log.Printf("[DEBUG] Setting Codespaces secret: %s/%s", baseURL, name)
+	_, err := apiRequest(ctx, client, "PUT", fmt.Sprintf("%s/%s", baseURL, name), secret, nil)
+	if err != nil {
+		return err
+	}
+	d.Set("key_id", keyID)
+	// This write updated the secret, so the read which follows records when
+	// rather than taking it for an update outside of Terraform
+	d.Set("updated_at", "")
+
+	return nil
+}
+
+// readCodespacesSecret reads the secret at baseURL/name, returning nil when it
+// no longer exists. Its value cannot be read back, so a secret updated outside
+// of Terraform is set again on the next apply.
+func readCodespacesSecret(ctx context.Context, d *schema.ResourceData, client *github.Client, baseURL, name string) (*codespacesSecret, error) {
+	log.Printf("[DEBUG] Reading Codespaces secret: %s/%s", baseURL, name)
+	secret := new(codespacesSecret)
+	_, err := apiRequest(ctx, client, "GET", fmt.Sprintf("%s/%s", baseURL, name), nil, secret)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if updatedAt := d.Get("updated_at").(string); updatedAt != "" && updatedAt != secret.GetUpdatedAt() {
+		log.Printf("[WARN] Codespaces secret %s/%s was updated outside of Terraform", baseURL, name)
+		d.Set("encrypted_value", "")
+		d.Set("plaintext_value", "")
+	}
+
+	d.Set("secret_name", name)
+	d.Set("created_at", secret.CreatedAt)
+	d.Set("updated_at", secret.UpdatedAt)
+
+	return secret, nil
+}
+
+func (s *codespacesSecret) GetUpdatedAt() string {
+	if s == nil || s.UpdatedAt == nil {
+		return ""
+	}
+	return *s.UpdatedAt
+}
+
+// listCodespacesSecretRepositories returns the IDs of the repositories which
+// can use the secret at baseURL/name.
+func listCodespacesSecretRepositories(ctx context.Context, meta interface{}, baseURL, name string) ([]interface{}, error) {
+	client := meta.(*Organization).client
+	ids := []interface{}{}
+	repositories, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		result := new(codespacesSecretRepositories)
+		resp, err := apiRequest(ctx, client, "GET",
+			fmt.Sprintf("%s/%s/repositories?%s", baseURL, name, pageQuery(url.Values{}, opt)), nil, result)
+		return result.Repositories, resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range repositories {
+		ids = append(ids, int(v.(*github.Repository).GetID()))
+	}
+
+	return ids, nil
+}
+
+func expandSelectedRepositoryIDs(s *schema.Set) []int64 {
+	ids := []int64{}
+	for _, id := range s.List() {
+		ids = append(ids, int64(id.(int)))
+	}
+
+	return ids
+}
+
+func resourceGithubCodespacesSecret() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubCodespacesSecretCreateOrUpdate,
+		Read:   resourceGithubCodespacesSecretRead,
+		Update: resourceGithubCodespacesSecretCreateOrUpdate,
+		Delete: resourceGithubCodespacesSecretDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: codespacesSecretSchema(map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		}),
+	}
+}
+
+func resourceGithubCodespacesSecretCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	name := d.Get("secret_name").(string)
+	ctx := stopContext(meta)
+
+	err = setCodespacesSecret(ctx, d, client, fmt.Sprintf("repos/%s/%s/codespaces/secrets", owner, repoName),
+		&codespacesSecret{})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&repoName, &name))
+
+	return untilFound(d, meta, resourceGithubCodespacesSecretRead)
+}
+
+func resourceGithubCodespacesSecretRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, name, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	secret, err := readCodespacesSecret(ctx, d, client, fmt.Sprintf("repos/%s/%s/codespaces/secrets", owner, repoName), name)
+	if err != nil {
+		return err
+	}
+	if secret == nil {
+		log.Printf("[WARN] Removing Codespaces secret %s from state because it no longer exists in GitHub",
+			d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("repository", repoName)
+
+	return nil
+}
+
+func resourceGithubCodespacesSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, name, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting Codespaces secret: %s", d.Id())
+	_, err = apiRequest(ctx, client, "DELETE",
+		fmt.Sprintf("repos/%s/%s/codespaces/secrets/%s", owner, repoName, name), nil, nil)
+
+	return err
+}
@@ -0,0 +1,129 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestResourceGithubCodespacesSecretCreate(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/repos/example/api/codespaces/secrets/public-key",
+			ResponseBody: `{"key_id": "568250167242549743", "key": "hBT5WZEj8ZoOv6TYJsfWq7MxTEQopZO5/IT3ZCVQPzs="}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:    "/repos/example/api/codespaces/secrets/API_TOKEN",
+			ExpectedMethod: "PUT",
+			ExpectedBody:   []byte(`{"encrypted_value":"c2VhbGVk","key_id":"568250167242549743"}` + "\n"),
+			StatusCode:     201,
+		},
+		{
+			ExpectedUri:  "/repos/example/api/codespaces/secrets/API_TOKEN",
+			ResponseBody: `{"name": "API_TOKEN", "created_at": "2024-01-10T10:00:00Z", "updated_at": "2024-01-10T10:00:00Z"}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubCodespacesSecret().Schema, map[string]interface{}{
+		"repository":      "api",
+		"secret_name":     "API_TOKEN",
+		"encrypted_value": "c2VhbGVk",
+	})
+	if err := resourceGithubCodespacesSecretCreateOrUpdate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "api:API_TOKEN" {
+		t.Fatalf("Expected ID api:API_TOKEN, got %q", d.Id())
+	}
+	if keyID := d.Get("key_id").(string); keyID != "568250167242549743" {
+		t.Fatalf("Expected the current public key to be used, got %q", keyID)
+	}
+	if updatedAt := d.Get("updated_at").(string); updatedAt != "2024-01-10T10:00:00Z" {
+		t.Fatalf("Expected updated_at to be read, got %q", updatedAt)
+	}
+}
+
+func TestResourceGithubCodespacesSecretRead_updatedOutsideTerraform(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/repos/example/api/codespaces/secrets/API_TOKEN",
+			ResponseBody: `{"name": "API_TOKEN", "created_at": "2024-01-10T10:00:00Z", "updated_at": "2024-02-01T08:30:00Z"}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubCodespacesSecret().Schema, map[string]interface{}{
+		"repository":      "api",
+		"secret_name":     "API_TOKEN",
+		"encrypted_value": "c2VhbGVk",
+	})
+	d.SetId("api:API_TOKEN")
+	d.Set("updated_at", "2024-01-10T10:00:00Z")
+	if err := resourceGithubCodespacesSecretRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	// The value is set again on the next apply
+	if v := d.Get("encrypted_value").(string); v != "" {
+		t.Fatalf("Expected the value of the updated secret to be unknown, got %q", v)
+	}
+}
+
+func TestResourceGithubCodespacesSecretUpdate_plaintextValue(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/repos/example/api/codespaces/secrets/public-key",
+			ResponseBody: `{"key_id": "568250167242549743", "key": "hBT5WZEj8ZoOv6TYJsfWq7MxTEQopZO5/IT3ZCVQPzs="}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:    "/repos/example/api/codespaces/secrets/API_TOKEN",
+			ExpectedMethod: "PUT",
+			StatusCode:     204,
+		},
+		{
+			ExpectedUri:  "/repos/example/api/codespaces/secrets/API_TOKEN",
+			ResponseBody: `{"name": "API_TOKEN", "created_at": "2024-01-10T10:00:00Z", "updated_at": "2024-02-01T08:30:00Z"}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubCodespacesSecret().Schema, map[string]interface{}{
+		"repository":      "api",
+		"secret_name":     "API_TOKEN",
+		"plaintext_value": "s3cr3t",
+	})
+	d.SetId("api:API_TOKEN")
+	d.Set("updated_at", "2024-01-10T10:00:00Z")
+	if err := resourceGithubCodespacesSecretCreateOrUpdate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	// The update of the apply itself is not taken for one outside of Terraform
+	if v := d.Get("plaintext_value").(string); v != "s3cr3t" {
+		t.Fatalf("Expected the value to be kept after updating it, got %q", v)
+	}
+	if updatedAt := d.Get("updated_at").(string); updatedAt != "2024-02-01T08:30:00Z" {
+		t.Fatalf("Expected updated_at of the update to be read, got %q", updatedAt)
+	}
+}
@@ -0,0 +1,84 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The Codespaces secrets of the authenticated user are available to the
+// codespaces the user creates for the selected repositories.
+const codespacesUserSecretsURL = "user/codespaces/secrets"
+
+func resourceGithubCodespacesUserSecret() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubCodespacesUserSecretCreateOrUpdate,
+		Read:   resourceGithubCodespacesUserSecretRead,
+		Update: resourceGithubCodespacesUserSecretCreateOrUpdate,
+		Delete: resourceGithubCodespacesUserSecretDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: codespacesSecretSchema(map[string]*schema.Schema{
+			"selected_repository_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Set:      schema.HashInt,
+			},
+		}),
+	}
+}
+
+func resourceGithubCodespacesUserSecretCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	ctx := stopContext(meta)
+
+	err := setCodespacesSecret(ctx, d, client, codespacesUserSecretsURL, &codespacesSecret{
+		SelectedRepositoryIDs: expandSelectedRepositoryIDs(d.Get("selected_repository_ids").(*schema.Set)),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("secret_name").(string))
+
+	return untilFound(d, meta, resourceGithubCodespacesUserSecretRead)
+}
+
+func resourceGithubCodespacesUserSecretRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	secret, err := readCodespacesSecret(ctx, d, client, codespacesUserSecretsURL, d.Id())
+	if err != nil {
+		return err
+	}
+	if secret == nil {
+		log.Printf("[WARN] Removing Codespaces user secret %s from state because it no longer exists in GitHub",
+			d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	ids, err := listCodespacesSecretRepositories(ctx, meta, codespacesUserSecretsURL, d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("selected_repository_ids", schema.NewSet(schema.HashInt, ids))
+
+	return nil
+}
+
+func resourceGithubCodespacesUserSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting Codespaces user secret: %s", d.Id())
+	_, err := apiRequest(ctx, client, "DELETE", fmt.Sprintf("%s/%s", codespacesUserSecretsURL, d.Id()), nil, nil)
+
+	return err
+}
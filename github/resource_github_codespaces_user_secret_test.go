@@ -0,0 +1,45 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestResourceGithubCodespacesUserSecretRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/user/codespaces/secrets/DOTFILES_TOKEN",
+			ResponseBody: `{"name": "DOTFILES_TOKEN", "visibility": "selected", "created_at": "2024-01-10T10:00:00Z", "updated_at": "2024-01-10T10:00:00Z"}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/user/codespaces/secrets/DOTFILES_TOKEN/repositories?page=1&per_page=100",
+			ResponseBody: `{"total_count": 2, "repositories": [{"id": 1296269}, {"id": 1296270}]}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "octocat", client: client, organizationOptions: organizationOptions{individual: true}}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubCodespacesUserSecret().Schema, map[string]interface{}{
+		"secret_name":     "DOTFILES_TOKEN",
+		"encrypted_value": "c2VhbGVk",
+	})
+	d.SetId("DOTFILES_TOKEN")
+	if err := resourceGithubCodespacesUserSecretRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := d.Get("selected_repository_ids").(*schema.Set); ids.Len() != 2 {
+		t.Fatalf("Expected two selected repositories, got %v", ids.List())
+	}
+	if v := d.Get("encrypted_value").(string); v != "c2VhbGVk" {
+		t.Fatalf("Expected the value to be kept, got %q", v)
+	}
+}
@@ -0,0 +1,242 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Deployments cannot be changed once created, so every argument forces a new
+// deployment.
+func resourceGithubDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubDeploymentCreate,
+		Read:   resourceGithubDeploymentRead,
+		Delete: resourceGithubDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ref": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"task": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "deploy",
+			},
+			"environment": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "production",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"payload": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateJsonString,
+			},
+			"auto_merge": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"required_contexts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"transient_environment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"production_environment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"sha": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	req := &github.DeploymentRequest{
+		Ref:                  github.String(d.Get("ref").(string)),
+		Task:                 github.String(d.Get("task").(string)),
+		Environment:          github.String(d.Get("environment").(string)),
+		AutoMerge:            github.Bool(d.Get("auto_merge").(bool)),
+		TransientEnvironment: github.Bool(d.Get("transient_environment").(bool)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req.Description = github.String(v.(string))
+	}
+	if v, ok := d.GetOk("payload"); ok {
+		req.Payload = github.String(v.(string))
+	}
+	// Without required contexts every commit status of the ref must
+	// succeed, so an empty set is only sent when it is configured
+	if v, ok := d.GetOk("required_contexts"); ok {
+		contexts := expandStringList(v.(*schema.Set).List())
+		req.RequiredContexts = &contexts
+	}
+	// GitHub decides whether the environment is a production one unless told
+	if v, ok := d.GetOkExists("production_environment"); ok {
+		req.ProductionEnvironment = github.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Creating deployment of %s to %s: %s/%s", *req.Ref, *req.Environment, owner, repoName)
+	deployment, _, err := client.Repositories.CreateDeployment(ctx, owner, repoName, req)
+	if err != nil {
+		return err
+	}
+
+	id := strconv.FormatInt(deployment.GetID(), 10)
+	d.SetId(buildTwoPartID(&repoName, &id))
+
+	return untilFound(d, meta, resourceGithubDeploymentRead)
+}
+
+func parseDeploymentID(id string) (string, int64, error) {
+	repoName, deploymentID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+	n, err := strconv.ParseInt(deploymentID, 10, 64)
+	if err != nil {
+		return "", 0, unconvertibleIdErr(deploymentID, err)
+	}
+
+	return repoName, n, nil
+}
+
+func resourceGithubDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, deploymentID, err := parseDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading deployment: %s", d.Id())
+	deployment, _, err := client.Repositories.GetDeployment(ctx, owner, repoName, deploymentID)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing deployment %s from state because it no longer exists in GitHub",
+				d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("repository", repoName)
+	d.Set("deployment_id", deployment.GetID())
+	d.Set("node_id", deployment.GetNodeID())
+	d.Set("ref", deployment.GetRef())
+	d.Set("sha", deployment.GetSHA())
+	d.Set("task", deployment.GetTask())
+	d.Set("environment", deployment.GetEnvironment())
+	d.Set("description", deployment.GetDescription())
+	d.Set("creator", deployment.GetCreator().GetLogin())
+
+	// A payload sent as a string is returned as one; anything else is the
+	// empty object GitHub stores when no payload was given
+	var payload string
+	if err := json.Unmarshal(deployment.Payload, &payload); err == nil {
+		d.Set("payload", payload)
+	}
+
+	return nil
+}
+
+func resourceGithubDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, deploymentID, err := parseDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// Only inactive deployments can be deleted, unless another deployment
+	// to the environment remains
+	log.Printf("[DEBUG] Marking deployment inactive: %s", d.Id())
+	_, _, err = client.Repositories.CreateDeploymentStatus(ctx, owner, repoName, deploymentID,
+		&github.DeploymentStatusRequest{State: github.String("inactive")})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting deployment: %s", d.Id())
+	_, err = apiRequest(ctx, client, "DELETE",
+		fmt.Sprintf("repos/%s/%s/deployments/%d", owner, repoName, deploymentID), nil, nil)
+
+	return err
+}
@@ -0,0 +1,191 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Deployment statuses can neither be changed nor deleted, so every argument
+// forces a new status and destroying one only removes it from state.
+func resourceGithubDeploymentStatus() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubDeploymentStatusCreate,
+		Read:   resourceGithubDeploymentStatusRead,
+		Delete: resourceGithubDeploymentStatusDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"deployment_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validateValueFunc([]string{
+					"error",
+					"failure",
+					"inactive",
+					"in_progress",
+					"queued",
+					"pending",
+					"success",
+				}),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"log_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"environment": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"environment_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"auto_inactive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// parseDeploymentStatusID returns the pieces of id
+// `repository:deployment_id:status_id`.
+func parseDeploymentStatusID(id string) (string, int64, int64, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 {
+		return "", 0, 0, fmt.Errorf("Unexpected ID format (%q). Expected repository:deployment_id:status_id", id)
+	}
+	deploymentID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, 0, unconvertibleIdErr(parts[1], err)
+	}
+	statusID, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return "", 0, 0, unconvertibleIdErr(parts[2], err)
+	}
+
+	return parts[0], deploymentID, statusID, nil
+}
+
+func resourceGithubDeploymentStatusCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	deploymentID := int64(d.Get("deployment_id").(int))
+	ctx := stopContext(meta)
+
+	req := &github.DeploymentStatusRequest{
+		State:        github.String(d.Get("state").(string)),
+		AutoInactive: github.Bool(d.Get("auto_inactive").(bool)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req.Description = github.String(v.(string))
+	}
+	if v, ok := d.GetOk("log_url"); ok {
+		req.LogURL = github.String(v.(string))
+	}
+	if v, ok := d.GetOk("environment"); ok {
+		req.Environment = github.String(v.(string))
+	}
+	if v, ok := d.GetOk("environment_url"); ok {
+		req.EnvironmentURL = github.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating %s status of deployment %d: %s/%s", *req.State, deploymentID, owner, repoName)
+	status, _, err := client.Repositories.CreateDeploymentStatus(ctx, owner, repoName, deploymentID, req)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d:%d", repoName, deploymentID, status.GetID()))
+
+	return untilFound(d, meta, resourceGithubDeploymentStatusRead)
+}
+
+func resourceGithubDeploymentStatusRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, deploymentID, statusID, err := parseDeploymentStatusID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading deployment status: %s", d.Id())
+	status, _, err := client.Repositories.GetDeploymentStatus(ctx, owner, repoName, deploymentID, statusID)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing deployment status %s from state because it no longer exists in GitHub",
+				d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("repository", repoName)
+	d.Set("deployment_id", deploymentID)
+	d.Set("node_id", status.GetNodeID())
+	d.Set("state", status.GetState())
+	d.Set("description", status.GetDescription())
+	d.Set("creator", status.GetCreator().GetLogin())
+
+	return nil
+}
+
+func resourceGithubDeploymentStatusDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing deployment status %s from state; GitHub keeps the history of every deployment",
+		d.Id())
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestParseDeploymentStatusID(t *testing.T) {
+	repoName, deploymentID, statusID, err := parseDeploymentStatusID("example:42:7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repoName != "example" || deploymentID != 42 || statusID != 7 {
+		t.Fatalf("Unexpected pieces: %q, %d, %d", repoName, deploymentID, statusID)
+	}
+
+	for _, id := range []string{"example:42", "example:42:latest", "example:42:7:1"} {
+		if _, _, _, err := parseDeploymentStatusID(id); err == nil {
+			t.Fatalf("Expected an error parsing %q", id)
+		}
+	}
+}
+
+func TestAccGithubDeploymentStatus_basic(t *testing.T) {
+	rn := "github_deployment_status.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-deploy-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubDeploymentStatusConfig(repoName, "in_progress"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "state", "in_progress"),
+					resource.TestCheckResourceAttrSet(rn, "creator"),
+				),
+			},
+			{
+				Config: testAccGithubDeploymentStatusConfig(repoName, "success"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "state", "success"),
+				),
+			},
+			{
+				ResourceName:            rn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_inactive", "log_url", "environment_url"},
+			},
+		},
+	})
+}
+
+func testAccGithubDeploymentStatusConfig(repoName, state string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+resource "github_deployment" "test" {
+  repository        = "${github_repository.test.name}"
+  ref               = "${github_repository.test.default_branch}"
+  required_contexts = []
+}
+
+resource "github_deployment_status" "test" {
+  repository    = "${github_repository.test.name}"
+  deployment_id = "${github_deployment.test.deployment_id}"
+  state         = "%s"
+  log_url       = "https://example.com/logs"
+}
+`, repoName, state)
+}
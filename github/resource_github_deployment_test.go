@@ -0,0 +1,120 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceGithubDeploymentDelete(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/example/app/deployments/42/statuses",
+			ExpectedMethod: "POST",
+			ExpectedBody:   []byte(`{"state":"inactive"}` + "\n"),
+			StatusCode:     201,
+			ResponseBody:   `{"id":1,"state":"inactive"}`,
+		},
+		{
+			ExpectedUri:    "/repos/example/app/deployments/42",
+			ExpectedMethod: "DELETE",
+			StatusCode:     204,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubDeployment().Schema, map[string]interface{}{})
+	d.SetId("app:42")
+
+	if err := resourceGithubDeploymentDelete(d, meta); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAccGithubDeployment_basic(t *testing.T) {
+	rn := "github_deployment.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-deploy-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubDeploymentConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "environment", "staging"),
+					resource.TestCheckResourceAttr(rn, "task", "deploy"),
+					resource.TestCheckResourceAttr(rn, "payload", `{"version":"1.0.0"}`),
+					resource.TestCheckResourceAttrSet(rn, "sha"),
+					resource.TestCheckResourceAttrSet(rn, "deployment_id"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// These are only used while creating the deployment
+				ImportStateVerifyIgnore: []string{
+					"auto_merge", "required_contexts", "transient_environment", "production_environment",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckGithubDeploymentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_deployment" {
+			continue
+		}
+
+		orgName := testAccProvider.Meta().(*Organization).name
+		repoName, deploymentID, err := parseDeploymentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, resp, err := conn.Repositories.GetDeployment(context.TODO(), orgName, repoName, deploymentID)
+		if err == nil {
+			return fmt.Errorf("Deployment %s still exists", rs.Primary.ID)
+		}
+		if resp != nil && resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+
+	return nil
+}
+
+func testAccGithubDeploymentConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+resource "github_deployment" "test" {
+  repository        = "${github_repository.test.name}"
+  ref               = "${github_repository.test.default_branch}"
+  environment       = "staging"
+  payload           = "{\"version\":\"1.0.0\"}"
+  required_contexts = []
+}
+`, repoName)
+}
@@ -0,0 +1,154 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type externalGroupTeam struct {
+	TeamID   *int64  `json:"team_id,omitempty"`
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+type externalGroup struct {
+	GroupID   *int64               `json:"group_id,omitempty"`
+	GroupName *string              `json:"group_name,omitempty"`
+	UpdatedAt *string              `json:"updated_at,omitempty"`
+	Teams     []*externalGroupTeam `json:"teams,omitempty"`
+}
+
+type externalGroups struct {
+	Groups []*externalGroup `json:"groups"`
+}
+
+func resourceGithubEmuGroupMapping() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubEmuGroupMappingCreateOrUpdate,
+		Read:   resourceGithubEmuGroupMappingRead,
+		Update: resourceGithubEmuGroupMappingCreateOrUpdate,
+		Delete: resourceGithubEmuGroupMappingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func emuGroupMappingURL(orgName, teamSlug string) string {
+	return fmt.Sprintf("orgs/%s/teams/%s/external-groups", orgName, teamSlug)
+}
+
+func resourceGithubEmuGroupMappingCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	teamSlug := d.Get("team_slug").(string)
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	// A team is mapped to at most one group, so this replaces any mapping
+	body := &externalGroup{GroupID: github.Int64(int64(d.Get("group_id").(int)))}
+
+	log.Printf("[DEBUG] Mapping team %s to external group %d", teamSlug, *body.GroupID)
+	_, err = apiRequest(ctx, client, "PATCH", emuGroupMappingURL(orgName, teamSlug), body, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(teamSlug)
+
+	return untilFound(d, meta, resourceGithubEmuGroupMappingRead)
+}
+
+func resourceGithubEmuGroupMappingRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	teamSlug := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading external group mapping of team: %s", teamSlug)
+	groups := new(externalGroups)
+	resp, err := apiRequest(ctx, client, "GET", emuGroupMappingURL(orgName, teamSlug), nil, groups)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing external group mapping of team %s from state because the team no longer exists in GitHub",
+					teamSlug)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	if len(groups.Groups) == 0 {
+		log.Printf("[WARN] Removing external group mapping of team %s from state because it no longer exists in GitHub",
+			teamSlug)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("team_slug", teamSlug)
+	d.Set("group_id", groups.Groups[0].GroupID)
+	d.Set("group_name", groups.Groups[0].GroupName)
+
+	return nil
+}
+
+func resourceGithubEmuGroupMappingDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	teamSlug := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Removing external group mapping of team: %s", teamSlug)
+	_, err = apiRequest(ctx, client, "DELETE", emuGroupMappingURL(orgName, teamSlug), nil, nil)
+	return err
+}
@@ -0,0 +1,57 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubEmuGroupMapping_basic(t *testing.T) {
+	// Requires an organization of an enterprise with managed users
+	groupName := os.Getenv("GITHUB_TEST_EXTERNAL_GROUP_NAME")
+	if groupName == "" {
+		t.Skip("GITHUB_TEST_EXTERNAL_GROUP_NAME must be set for this acceptance test")
+	}
+	rn := "github_emu_group_mapping.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	teamName := fmt.Sprintf("tf-acc-test-emu-%s", rs)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubEmuGroupMappingConfig(teamName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "group_name", groupName),
+					resource.TestCheckResourceAttrPair(rn, "group_id", "data.github_external_group.test", "group_id"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubEmuGroupMappingConfig(teamName, groupName string) string {
+	return fmt.Sprintf(`
+resource "github_team" "test" {
+  name = "%s"
+}
+
+data "github_external_group" "test" {
+  group_name = "%s"
+}
+
+resource "github_emu_group_mapping" "test" {
+  team_slug = "${github_team.test.slug}"
+  group_id  = "${data.github_external_group.test.group_id}"
+}
+`, teamName, groupName)
+}
@@ -0,0 +1,238 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type enterpriseActionsPermissions struct {
+	EnabledOrganizations *string `json:"enabled_organizations,omitempty"`
+	AllowedActions       *string `json:"allowed_actions,omitempty"`
+}
+
+type actionsEnabledOrganizations struct {
+	TotalCount    int                    `json:"total_count,omitempty"`
+	Organizations []*github.Organization `json:"organizations,omitempty"`
+}
+
+type actionsSelectedOrganizationIDs struct {
+	SelectedOrganizationIDs []int64 `json:"selected_organization_ids"`
+}
+
+// The Actions permissions of an enterprise have the ID of the enterprise,
+// and apply to its organizations, which can restrict them further.
+func resourceGithubEnterpriseActionsPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubEnterpriseActionsPermissionsCreateOrUpdate,
+		Read:   resourceGithubEnterpriseActionsPermissionsRead,
+		Update: resourceGithubEnterpriseActionsPermissionsCreateOrUpdate,
+		Delete: resourceGithubEnterpriseActionsPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enterprise": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled_organizations": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateValueFunc([]string{"all", "none", "selected"}),
+			},
+			"enabled_organizations_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"organization_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"allowed_actions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validateValueFunc([]string{"all", "local_only", "selected"}),
+			},
+			"allowed_actions_config": actionsAllowedConfigSchema(),
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubEnterpriseActionsPermissionsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	enterprise := d.Get("enterprise").(string)
+	enabledOrganizations := d.Get("enabled_organizations").(string)
+	allowedActions := d.Get("allowed_actions").(string)
+	allowed := expandActionsAllowed(d)
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	var orgIDs []int64
+	vL := d.Get("enabled_organizations_config").([]interface{})
+	if len(vL) > 0 && vL[0] != nil {
+		if enabledOrganizations != "selected" {
+			return fmt.Errorf("`enabled_organizations_config` can only be set when `enabled_organizations` is %q.", "selected")
+		}
+		for _, id := range vL[0].(map[string]interface{})["organization_ids"].(*schema.Set).List() {
+			orgIDs = append(orgIDs, int64(id.(int)))
+		}
+	}
+	if allowed != nil && allowedActions != "selected" {
+		return fmt.Errorf("`allowed_actions_config` can only be set when `allowed_actions` is %q.", "selected")
+	}
+
+	permissions := &enterpriseActionsPermissions{EnabledOrganizations: github.String(enabledOrganizations)}
+	if enabledOrganizations != "none" {
+		permissions.AllowedActions = github.String(allowedActions)
+	}
+
+	log.Printf("[DEBUG] Updating Actions permissions of enterprise: %s", enterprise)
+	_, err := apiRequest(ctx, client, "PUT", fmt.Sprintf("enterprises/%s/actions/permissions", enterprise), permissions, nil)
+	if err != nil {
+		return err
+	}
+
+	if enabledOrganizations == "selected" {
+		log.Printf("[DEBUG] Updating Actions enabled organizations of enterprise: %s", enterprise)
+		_, err = apiRequest(ctx, client, "PUT", fmt.Sprintf("enterprises/%s/actions/permissions/organizations", enterprise),
+			&actionsSelectedOrganizationIDs{SelectedOrganizationIDs: append([]int64{}, orgIDs...)}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if enabledOrganizations != "none" && allowed != nil {
+		log.Printf("[DEBUG] Updating Actions allowed actions of enterprise: %s", enterprise)
+		_, err = apiRequest(ctx, client, "PUT", fmt.Sprintf("enterprises/%s/actions/permissions/selected-actions", enterprise), allowed, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(enterprise)
+
+	return untilFound(d, meta, resourceGithubEnterpriseActionsPermissionsRead)
+}
+
+func resourceGithubEnterpriseActionsPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	enterprise := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading Actions permissions of enterprise: %s", enterprise)
+	permissions := new(enterpriseActionsPermissions)
+	resp, err := apiRequest(ctx, client, "GET", fmt.Sprintf("enterprises/%s/actions/permissions", enterprise), nil, permissions)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return readGithubEnterpriseActionsPermissionsSelections(d, meta)
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing Actions permissions %s from state because the enterprise no longer exists in GitHub",
+					enterprise)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("enterprise", enterprise)
+	d.Set("enabled_organizations", permissions.EnabledOrganizations)
+	if permissions.AllowedActions != nil {
+		d.Set("allowed_actions", permissions.AllowedActions)
+	}
+
+	return readGithubEnterpriseActionsPermissionsSelections(d, meta)
+}
+
+// readGithubEnterpriseActionsPermissionsSelections records the organizations
+// and actions selected by the policy in state, which the etag of the policy
+// does not cover.
+func readGithubEnterpriseActionsPermissionsSelections(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	enterprise := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	enabledOrganizations := d.Get("enabled_organizations").(string)
+
+	if enabledOrganizations == "selected" {
+		orgIDs := []interface{}{}
+		organizations, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+			orgs := new(actionsEnabledOrganizations)
+			resp, err := apiRequest(ctx, client, "GET",
+				fmt.Sprintf("enterprises/%s/actions/permissions/organizations?%s", enterprise, pageQuery(url.Values{}, opt)), nil, orgs)
+			return orgs.Organizations, resp, err
+		})
+		if err != nil {
+			return err
+		}
+		for _, v := range organizations {
+			orgIDs = append(orgIDs, int(v.(*github.Organization).GetID()))
+		}
+
+		d.Set("enabled_organizations_config", []interface{}{
+			map[string]interface{}{
+				"organization_ids": schema.NewSet(schema.HashInt, orgIDs),
+			},
+		})
+	} else {
+		d.Set("enabled_organizations_config", []interface{}{})
+	}
+
+	// No actions are allowed while Actions are disabled
+	if enabledOrganizations != "none" && d.Get("allowed_actions").(string) == "selected" {
+		allowed := new(actionsAllowed)
+		_, err := apiRequest(ctx, client, "GET", fmt.Sprintf("enterprises/%s/actions/permissions/selected-actions", enterprise), nil, allowed)
+		if err != nil {
+			return err
+		}
+		d.Set("allowed_actions_config", flattenActionsAllowed(allowed))
+	} else {
+		d.Set("allowed_actions_config", []interface{}{})
+	}
+
+	return nil
+}
+
+func resourceGithubEnterpriseActionsPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	enterprise := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// Restore the permissive defaults of a new enterprise
+	permissions := &enterpriseActionsPermissions{
+		EnabledOrganizations: github.String("all"),
+		AllowedActions:       github.String("all"),
+	}
+
+	log.Printf("[DEBUG] Resetting Actions permissions of enterprise: %s", enterprise)
+	_, err := apiRequest(ctx, client, "PUT", fmt.Sprintf("enterprises/%s/actions/permissions", enterprise), permissions, nil)
+	return err
+}
@@ -0,0 +1,96 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccGithubEnterpriseActionsPermissions_basic(t *testing.T) {
+	// Requires an enterprise the test user is an owner of
+	enterprise := os.Getenv("GITHUB_TEST_ENTERPRISE")
+	if enterprise == "" {
+		t.Skip("GITHUB_TEST_ENTERPRISE must be set for this acceptance test")
+	}
+	rn := "github_enterprise_actions_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubEnterpriseActionsPermissionsConfig(enterprise),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "id", enterprise),
+					resource.TestCheckResourceAttr(rn, "enabled_organizations", "all"),
+					resource.TestCheckResourceAttr(rn, "allowed_actions", "selected"),
+					resource.TestCheckResourceAttr(rn, "allowed_actions_config.0.github_owned_allowed", "true"),
+					resource.TestCheckResourceAttr(rn, "allowed_actions_config.0.patterns_allowed.#", "1"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubEnterpriseActionsPermissionsConfig(enterprise string) string {
+	return fmt.Sprintf(`
+resource "github_enterprise_actions_permissions" "test" {
+  enterprise            = "%s"
+  enabled_organizations = "all"
+  allowed_actions       = "selected"
+
+  allowed_actions_config {
+    github_owned_allowed = true
+    patterns_allowed     = ["hashicorp/*"]
+  }
+}
+`, enterprise)
+}
+
+func TestGithubEnterpriseActionsPermissionsRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/enterprises/example/actions/permissions",
+			StatusCode:   200,
+			ResponseBody: `{"enabled_organizations": "selected", "allowed_actions": "local_only"}`,
+		},
+		{
+			ExpectedUri:  "/enterprises/example/actions/permissions/organizations?page=1&per_page=100",
+			StatusCode:   200,
+			ResponseBody: `{"total_count": 2, "organizations": [{"id": 1}, {"id": 2}]}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example-org", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubEnterpriseActionsPermissions().Schema, map[string]interface{}{})
+	d.SetId("example")
+
+	if err := resourceGithubEnterpriseActionsPermissionsRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := d.Get("enterprise"); v != "example" {
+		t.Fatalf("Expected the enterprise to be read from the ID, got %q", v)
+	}
+	ids := d.Get("enabled_organizations_config.0.organization_ids").(*schema.Set)
+	if ids.Len() != 2 || !ids.Contains(1) || !ids.Contains(2) {
+		t.Fatalf("Expected the selected organizations, got %v", ids.List())
+	}
+	if n := len(d.Get("allowed_actions_config").([]interface{})); n != 0 {
+		t.Fatalf("Expected no allowed actions config unless actions are selected, got %d", n)
+	}
+}
@@ -0,0 +1,278 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type enterpriseActionsRunnerGroup struct {
+	ID                       *int64   `json:"id,omitempty"`
+	Name                     *string  `json:"name,omitempty"`
+	Visibility               *string  `json:"visibility,omitempty"`
+	Default                  *bool    `json:"default,omitempty"`
+	RunnersURL               *string  `json:"runners_url,omitempty"`
+	SelectedOrganizationsURL *string  `json:"selected_organizations_url,omitempty"`
+	AllowsPublicRepositories *bool    `json:"allows_public_repositories,omitempty"`
+	RestrictedToWorkflows    *bool    `json:"restricted_to_workflows,omitempty"`
+	SelectedWorkflows        []string `json:"selected_workflows"`
+	SelectedOrganizationIDs  []int64  `json:"selected_organization_ids,omitempty"`
+}
+
+// The runner groups of an enterprise have the ID `<enterprise>:<id>`. Their
+// runners are shared with all or the selected organizations of the
+// enterprise, which receive them as inherited runner groups.
+func resourceGithubEnterpriseActionsRunnerGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubEnterpriseActionsRunnerGroupCreate,
+		Read:   resourceGithubEnterpriseActionsRunnerGroupRead,
+		Update: resourceGithubEnterpriseActionsRunnerGroupUpdate,
+		Delete: resourceGithubEnterpriseActionsRunnerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enterprise": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateValueFunc([]string{"all", "selected"}),
+			},
+			"selected_organization_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Set:      schema.HashInt,
+			},
+			"allows_public_repositories": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"restricted_to_workflows": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"selected_workflows": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"runners_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"selected_organizations_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubEnterpriseActionsRunnerGroupObject(d *schema.ResourceData) (*enterpriseActionsRunnerGroup, error) {
+	visibility := d.Get("visibility").(string)
+	group := &enterpriseActionsRunnerGroup{
+		Name:                     github.String(d.Get("name").(string)),
+		Visibility:               github.String(visibility),
+		AllowsPublicRepositories: github.Bool(d.Get("allows_public_repositories").(bool)),
+		RestrictedToWorkflows:    github.Bool(d.Get("restricted_to_workflows").(bool)),
+		SelectedWorkflows:        expandStringList(d.Get("selected_workflows").([]interface{})),
+	}
+
+	ids := d.Get("selected_organization_ids").(*schema.Set).List()
+	if len(ids) > 0 && visibility != "selected" {
+		return nil, fmt.Errorf("`selected_organization_ids` can only be set when `visibility` is %q.", "selected")
+	}
+	if len(group.SelectedWorkflows) > 0 && !*group.RestrictedToWorkflows {
+		return nil, fmt.Errorf("`selected_workflows` can only be set when `restricted_to_workflows` is true.")
+	}
+
+	return group, nil
+}
+
+func expandRunnerGroupOrganizationIDs(d *schema.ResourceData) []int64 {
+	ids := []int64{}
+	for _, id := range d.Get("selected_organization_ids").(*schema.Set).List() {
+		ids = append(ids, int64(id.(int)))
+	}
+	return ids
+}
+
+func parseEnterpriseRunnerGroupID(id string) (string, int64, error) {
+	enterprise, groupID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+	n, err := strconv.ParseInt(groupID, 10, 64)
+	if err != nil {
+		return "", 0, unconvertibleIdErr(groupID, err)
+	}
+
+	return enterprise, n, nil
+}
+
+func resourceGithubEnterpriseActionsRunnerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	enterprise := d.Get("enterprise").(string)
+	ctx := stopContext(meta)
+
+	group, err := resourceGithubEnterpriseActionsRunnerGroupObject(d)
+	if err != nil {
+		return err
+	}
+	if *group.Visibility == "selected" {
+		group.SelectedOrganizationIDs = expandRunnerGroupOrganizationIDs(d)
+	}
+
+	log.Printf("[DEBUG] Creating Actions runner group of enterprise: %s (%s)", *group.Name, enterprise)
+	created := new(enterpriseActionsRunnerGroup)
+	_, err = apiRequest(ctx, client, "POST", fmt.Sprintf("enterprises/%s/actions/runner-groups", enterprise), group, created)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&enterprise, github.String(strconv.FormatInt(*created.ID, 10))))
+
+	return untilFound(d, meta, resourceGithubEnterpriseActionsRunnerGroupRead)
+}
+
+func resourceGithubEnterpriseActionsRunnerGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	enterprise, id, err := parseEnterpriseRunnerGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading Actions runner group of enterprise: %s", d.Id())
+	group := new(enterpriseActionsRunnerGroup)
+	resp, err := apiRequest(ctx, client, "GET", fmt.Sprintf("enterprises/%s/actions/runner-groups/%d", enterprise, id), nil, group)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return readGithubEnterpriseActionsRunnerGroupOrganizations(d, meta, enterprise, id)
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing Actions runner group %s from state because it no longer exists in GitHub",
+					d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("enterprise", enterprise)
+	d.Set("name", group.Name)
+	d.Set("visibility", group.Visibility)
+	d.Set("allows_public_repositories", group.AllowsPublicRepositories)
+	d.Set("restricted_to_workflows", group.RestrictedToWorkflows)
+	d.Set("selected_workflows", group.SelectedWorkflows)
+	d.Set("default", group.Default)
+	d.Set("runners_url", group.RunnersURL)
+	d.Set("selected_organizations_url", group.SelectedOrganizationsURL)
+
+	return readGithubEnterpriseActionsRunnerGroupOrganizations(d, meta, enterprise, id)
+}
+
+// readGithubEnterpriseActionsRunnerGroupOrganizations records the
+// organizations selected by the runner group in state, which the etag of the
+// group does not cover.
+func readGithubEnterpriseActionsRunnerGroupOrganizations(d *schema.ResourceData, meta interface{}, enterprise string, id int64) error {
+	client := meta.(*Organization).client
+
+	orgIDs := []interface{}{}
+	if d.Get("visibility").(string) == "selected" {
+		ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+		organizations, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+			orgs := new(actionsEnabledOrganizations)
+			resp, err := apiRequest(ctx, client, "GET",
+				fmt.Sprintf("enterprises/%s/actions/runner-groups/%d/organizations?%s", enterprise, id, pageQuery(url.Values{}, opt)), nil, orgs)
+			return orgs.Organizations, resp, err
+		})
+		if err != nil {
+			return err
+		}
+		for _, v := range organizations {
+			orgIDs = append(orgIDs, int(v.(*github.Organization).GetID()))
+		}
+	}
+	d.Set("selected_organization_ids", schema.NewSet(schema.HashInt, orgIDs))
+
+	return nil
+}
+
+func resourceGithubEnterpriseActionsRunnerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	enterprise, id, err := parseEnterpriseRunnerGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	group, err := resourceGithubEnterpriseActionsRunnerGroupObject(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Actions runner group of enterprise: %s", d.Id())
+	_, err = apiRequest(ctx, client, "PATCH", fmt.Sprintf("enterprises/%s/actions/runner-groups/%d", enterprise, id), group, nil)
+	if err != nil {
+		return err
+	}
+
+	if *group.Visibility == "selected" && d.HasChange("selected_organization_ids") {
+		log.Printf("[DEBUG] Updating Actions runner group organizations of enterprise: %s", d.Id())
+		_, err = apiRequest(ctx, client, "PUT", fmt.Sprintf("enterprises/%s/actions/runner-groups/%d/organizations", enterprise, id),
+			&actionsSelectedOrganizationIDs{SelectedOrganizationIDs: expandRunnerGroupOrganizationIDs(d)}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubEnterpriseActionsRunnerGroupRead(d, meta)
+}
+
+func resourceGithubEnterpriseActionsRunnerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	enterprise, id, err := parseEnterpriseRunnerGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting Actions runner group of enterprise: %s", d.Id())
+	_, err = apiRequest(ctx, client, "DELETE", fmt.Sprintf("enterprises/%s/actions/runner-groups/%d", enterprise, id), nil, nil)
+	return err
+}
@@ -0,0 +1,148 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubEnterpriseActionsRunnerGroup_basic(t *testing.T) {
+	// Requires an enterprise the test user is an owner of
+	enterprise := os.Getenv("GITHUB_TEST_ENTERPRISE")
+	if enterprise == "" {
+		t.Skip("GITHUB_TEST_ENTERPRISE must be set for this acceptance test")
+	}
+	rn := "github_enterprise_actions_runner_group.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupName := fmt.Sprintf("tf-acc-test-runners-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubEnterpriseActionsRunnerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubEnterpriseActionsRunnerGroupConfig(enterprise, groupName, "all"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "enterprise", enterprise),
+					resource.TestCheckResourceAttr(rn, "name", groupName),
+					resource.TestCheckResourceAttr(rn, "visibility", "all"),
+					resource.TestCheckResourceAttr(rn, "default", "false"),
+				),
+			},
+			{
+				Config: testAccGithubEnterpriseActionsRunnerGroupConfig(enterprise, groupName, "selected"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "visibility", "selected"),
+					resource.TestCheckResourceAttr(rn, "selected_organization_ids.#", "0"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGithubEnterpriseActionsRunnerGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_enterprise_actions_runner_group" {
+			continue
+		}
+
+		enterprise, id, err := parseEnterpriseRunnerGroupID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := apiRequest(context.TODO(), conn, "GET",
+			fmt.Sprintf("enterprises/%s/actions/runner-groups/%d", enterprise, id), nil, nil)
+		if err == nil {
+			return fmt.Errorf("Actions runner group %s still exists", rs.Primary.ID)
+		}
+		if resp != nil && resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+
+	return nil
+}
+
+func testAccGithubEnterpriseActionsRunnerGroupConfig(enterprise, groupName, visibility string) string {
+	return fmt.Sprintf(`
+resource "github_enterprise_actions_runner_group" "test" {
+  enterprise = "%s"
+  name       = "%s"
+  visibility = "%s"
+}
+`, enterprise, groupName, visibility)
+}
+
+func TestGithubEnterpriseActionsRunnerGroupReadNotModified(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:     "/enterprises/example/actions/runner-groups/7",
+			ExpectedHeaders: map[string]string{"If-None-Match": `"abc"`},
+			StatusCode:      304,
+		},
+		{
+			ExpectedUri:  "/enterprises/example/actions/runner-groups/7/organizations?page=1&per_page=100",
+			StatusCode:   200,
+			ResponseBody: `{"total_count": 1, "organizations": [{"id": 42}]}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(&http.Client{Transport: NewEtagTransport(http.DefaultTransport)})
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example-org", client: client, organizationOptions: organizationOptions{conditionalRequests: true}}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubEnterpriseActionsRunnerGroup().Schema, map[string]interface{}{
+		"enterprise": "example",
+		"name":       "runners",
+		"visibility": "selected",
+	})
+	d.SetId("example:7")
+	d.Set("etag", `"abc"`)
+
+	if err := resourceGithubEnterpriseActionsRunnerGroupRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	// The organizations are not covered by the etag of the group
+	ids := d.Get("selected_organization_ids").(*schema.Set)
+	if ids.Len() != 1 || !ids.Contains(42) {
+		t.Fatalf("Expected the selected organizations to be read again, got %v", ids.List())
+	}
+}
+
+func TestParseEnterpriseRunnerGroupID(t *testing.T) {
+	enterprise, id, err := parseEnterpriseRunnerGroupID("example:7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enterprise != "example" || id != 7 {
+		t.Fatalf("Expected example and 7, got %q and %d", enterprise, id)
+	}
+
+	if _, _, err := parseEnterpriseRunnerGroupID("7"); err == nil {
+		t.Fatal("Expected an error for an ID without the enterprise")
+	}
+	if _, _, err := parseEnterpriseRunnerGroupID("example:runners"); err == nil {
+		t.Fatal("Expected an error for a non-numeric group ID")
+	}
+}
@@ -0,0 +1,197 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type preReceiveEnvironment struct {
+	ID                 *int64  `json:"id,omitempty"`
+	Name               *string `json:"name,omitempty"`
+	ImageURL           *string `json:"image_url,omitempty"`
+	DefaultEnvironment *bool   `json:"default_environment,omitempty"`
+	Download           *struct {
+		State   *string `json:"state,omitempty"`
+		Message *string `json:"message,omitempty"`
+	} `json:"download,omitempty"`
+}
+
+// A pre-receive environment is the image of a GitHub Enterprise Server which
+// pre-receive hooks run in. It is downloaded from its image URL when it is
+// created, and whenever the image URL changes.
+func resourceGithubEnterprisePreReceiveEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubEnterprisePreReceiveEnvironmentCreate,
+		Read:   resourceGithubEnterprisePreReceiveEnvironmentRead,
+		Update: resourceGithubEnterprisePreReceiveEnvironmentUpdate,
+		Delete: resourceGithubEnterprisePreReceiveEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"image_url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"default_environment": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"download_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// waitForPreReceiveEnvironmentDownload waits until GitHub downloaded the image
+// of the environment, since hooks cannot use it before.
+func waitForPreReceiveEnvironmentDownload(ctx context.Context, client *github.Client, id string) error {
+	log.Printf("[DEBUG] Waiting for pre-receive environment to be downloaded: %s", id)
+	return resource.Retry(timeLeft(ctx), func() *resource.RetryError {
+		env := new(preReceiveEnvironment)
+		_, err := apiRequest(ctx, client, "GET", "admin/pre-receive-environments/"+id, nil, env)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if env.Download == nil || env.Download.State == nil {
+			return nil
+		}
+
+		switch *env.Download.State {
+		case "success":
+			return nil
+		case "failed":
+			message := ""
+			if env.Download.Message != nil {
+				message = *env.Download.Message
+			}
+			return resource.NonRetryableError(fmt.Errorf("Downloading pre-receive environment %s failed: %s", id, message))
+		default:
+			return resource.RetryableError(fmt.Errorf("Pre-receive environment %s is not downloaded yet (%s)", id, *env.Download.State))
+		}
+	})
+}
+
+func resourceGithubEnterprisePreReceiveEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	ctx := stopContext(meta)
+
+	req := &preReceiveEnvironment{
+		Name:     github.String(d.Get("name").(string)),
+		ImageURL: github.String(d.Get("image_url").(string)),
+	}
+	log.Printf("[DEBUG] Creating pre-receive environment: %s", *req.Name)
+	env := new(preReceiveEnvironment)
+	_, err = apiRequest(ctx, client, "POST", "admin/pre-receive-environments", req, env)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(*env.ID, 10))
+
+	err = waitForPreReceiveEnvironmentDownload(ctx, client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	return untilFound(d, meta, resourceGithubEnterprisePreReceiveEnvironmentRead)
+}
+
+func resourceGithubEnterprisePreReceiveEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading pre-receive environment: %s", d.Id())
+	env := new(preReceiveEnvironment)
+	_, err = apiRequest(ctx, client, "GET", "admin/pre-receive-environments/"+d.Id(), nil, env)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing pre-receive environment %s from state because it no longer exists in GitHub",
+				d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", env.Name)
+	d.Set("image_url", env.ImageURL)
+	d.Set("default_environment", env.DefaultEnvironment)
+	if env.Download != nil {
+		d.Set("download_state", env.Download.State)
+	}
+
+	return nil
+}
+
+func resourceGithubEnterprisePreReceiveEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	req := &preReceiveEnvironment{
+		Name:     github.String(d.Get("name").(string)),
+		ImageURL: github.String(d.Get("image_url").(string)),
+	}
+	log.Printf("[DEBUG] Updating pre-receive environment: %s", d.Id())
+	_, err = apiRequest(ctx, client, "PATCH", "admin/pre-receive-environments/"+d.Id(), req, nil)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("image_url") {
+		log.Printf("[DEBUG] Downloading the new image of pre-receive environment: %s", d.Id())
+		_, err = apiRequest(ctx, client, "POST", fmt.Sprintf("admin/pre-receive-environments/%s/downloads", d.Id()), nil, nil)
+		if err != nil {
+			return err
+		}
+		err = waitForPreReceiveEnvironmentDownload(ctx, client, d.Id())
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubEnterprisePreReceiveEnvironmentRead(d, meta)
+}
+
+func resourceGithubEnterprisePreReceiveEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// An environment cannot be deleted while hooks use it
+	log.Printf("[DEBUG] Deleting pre-receive environment: %s", d.Id())
+	_, err = apiRequest(ctx, client, "DELETE", "admin/pre-receive-environments/"+d.Id(), nil, nil)
+	return err
+}
@@ -0,0 +1,64 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestGithubEnterprisePreReceiveEnvironmentCreate(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/admin/pre-receive-environments",
+			ExpectedMethod: "POST",
+			ExpectedBody:   []byte(`{"name":"DevTools","image_url":"https://example.com/devtools.tar.gz"}` + "\n"),
+			StatusCode:     201,
+			ResponseBody:   `{"id": 2, "name": "DevTools", "image_url": "https://example.com/devtools.tar.gz", "download": {"state": "not_started"}}`,
+		},
+		{
+			ExpectedUri:  "/admin/pre-receive-environments/2",
+			StatusCode:   200,
+			ResponseBody: `{"id": 2, "name": "DevTools", "download": {"state": "success"}}`,
+		},
+		{
+			ExpectedUri: "/admin/pre-receive-environments/2",
+			StatusCode:  200,
+			ResponseBody: `{"id": 2, "name": "DevTools", "image_url": "https://example.com/devtools.tar.gz",
+				"default_environment": false, "download": {"state": "success"}}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{enterpriseServer: true}}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubEnterprisePreReceiveEnvironment().Schema, map[string]interface{}{
+		"name":      "DevTools",
+		"image_url": "https://example.com/devtools.tar.gz",
+	})
+	if err := resourceGithubEnterprisePreReceiveEnvironmentCreate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "2" {
+		t.Fatalf("Unexpected ID %q", d.Id())
+	}
+	if v := d.Get("download_state"); v != "success" {
+		t.Fatalf("Expected the environment to be downloaded, got %v", v)
+	}
+}
+
+func TestGithubEnterprisePreReceiveEnvironmentCreate_notEnterpriseServer(t *testing.T) {
+	meta := &Organization{name: "example", client: github.NewClient(nil)}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubEnterprisePreReceiveEnvironment().Schema, map[string]interface{}{
+		"name":      "DevTools",
+		"image_url": "https://example.com/devtools.tar.gz",
+	})
+	if err := resourceGithubEnterprisePreReceiveEnvironmentCreate(d, meta); err == nil {
+		t.Fatal("Expected pre-receive environments to require an Enterprise Server")
+	}
+}
@@ -0,0 +1,174 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type preReceiveHookRepository struct {
+	FullName *string `json:"full_name,omitempty"`
+}
+
+type preReceiveHookEnvironment struct {
+	ID *int64 `json:"id,omitempty"`
+}
+
+type preReceiveHook struct {
+	ID                           *int64                     `json:"id,omitempty"`
+	Name                         *string                    `json:"name,omitempty"`
+	Script                       *string                    `json:"script,omitempty"`
+	ScriptRepository             *preReceiveHookRepository  `json:"script_repository,omitempty"`
+	Environment                  *preReceiveHookEnvironment `json:"environment,omitempty"`
+	Enforcement                  *string                    `json:"enforcement,omitempty"`
+	AllowDownstreamConfiguration *bool                      `json:"allow_downstream_configuration,omitempty"`
+}
+
+// A pre-receive hook of a GitHub Enterprise Server runs a script of a
+// repository on every push. Organizations and repositories may override its
+// enforcement if it allows downstream configuration.
+func resourceGithubEnterprisePreReceiveHook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubEnterprisePreReceiveHookCreate,
+		Read:   resourceGithubEnterprisePreReceiveHookRead,
+		Update: resourceGithubEnterprisePreReceiveHookUpdate,
+		Delete: resourceGithubEnterprisePreReceiveHookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"script": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"script_repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"enforcement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validateValueFunc([]string{"enabled", "disabled", "testing"}),
+			},
+			"allow_downstream_configuration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceGithubEnterprisePreReceiveHookObject(d *schema.ResourceData) *preReceiveHook {
+	return &preReceiveHook{
+		Name:                         github.String(d.Get("name").(string)),
+		Script:                       github.String(d.Get("script").(string)),
+		ScriptRepository:             &preReceiveHookRepository{FullName: github.String(d.Get("script_repository").(string))},
+		Environment:                  &preReceiveHookEnvironment{ID: github.Int64(int64(d.Get("environment_id").(int)))},
+		Enforcement:                  github.String(d.Get("enforcement").(string)),
+		AllowDownstreamConfiguration: github.Bool(d.Get("allow_downstream_configuration").(bool)),
+	}
+}
+
+func resourceGithubEnterprisePreReceiveHookCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	ctx := stopContext(meta)
+
+	req := resourceGithubEnterprisePreReceiveHookObject(d)
+	log.Printf("[DEBUG] Creating pre-receive hook: %s", *req.Name)
+	hook := new(preReceiveHook)
+	_, err = apiRequest(ctx, client, "POST", "admin/pre-receive-hooks", req, hook)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(*hook.ID, 10))
+
+	return untilFound(d, meta, resourceGithubEnterprisePreReceiveHookRead)
+}
+
+func resourceGithubEnterprisePreReceiveHookRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading pre-receive hook: %s", d.Id())
+	hook := new(preReceiveHook)
+	_, err = apiRequest(ctx, client, "GET", "admin/pre-receive-hooks/"+d.Id(), nil, hook)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing pre-receive hook %s from state because it no longer exists in GitHub",
+				d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", hook.Name)
+	d.Set("script", hook.Script)
+	if hook.ScriptRepository != nil {
+		d.Set("script_repository", hook.ScriptRepository.FullName)
+	}
+	if hook.Environment != nil && hook.Environment.ID != nil {
+		d.Set("environment_id", int(*hook.Environment.ID))
+	}
+	d.Set("enforcement", hook.Enforcement)
+	d.Set("allow_downstream_configuration", hook.AllowDownstreamConfiguration)
+
+	return nil
+}
+
+func resourceGithubEnterprisePreReceiveHookUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Updating pre-receive hook: %s", d.Id())
+	_, err = apiRequest(ctx, client, "PATCH", "admin/pre-receive-hooks/"+d.Id(), resourceGithubEnterprisePreReceiveHookObject(d), nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubEnterprisePreReceiveHookRead(d, meta)
+}
+
+func resourceGithubEnterprisePreReceiveHookDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting pre-receive hook: %s", d.Id())
+	_, err = apiRequest(ctx, client, "DELETE", "admin/pre-receive-hooks/"+d.Id(), nil, nil)
+	return err
+}
@@ -0,0 +1,55 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestGithubEnterprisePreReceiveHookCreate(t *testing.T) {
+	response := `{"id": 5, "name": "Check commit messages", "script": "scripts/check-messages.sh",
+		"script_repository": {"id": 595, "full_name": "example/hooks"}, "environment": {"id": 2},
+		"enforcement": "testing", "allow_downstream_configuration": true}`
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/admin/pre-receive-hooks",
+			ExpectedMethod: "POST",
+			ExpectedBody: []byte(`{"name":"Check commit messages","script":"scripts/check-messages.sh",` +
+				`"script_repository":{"full_name":"example/hooks"},"environment":{"id":2},` +
+				`"enforcement":"testing","allow_downstream_configuration":true}` + "\n"),
+			StatusCode:   201,
+			ResponseBody: response,
+		},
+		{
+			ExpectedUri:  "/admin/pre-receive-hooks/5",
+			StatusCode:   200,
+			ResponseBody: response,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{enterpriseServer: true}}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubEnterprisePreReceiveHook().Schema, map[string]interface{}{
+		"name":                           "Check commit messages",
+		"script":                         "scripts/check-messages.sh",
+		"script_repository":              "example/hooks",
+		"environment_id":                 2,
+		"enforcement":                    "testing",
+		"allow_downstream_configuration": true,
+	})
+	if err := resourceGithubEnterprisePreReceiveHookCreate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "5" {
+		t.Fatalf("Unexpected ID %q", d.Id())
+	}
+	if v := d.Get("environment_id"); v != 2 {
+		t.Fatalf("Unexpected environment %v", v)
+	}
+}
@@ -0,0 +1,152 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type interactionLimit struct {
+	Limit     string `json:"limit,omitempty"`
+	Expiry    string `json:"expiry,omitempty"`
+	Origin    string `json:"origin,omitempty"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+// An interaction limit restricts who may comment, open issues and create pull
+// requests in a repository, or in every public repository of the organization
+// without a repository, until it expires. It has the ID `<organization>` or
+// `<organization>/<repository>`.
+func resourceGithubInteractionLimit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubInteractionLimitCreateOrUpdate,
+		Read:   resourceGithubInteractionLimitRead,
+		Update: resourceGithubInteractionLimitCreateOrUpdate,
+		Delete: resourceGithubInteractionLimitDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if parts := strings.SplitN(d.Id(), "/", 2); len(parts) == 2 {
+					d.Set("repository", parts[1])
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"limit": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateValueFunc([]string{"existing_users", "contributors_only", "collaborators_only"}),
+			},
+			"expiry": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "one_day",
+				ValidateFunc: validateValueFunc([]string{"one_day", "three_days", "one_week", "one_month", "six_months"}),
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func interactionLimitURL(d *schema.ResourceData, meta interface{}) (string, string, error) {
+	orgName := meta.(*Organization).name
+	if repoName, ok := d.GetOk("repository"); ok {
+		return fmt.Sprintf("repos/%s/%s/interaction-limits", orgName, repoName.(string)),
+			fmt.Sprintf("%s/%s", orgName, repoName.(string)), nil
+	}
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return "", "", err
+	}
+	return fmt.Sprintf("orgs/%s/interaction-limits", orgName), orgName, nil
+}
+
+func resourceGithubInteractionLimitCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	limitURL, id, err := interactionLimitURL(d, meta)
+	if err != nil {
+		return err
+	}
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	// Setting the limit again restarts its expiry
+	limit := &interactionLimit{
+		Limit:  d.Get("limit").(string),
+		Expiry: d.Get("expiry").(string),
+	}
+	log.Printf("[DEBUG] Setting interaction limit: %s (%s)", id, limit.Limit)
+	_, err = apiRequest(ctx, client, "PUT", limitURL, limit, nil)
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	return untilFound(d, meta, resourceGithubInteractionLimitRead)
+}
+
+func resourceGithubInteractionLimitRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	limitURL, _, err := interactionLimitURL(d, meta)
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading interaction limit: %s", d.Id())
+	limit := new(interactionLimit)
+	_, err = apiRequest(ctx, client, "GET", limitURL, nil, limit)
+	if err != nil {
+		return err
+	}
+
+	// A repository also reports the limit of its organization
+	if limit.Limit == "" || (d.Get("repository").(string) != "" && limit.Origin == "organization") {
+		// A limit which expired as planned is kept, so it isn't set again
+		// until its configuration changes
+		if expiresAt, err := time.Parse(time.RFC3339, d.Get("expires_at").(string)); err == nil && time.Now().After(expiresAt) {
+			log.Printf("[DEBUG] Interaction limit %s expired at %s", d.Id(), expiresAt)
+			return nil
+		}
+		log.Printf("[WARN] Removing interaction limit %s from state because it no longer exists in GitHub", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("limit", limit.Limit)
+	d.Set("expires_at", limit.ExpiresAt)
+
+	return nil
+}
+
+func resourceGithubInteractionLimitDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	limitURL, _, err := interactionLimitURL(d, meta)
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Removing interaction limit: %s", d.Id())
+	_, err = apiRequest(ctx, client, "DELETE", limitURL, nil, nil)
+	return err
+}
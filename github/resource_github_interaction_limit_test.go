@@ -0,0 +1,90 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestGithubInteractionLimitCreate(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/example/web/interaction-limits",
+			ExpectedMethod: "PUT",
+			ExpectedBody:   []byte(`{"limit":"collaborators_only","expiry":"one_week"}` + "\n"),
+			StatusCode:     200,
+			ResponseBody:   `{"limit": "collaborators_only", "origin": "repository", "expires_at": "2020-01-08T00:00:00Z"}`,
+		},
+		{
+			ExpectedUri:  "/repos/example/web/interaction-limits",
+			StatusCode:   200,
+			ResponseBody: `{"limit": "collaborators_only", "origin": "repository", "expires_at": "2020-01-08T00:00:00Z"}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubInteractionLimit().Schema, map[string]interface{}{
+		"repository": "web",
+		"limit":      "collaborators_only",
+		"expiry":     "one_week",
+	})
+	if err := resourceGithubInteractionLimitCreateOrUpdate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "example/web" {
+		t.Fatalf("Expected the repository as ID, got %q", d.Id())
+	}
+	if v := d.Get("expires_at"); v != "2020-01-08T00:00:00Z" {
+		t.Fatalf("Unexpected expiry %v", v)
+	}
+}
+
+func TestGithubInteractionLimitRead_expired(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/orgs/example/interaction-limits",
+			StatusCode:   200,
+			ResponseBody: `{}`,
+		},
+		{
+			ExpectedUri:  "/orgs/example/interaction-limits",
+			StatusCode:   200,
+			ResponseBody: `{}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubInteractionLimit().Schema, map[string]interface{}{
+		"limit": "existing_users",
+	})
+	d.SetId("example")
+
+	// A limit which expired stays in state
+	d.Set("expires_at", "2020-01-02T00:00:00Z")
+	if err := resourceGithubInteractionLimitRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "example" {
+		t.Fatal("Expected the expired interaction limit to be kept in state")
+	}
+
+	// A limit which was removed before it expired doesn't
+	d.Set("expires_at", "2999-01-01T00:00:00Z")
+	if err := resourceGithubInteractionLimitRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "" {
+		t.Fatal("Expected the removed interaction limit to be removed from state")
+	}
+}
@@ -41,6 +41,10 @@ func resourceGithubIssueLabel() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -60,7 +64,7 @@ func resourceGithubIssueLabel() *schema.Resource {
 // same function for two schema funcs.
 
 func resourceGithubIssueLabelCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -75,7 +79,7 @@ func resourceGithubIssueLabelCreateOrUpdate(d *schema.ResourceData, meta interfa
 		Name:  github.String(name),
 		Color: github.String(color),
 	}
-	ctx := context.Background()
+	ctx := stopContext(meta)
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxId, d.Id())
 	}
@@ -131,11 +135,11 @@ func resourceGithubIssueLabelCreateOrUpdate(d *schema.ResourceData, meta interfa
 
 	d.SetId(buildTwoPartID(&repoName, &name))
 
-	return resourceGithubIssueLabelRead(d, meta)
+	return untilFound(d, meta, resourceGithubIssueLabelRead)
 }
 
 func resourceGithubIssueLabelRead(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -147,10 +151,8 @@ func resourceGithubIssueLabelRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	orgName := meta.(*Organization).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading label: %s (%s/%s)", name, orgName, repoName)
 	githubLabel, resp, err := client.Issues.GetLabel(ctx,
@@ -171,6 +173,7 @@ func resourceGithubIssueLabelRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("node_id", githubLabel.GetNodeID())
 	d.Set("repository", repoName)
 	d.Set("name", name)
 	d.Set("color", githubLabel.Color)
@@ -181,7 +184,7 @@ func resourceGithubIssueLabelRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceGithubIssueLabelDelete(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -191,7 +194,7 @@ func resourceGithubIssueLabelDelete(d *schema.ResourceData, meta interface{}) er
 	orgName := meta.(*Organization).name
 	repoName := d.Get("repository").(string)
 	name := d.Get("name").(string)
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting label: %s (%s/%s)", name, orgName, repoName)
 	_, err = client.Issues.DeleteLabel(ctx,
@@ -24,7 +24,7 @@ func resourceGithubMembership() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: caseInsensitive(),
+				DiffSuppressFunc: suppressRenamedUsername(),
 			},
 			"role": {
 				Type:         schema.TypeString,
@@ -32,6 +32,23 @@ func resourceGithubMembership() *schema.Resource {
 				ValidateFunc: validateValueFunc([]string{"member", "admin"}),
 				Default:      "member",
 			},
+			"downgrade_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -51,9 +68,12 @@ func resourceGithubMembershipCreateOrUpdate(d *schema.ResourceData, meta interfa
 	orgName := meta.(*Organization).name
 	username := d.Get("username").(string)
 	roleName := d.Get("role").(string)
-	ctx := context.Background()
+	ctx := stopContext(meta)
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxId, d.Id())
+		if username, err = userLoginFromID(d); err != nil {
+			return err
+		}
 	}
 
 	log.Printf("[DEBUG] Creating membership: %s/%s", orgName, username)
@@ -70,7 +90,7 @@ func resourceGithubMembershipCreateOrUpdate(d *schema.ResourceData, meta interfa
 
 	d.SetId(buildTwoPartID(membership.Organization.Login, membership.User.Login))
 
-	return resourceGithubMembershipRead(d, meta)
+	return untilFound(d, meta, resourceGithubMembershipRead)
 }
 
 func resourceGithubMembershipRead(d *schema.ResourceData, meta interface{}) error {
@@ -86,10 +106,8 @@ func resourceGithubMembershipRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return err
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading membership: %s", d.Id())
 	membership, resp, err := client.Organizations.GetOrgMembership(ctx,
@@ -100,6 +118,17 @@ func resourceGithubMembershipRead(d *schema.ResourceData, meta interface{}) erro
 				return nil
 			}
 			if ghErr.Response.StatusCode == http.StatusNotFound {
+				renamed, err := renamedUserLogin(stopContext(meta), d, meta, username)
+				if err != nil {
+					return err
+				}
+				if renamed != "" {
+					if err := renameUserID(d, renamed); err != nil {
+						return err
+					}
+					return resourceGithubMembershipRead(d, meta)
+				}
+
 				log.Printf("[WARN] Removing membership %s from state because it no longer exists in GitHub",
 					d.Id())
 				d.SetId("")
@@ -110,12 +139,22 @@ func resourceGithubMembershipRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	d.Set("etag", resp.Header.Get("ETag"))
-	d.Set("username", membership.User.Login)
-	d.Set("role", membership.Role)
+	d.Set("node_id", membership.GetUser().GetNodeID())
+	d.Set("user_id", membership.GetUser().GetID())
+	setUsername(d, membership.GetUser().GetLogin())
+	d.Set("role", membershipRole(membership))
+	d.Set("state", membership.GetState())
+	d.Set("downgrade_on_destroy", d.Get("downgrade_on_destroy").(bool))
 
 	return nil
 }
 
+// membershipDestroyed tells whether deleting d removes the user from the
+// organization or cancels their invitation, rather than downgrading them.
+func membershipDestroyed(d *schema.ResourceData) bool {
+	return d.Get("state").(string) == "pending" || !d.Get("downgrade_on_destroy").(bool)
+}
+
 func resourceGithubMembershipDelete(d *schema.ResourceData, meta interface{}) error {
 	err := checkOrganization(meta)
 	if err != nil {
@@ -124,11 +163,36 @@ func resourceGithubMembershipDelete(d *schema.ResourceData, meta interface{}) er
 
 	client := meta.(*Organization).client
 	orgName := meta.(*Organization).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	username, err := userLoginFromID(d)
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// Removing the membership of an invited user cancels the invitation
+	if d.Get("state").(string) == "pending" {
+		log.Printf("[DEBUG] Cancelling invitation of membership: %s", d.Id())
+	} else if d.Get("downgrade_on_destroy").(bool) {
+		log.Printf("[DEBUG] Downgrading membership to member: %s", d.Id())
+		_, _, err = client.Organizations.EditOrgMembership(ctx, username, orgName, &github.Membership{
+			Role: github.String("member"),
+		})
+		return err
+	} else {
+		log.Printf("[DEBUG] Deleting membership: %s", d.Id())
+	}
 
-	log.Printf("[DEBUG] Deleting membership: %s", d.Id())
-	_, err = client.Organizations.RemoveOrgMembership(ctx,
-		d.Get("username").(string), orgName)
+	_, err = client.Organizations.RemoveOrgMembership(ctx, username, orgName)
 
 	return err
 }
+
+// membershipRole returns the role of a membership the way it is configured.
+// The role of a pending invitation to become a member is reported as
+// direct_member, as it is on organization invitations.
+func membershipRole(m *github.Membership) string {
+	if m.GetRole() == "direct_member" {
+		return "member"
+	}
+	return m.GetRole()
+}
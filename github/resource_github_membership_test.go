@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/google/go-github/v28/github"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -30,6 +34,8 @@ func TestAccGithubMembership_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGithubMembershipExists(rn, &membership),
 					testAccCheckGithubMembershipRoleState(rn, &membership),
+					// The collaborator has not accepted the invitation yet
+					resource.TestCheckResourceAttr(rn, "state", "pending"),
 				),
 			},
 			{
@@ -83,6 +89,14 @@ func TestAccGithubMembership_caseInsensitive(t *testing.T) {
 	})
 }
 
+func TestMembershipRole(t *testing.T) {
+	for role, expected := range map[string]string{"direct_member": "member", "member": "member", "admin": "admin"} {
+		if actual := membershipRole(&github.Membership{Role: github.String(role)}); actual != expected {
+			t.Fatalf("Expected role %s to be configured as %s, got %s", role, expected, actual)
+		}
+	}
+}
+
 func testAccCheckGithubMembershipDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*Organization).client
 
@@ -188,3 +202,44 @@ func testAccGithubMembershipTheSame(orig, other *github.Membership) resource.Tes
 		return nil
 	}
 }
+
+func TestGithubMembershipRefresh_missingNodeID(t *testing.T) {
+	// GitHub answers 304 for as long as the membership does not change
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("If-None-Match") == `"abc"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"abc"`)
+		w.Write([]byte(`{"state": "active", "role": "member",
+  "user": {"login": "octocat", "id": 583231, "node_id": "MDQ6VXNlcjU4MzIzMQ=="}}`))
+	}))
+	defer ts.Close()
+
+	client := github.NewClient(&http.Client{Transport: NewEtagTransport(http.DefaultTransport)})
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{conditionalRequests: true}}
+
+	// The state of a membership read before node_id and user_id were read
+	r := Provider().(*schema.Provider).ResourcesMap["github_membership"]
+	state, err := r.Refresh(&terraform.InstanceState{
+		ID: "example:octocat",
+		Attributes: map[string]string{
+			"id":       "example:octocat",
+			"etag":     `"abc"`,
+			"username": "octocat",
+			"role":     "member",
+		},
+	}, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if state.Attributes["node_id"] != "MDQ6VXNlcjU4MzIzMQ==" || state.Attributes["user_id"] != "583231" {
+		t.Fatalf("Expected the membership to be read in full, got %v", state.Attributes)
+	}
+	if state.Attributes["state"] != "active" {
+		t.Fatalf("Expected the state of the membership, got %q", state.Attributes["state"])
+	}
+}
@@ -0,0 +1,166 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+type announcementBanner struct {
+	Announcement    *string `json:"announcement"`
+	ExpiresAt       *string `json:"expires_at"`
+	UserDismissible *bool   `json:"user_dismissible,omitempty"`
+}
+
+// An organization announcement is the banner shown on the pages of the
+// organization, or of every organization of an enterprise. It has the ID of
+// the organization or `enterprises/<enterprise>`.
+func resourceGithubOrganizationAnnouncement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationAnnouncementCreateOrUpdate,
+		Read:   resourceGithubOrganizationAnnouncementRead,
+		Update: resourceGithubOrganizationAnnouncementCreateOrUpdate,
+		Delete: resourceGithubOrganizationAnnouncementDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if strings.HasPrefix(d.Id(), "enterprises/") {
+					d.Set("enterprise", strings.TrimPrefix(d.Id(), "enterprises/"))
+				}
+				d.Set("user_dismissible", false)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Sets the announcement of the enterprise instead
+			"enterprise": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"announcement": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expires_at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"user_dismissible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func announcementURL(d *schema.ResourceData, meta interface{}) (string, string, error) {
+	if enterprise, ok := d.GetOk("enterprise"); ok {
+		return fmt.Sprintf("enterprises/%s/announcement", enterprise.(string)), "enterprises/" + enterprise.(string), nil
+	}
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return "", "", err
+	}
+	orgName := meta.(*Organization).name
+	return fmt.Sprintf("orgs/%s/announcement", orgName), orgName, nil
+}
+
+func resourceGithubOrganizationAnnouncementCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	bannerURL, id, err := announcementURL(d, meta)
+	if err != nil {
+		return err
+	}
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	// An announcement without expiry is shown until it is removed, so the
+	// expiry is cleared when it is no longer configured
+	banner := &announcementBanner{
+		Announcement:    github.String(d.Get("announcement").(string)),
+		UserDismissible: github.Bool(d.Get("user_dismissible").(bool)),
+	}
+	if v, ok := d.GetOk("expires_at"); ok {
+		banner.ExpiresAt = github.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Setting announcement: %s", id)
+	_, err = apiRequest(ctx, client, "PATCH", bannerURL, banner, nil)
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	return untilFound(d, meta, resourceGithubOrganizationAnnouncementRead)
+}
+
+func resourceGithubOrganizationAnnouncementRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	bannerURL, _, err := announcementURL(d, meta)
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading announcement: %s", d.Id())
+	banner := new(announcementBanner)
+	_, err = apiRequest(ctx, client, "GET", bannerURL, nil, banner)
+	if err != nil {
+		return err
+	}
+
+	if banner.Announcement == nil || *banner.Announcement == "" {
+		// An announcement which expired as planned is kept, so it isn't set
+		// again until its configuration changes
+		if expiresAt, err := time.Parse(time.RFC3339, d.Get("expires_at").(string)); err == nil && time.Now().After(expiresAt) {
+			log.Printf("[DEBUG] Announcement %s expired at %s", d.Id(), expiresAt)
+			return nil
+		}
+		log.Printf("[WARN] Removing announcement %s from state because it no longer exists in GitHub", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("announcement", banner.Announcement)
+	d.Set("user_dismissible", banner.UserDismissible != nil && *banner.UserDismissible)
+	// GitHub may return the expiry in another offset than configured
+	if banner.ExpiresAt != nil {
+		old, oldErr := time.Parse(time.RFC3339, d.Get("expires_at").(string))
+		current, err := time.Parse(time.RFC3339, *banner.ExpiresAt)
+		if oldErr != nil || err != nil || !old.Equal(current) {
+			d.Set("expires_at", banner.ExpiresAt)
+		}
+	} else {
+		d.Set("expires_at", "")
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationAnnouncementDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	bannerURL, _, err := announcementURL(d, meta)
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Removing announcement: %s", d.Id())
+	_, err = apiRequest(ctx, client, "DELETE", bannerURL, nil, nil)
+	return err
+}
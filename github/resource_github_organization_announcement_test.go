@@ -0,0 +1,76 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestGithubOrganizationAnnouncementCreate(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/enterprises/acme/announcement",
+			ExpectedMethod: "PATCH",
+			ExpectedBody:   []byte(`{"announcement":"Maintenance tonight","expires_at":"2020-01-02T06:00:00+01:00","user_dismissible":true}` + "\n"),
+			StatusCode:     200,
+			ResponseBody:   `{"announcement": "Maintenance tonight", "expires_at": "2020-01-02T05:00:00Z", "user_dismissible": true}`,
+		},
+		{
+			ExpectedUri:  "/enterprises/acme/announcement",
+			StatusCode:   200,
+			ResponseBody: `{"announcement": "Maintenance tonight", "expires_at": "2020-01-02T05:00:00Z", "user_dismissible": true}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubOrganizationAnnouncement().Schema, map[string]interface{}{
+		"enterprise":       "acme",
+		"announcement":     "Maintenance tonight",
+		"expires_at":       "2020-01-02T06:00:00+01:00",
+		"user_dismissible": true,
+	})
+	if err := resourceGithubOrganizationAnnouncementCreateOrUpdate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "enterprises/acme" {
+		t.Fatalf("Unexpected ID %q", d.Id())
+	}
+	// The same time in another offset is not a change
+	if v := d.Get("expires_at"); v != "2020-01-02T06:00:00+01:00" {
+		t.Fatalf("Expected the configured expiry to be kept, got %v", v)
+	}
+}
+
+func TestGithubOrganizationAnnouncementRead_removed(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/orgs/example/announcement",
+			StatusCode:   200,
+			ResponseBody: `{"announcement": null, "expires_at": null}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubOrganizationAnnouncement().Schema, map[string]interface{}{
+		"announcement": "Maintenance tonight",
+	})
+	d.SetId("example")
+	if err := resourceGithubOrganizationAnnouncementRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "" {
+		t.Fatal("Expected the removed announcement to be removed from state")
+	}
+}
@@ -0,0 +1,201 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type customProperty struct {
+	PropertyName     *string     `json:"property_name,omitempty"`
+	ValueType        *string     `json:"value_type,omitempty"`
+	Required         *bool       `json:"required,omitempty"`
+	DefaultValue     interface{} `json:"default_value"`
+	Description      *string     `json:"description,omitempty"`
+	AllowedValues    []string    `json:"allowed_values,omitempty"`
+	ValuesEditableBy *string     `json:"values_editable_by,omitempty"`
+}
+
+type customPropertyValue struct {
+	PropertyName string      `json:"property_name"`
+	Value        interface{} `json:"value"`
+}
+
+// expandCustomPropertyValue returns the value of a custom property as the API
+// expects it: a list for multi_select properties, a single string for the
+// other types and null for no value.
+func expandCustomPropertyValue(valueType string, values []interface{}) interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	if valueType == "multi_select" {
+		return expandStringList(values)
+	}
+
+	return values[0].(string)
+}
+
+// flattenCustomPropertyValue returns the value of a custom property, which is
+// either a string, a list of strings or null, as a list.
+func flattenCustomPropertyValue(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case string:
+		return []interface{}{v}
+	case []interface{}:
+		return v
+	}
+
+	return []interface{}{}
+}
+
+func resourceGithubOrganizationCustomProperty() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationCustomPropertyCreateOrUpdate,
+		Read:   resourceGithubOrganizationCustomPropertyRead,
+		Update: resourceGithubOrganizationCustomPropertyCreateOrUpdate,
+		Delete: resourceGithubOrganizationCustomPropertyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"property_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateValueFunc([]string{"string", "single_select", "multi_select", "true_false"}),
+			},
+			"required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"default_value": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"allowed_values": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"values_editable_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "org_actors",
+				ValidateFunc: validateValueFunc([]string{"org_actors", "org_and_repo_actors"}),
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationCustomPropertyCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	name := d.Get("property_name").(string)
+	valueType := d.Get("value_type").(string)
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	property := &customProperty{
+		ValueType:        github.String(valueType),
+		Required:         github.Bool(d.Get("required").(bool)),
+		DefaultValue:     expandCustomPropertyValue(valueType, d.Get("default_value").([]interface{})),
+		Description:      optionalString(d, "description"),
+		AllowedValues:    expandStringList(d.Get("allowed_values").([]interface{})),
+		ValuesEditableBy: github.String(d.Get("values_editable_by").(string)),
+	}
+
+	// Creating and updating a property are the same request
+	log.Printf("[DEBUG] Setting custom property: %s (%s)", name, orgName)
+	_, err = apiRequest(ctx, client, "PUT", fmt.Sprintf("orgs/%s/properties/schema/%s", orgName, name), property, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(name)
+
+	return untilFound(d, meta, resourceGithubOrganizationCustomPropertyRead)
+}
+
+func resourceGithubOrganizationCustomPropertyRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	name := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading custom property: %s (%s)", name, orgName)
+	property := new(customProperty)
+	resp, err := apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/properties/schema/%s", orgName, name), nil, property)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing custom property %s from state because it no longer exists in GitHub",
+					d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("property_name", property.PropertyName)
+	d.Set("value_type", property.ValueType)
+	d.Set("required", property.Required)
+	d.Set("default_value", flattenCustomPropertyValue(property.DefaultValue))
+	d.Set("description", property.Description)
+	d.Set("allowed_values", flattenStringList(property.AllowedValues))
+	d.Set("values_editable_by", property.ValuesEditableBy)
+
+	return nil
+}
+
+func resourceGithubOrganizationCustomPropertyDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting custom property: %s (%s)", d.Id(), orgName)
+	_, err = apiRequest(ctx, client, "DELETE", fmt.Sprintf("orgs/%s/properties/schema/%s", orgName, d.Id()), nil, nil)
+
+	return err
+}
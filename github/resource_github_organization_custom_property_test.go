@@ -0,0 +1,77 @@
+package github
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestCustomPropertyValue(t *testing.T) {
+	if v := expandCustomPropertyValue("multi_select", []interface{}{"a", "b"}); !reflect.DeepEqual(v, []string{"a", "b"}) {
+		t.Fatalf("Expected a list for a multi_select property, got %#v", v)
+	}
+	if v := expandCustomPropertyValue("single_select", []interface{}{"a"}); v != "a" {
+		t.Fatalf("Expected a string for a single_select property, got %#v", v)
+	}
+	if v := expandCustomPropertyValue("string", []interface{}{}); v != nil {
+		t.Fatalf("Expected null for no value, got %#v", v)
+	}
+
+	if v := flattenCustomPropertyValue("a"); !reflect.DeepEqual(v, []interface{}{"a"}) {
+		t.Fatalf("Expected a string value to be flattened to a list, got %#v", v)
+	}
+	if v := flattenCustomPropertyValue([]interface{}{"a", "b"}); !reflect.DeepEqual(v, []interface{}{"a", "b"}) {
+		t.Fatalf("Expected a list value to be kept, got %#v", v)
+	}
+	if v := flattenCustomPropertyValue(nil); len(v) != 0 {
+		t.Fatalf("Expected no value for null, got %#v", v)
+	}
+}
+
+func TestAccGithubOrganizationCustomProperty_basic(t *testing.T) {
+	rn := "github_organization_custom_property.test"
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubOrganizationCustomPropertyConfig(randString, "Where the repository is deployed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr(rn, "value_type", "single_select"),
+					resource.TestCheckResourceAttr(rn, "allowed_values.#", "2"),
+					resource.TestCheckResourceAttr(rn, "default_value.0", "staging"),
+				),
+			},
+			{
+				Config: testAccGithubOrganizationCustomPropertyConfig(randString, "The environment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "description", "The environment"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubOrganizationCustomPropertyConfig(randString, description string) string {
+	return fmt.Sprintf(`
+resource "github_organization_custom_property" "test" {
+  property_name  = "tf-acc-test-%s"
+  value_type     = "single_select"
+  required       = true
+  default_value  = ["staging"]
+  description    = %q
+  allowed_values = ["production", "staging"]
+}
+`, randString, description)
+}
@@ -0,0 +1,166 @@
+package github
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ipAllowListSettings holds the IP allow list settings of an organization,
+// or the ownerInfo of an enterprise, in the GraphQL API.
+type ipAllowListSettings struct {
+	IpAllowListEnabledSetting                 string `json:"ipAllowListEnabledSetting"`
+	IpAllowListForInstalledAppsEnabledSetting string `json:"ipAllowListForInstalledAppsEnabledSetting"`
+}
+
+const enterpriseIpAllowListIDPrefix = "enterprises/"
+
+// The IP allow list of an organization has the ID of the organization, and
+// that of an enterprise `enterprises/<slug>`. Destroying it disables the
+// allow list, leaving its entries in place.
+func resourceGithubOrganizationIpAllowList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationIpAllowListCreateOrUpdate,
+		Read:   resourceGithubOrganizationIpAllowListRead,
+		Update: resourceGithubOrganizationIpAllowListCreateOrUpdate,
+		Delete: resourceGithubOrganizationIpAllowListDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enterprise": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"for_installed_apps": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func ipAllowListSettingValue(enabled bool) string {
+	if enabled {
+		return "ENABLED"
+	}
+	return "DISABLED"
+}
+
+func updateIpAllowListSettings(ctx context.Context, meta interface{}, enterprise string, enabled, forInstalledApps bool) error {
+	client := meta.(*Organization).client
+
+	ownerID, err := getOwnerNodeID(ctx, meta, enterprise)
+	if err != nil {
+		return err
+	}
+
+	return graphqlRequest(ctx, client, `mutation($enabled: UpdateIpAllowListEnabledSettingInput!, $apps: UpdateIpAllowListForInstalledAppsEnabledSettingInput!) {
+  updateIpAllowListEnabledSetting(input: $enabled) { clientMutationId }
+  updateIpAllowListForInstalledAppsEnabledSetting(input: $apps) { clientMutationId }
+}`, map[string]interface{}{
+		"enabled": map[string]interface{}{"ownerId": ownerID, "settingValue": ipAllowListSettingValue(enabled)},
+		"apps":    map[string]interface{}{"ownerId": ownerID, "settingValue": ipAllowListSettingValue(forInstalledApps)},
+	}, nil)
+}
+
+func resourceGithubOrganizationIpAllowListCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	enterprise := d.Get("enterprise").(string)
+	if enterprise == "" {
+		err := checkOrganization(meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	if enterprise != "" {
+		log.Printf("[DEBUG] Updating IP allow list settings of enterprise: %s", enterprise)
+	} else {
+		log.Printf("[DEBUG] Updating IP allow list settings: %s", orgName)
+	}
+	err := updateIpAllowListSettings(ctx, meta, enterprise, d.Get("enabled").(bool), d.Get("for_installed_apps").(bool))
+	if err != nil {
+		return err
+	}
+
+	if enterprise != "" {
+		d.SetId(enterpriseIpAllowListIDPrefix + enterprise)
+	} else {
+		d.SetId(orgName)
+	}
+
+	return untilFound(d, meta, resourceGithubOrganizationIpAllowListRead)
+}
+
+func resourceGithubOrganizationIpAllowListRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	var data struct {
+		Enterprise *struct {
+			OwnerInfo ipAllowListSettings `json:"ownerInfo"`
+		} `json:"enterprise"`
+		Organization *ipAllowListSettings `json:"organization"`
+	}
+	var settings *ipAllowListSettings
+	if strings.HasPrefix(d.Id(), enterpriseIpAllowListIDPrefix) {
+		enterprise := strings.TrimPrefix(d.Id(), enterpriseIpAllowListIDPrefix)
+
+		log.Printf("[DEBUG] Reading IP allow list settings of enterprise: %s", enterprise)
+		err := graphqlRequest(ctx, client, `query($slug: String!) {
+  enterprise(slug: $slug) { ownerInfo { ipAllowListEnabledSetting ipAllowListForInstalledAppsEnabledSetting } }
+}`, map[string]interface{}{"slug": enterprise}, &data)
+		if err != nil && !isGraphqlNotFound(err) {
+			return err
+		}
+		if data.Enterprise != nil {
+			settings = &data.Enterprise.OwnerInfo
+		}
+		d.Set("enterprise", enterprise)
+	} else {
+		log.Printf("[DEBUG] Reading IP allow list settings: %s", d.Id())
+		err := graphqlRequest(ctx, client, `query($login: String!) {
+  organization(login: $login) { ipAllowListEnabledSetting ipAllowListForInstalledAppsEnabledSetting }
+}`, map[string]interface{}{"login": d.Id()}, &data)
+		if err != nil && !isGraphqlNotFound(err) {
+			return err
+		}
+		settings = data.Organization
+		d.Set("enterprise", "")
+	}
+
+	if settings == nil {
+		log.Printf("[WARN] Removing IP allow list settings %s from state because the owner no longer exists in GitHub",
+			d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("enabled", settings.IpAllowListEnabledSetting == "ENABLED")
+	d.Set("for_installed_apps", settings.IpAllowListForInstalledAppsEnabledSetting == "ENABLED")
+
+	return nil
+}
+
+func resourceGithubOrganizationIpAllowListDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Disabling IP allow list: %s", d.Id())
+	return updateIpAllowListSettings(ctx, meta, d.Get("enterprise").(string), false, false)
+}
@@ -0,0 +1,191 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// ipAllowListEntry is an IpAllowListEntry object of the GraphQL API.
+type ipAllowListEntry struct {
+	ID             string `json:"id"`
+	AllowListValue string `json:"allowListValue"`
+	Name           string `json:"name"`
+	IsActive       bool   `json:"isActive"`
+	CreatedAt      string `json:"createdAt"`
+	UpdatedAt      string `json:"updatedAt"`
+	Owner          struct {
+		Typename string `json:"__typename"`
+		Login    string `json:"login"`
+		Slug     string `json:"slug"`
+	} `json:"owner"`
+}
+
+const ipAllowListEntryFields = `id allowListValue name isActive createdAt updatedAt
+  owner { __typename ... on Organization { login } ... on Enterprise { slug } }`
+
+// IP allow list entries are managed through the GraphQL API only; the ID of
+// an entry is its node ID.
+func resourceGithubOrganizationIpAllowListEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationIpAllowListEntryCreate,
+		Read:   resourceGithubOrganizationIpAllowListEntryRead,
+		Update: resourceGithubOrganizationIpAllowListEntryUpdate,
+		Delete: resourceGithubOrganizationIpAllowListEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// The entries of an enterprise apply to all of its organizations
+			"enterprise": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.Any(validation.SingleIP(), validation.CIDRNetwork(0, 128)),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationIpAllowListEntryCreate(d *schema.ResourceData, meta interface{}) error {
+	enterprise := d.Get("enterprise").(string)
+	if enterprise == "" {
+		err := checkOrganization(meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	client := meta.(*Organization).client
+
+	ctx := stopContext(meta)
+
+	ownerID, err := getOwnerNodeID(ctx, meta, enterprise)
+	if err != nil {
+		return err
+	}
+
+	value := d.Get("value").(string)
+	log.Printf("[DEBUG] Creating IP allow list entry: %s (%s)", value, ownerID)
+	var data struct {
+		CreateIpAllowListEntry struct {
+			IpAllowListEntry ipAllowListEntry `json:"ipAllowListEntry"`
+		} `json:"createIpAllowListEntry"`
+	}
+	err = graphqlRequest(ctx, client, `mutation($input: CreateIpAllowListEntryInput!) {
+  createIpAllowListEntry(input: $input) { ipAllowListEntry { id } }
+}`, map[string]interface{}{"input": map[string]interface{}{
+		"ownerId":        ownerID,
+		"allowListValue": value,
+		"name":           d.Get("name").(string),
+		"isActive":       d.Get("active").(bool),
+	}}, &data)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(data.CreateIpAllowListEntry.IpAllowListEntry.ID)
+
+	return resourceGithubOrganizationIpAllowListEntryRead(d, meta)
+}
+
+func resourceGithubOrganizationIpAllowListEntryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading IP allow list entry: %s", d.Id())
+	var data struct {
+		Node *ipAllowListEntry `json:"node"`
+	}
+	err := graphqlRequest(ctx, client, fmt.Sprintf(`query($id: ID!) {
+  node(id: $id) { ... on IpAllowListEntry { %s } }
+}`, ipAllowListEntryFields), map[string]interface{}{"id": d.Id()}, &data)
+	if err != nil && !isGraphqlNotFound(err) {
+		return err
+	}
+	if data.Node == nil || data.Node.ID == "" {
+		log.Printf("[WARN] Removing IP allow list entry %s from state because it no longer exists in GitHub",
+			d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	entry := data.Node
+	if entry.Owner.Typename == "Enterprise" {
+		d.Set("enterprise", entry.Owner.Slug)
+	} else {
+		d.Set("enterprise", "")
+	}
+	d.Set("value", entry.AllowListValue)
+	d.Set("name", entry.Name)
+	d.Set("active", entry.IsActive)
+	d.Set("created_at", entry.CreatedAt)
+	d.Set("updated_at", entry.UpdatedAt)
+
+	return nil
+}
+
+func resourceGithubOrganizationIpAllowListEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Updating IP allow list entry: %s", d.Id())
+	err := graphqlRequest(ctx, client, `mutation($input: UpdateIpAllowListEntryInput!) {
+  updateIpAllowListEntry(input: $input) { ipAllowListEntry { id } }
+}`, map[string]interface{}{"input": map[string]interface{}{
+		"ipAllowListEntryId": d.Id(),
+		"allowListValue":     d.Get("value").(string),
+		"name":               d.Get("name").(string),
+		"isActive":           d.Get("active").(bool),
+	}}, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubOrganizationIpAllowListEntryRead(d, meta)
+}
+
+func resourceGithubOrganizationIpAllowListEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting IP allow list entry: %s", d.Id())
+	err := graphqlRequest(ctx, client, `mutation($input: DeleteIpAllowListEntryInput!) {
+  deleteIpAllowListEntry(input: $input) { clientMutationId }
+}`, map[string]interface{}{"input": map[string]interface{}{
+		"ipAllowListEntryId": d.Id(),
+	}}, nil)
+	if isGraphqlNotFound(err) {
+		return nil
+	}
+
+	return err
+}
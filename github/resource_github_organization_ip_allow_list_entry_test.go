@@ -0,0 +1,127 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubOrganizationIpAllowListEntry_basic(t *testing.T) {
+	rn := "github_organization_ip_allow_list_entry.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubOrganizationIpAllowListEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubOrganizationIpAllowListEntryConfig("192.0.2.0/24", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "value", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr(rn, "name", "test"),
+					resource.TestCheckResourceAttr(rn, "active", "false"),
+					resource.TestCheckResourceAttrSet(rn, "created_at"),
+				),
+			},
+			{
+				Config: testAccGithubOrganizationIpAllowListEntryConfig("198.51.100.7", "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "value", "198.51.100.7"),
+					resource.TestCheckResourceAttr(rn, "name", "updated"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestGithubOrganizationIpAllowListEntryRead(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/graphql",
+			ExpectedMethod: "POST",
+			StatusCode:     200,
+			ResponseBody: `{"data": {"node": {"id": "IALE_1", "allowListValue": "192.0.2.0/24", "name": "office",
+  "isActive": true, "createdAt": "2020-01-01T00:00:00Z", "updatedAt": "2020-01-02T00:00:00Z",
+  "owner": {"__typename": "Enterprise", "slug": "acme"}}}}`,
+		},
+		{
+			ExpectedUri:    "/graphql",
+			ExpectedMethod: "POST",
+			StatusCode:     200,
+			ResponseBody: `{"data": {"node": null},
+  "errors": [{"type": "NOT_FOUND", "path": ["node"], "message": "Could not resolve to a node with the global id of 'IALE_1'"}]}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubOrganizationIpAllowListEntry().Schema, map[string]interface{}{})
+	d.SetId("IALE_1")
+
+	if err := resourceGithubOrganizationIpAllowListEntryRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if enterprise := d.Get("enterprise").(string); enterprise != "acme" {
+		t.Fatalf("Expected the entry to belong to the enterprise acme, got %q", enterprise)
+	}
+	if value := d.Get("value").(string); value != "192.0.2.0/24" {
+		t.Fatalf("Expected the value 192.0.2.0/24, got %q", value)
+	}
+
+	// An entry which is gone is removed from state
+	if err := resourceGithubOrganizationIpAllowListEntryRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "" {
+		t.Fatalf("Expected the entry to be removed from state, got %q", d.Id())
+	}
+}
+
+func testAccCheckGithubOrganizationIpAllowListEntryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_organization_ip_allow_list_entry" {
+			continue
+		}
+
+		var data struct {
+			Node *ipAllowListEntry `json:"node"`
+		}
+		err := graphqlRequest(context.TODO(), conn, `query($id: ID!) { node(id: $id) { id } }`,
+			map[string]interface{}{"id": rs.Primary.ID}, &data)
+		if err != nil && !isGraphqlNotFound(err) {
+			return err
+		}
+		if data.Node != nil {
+			return fmt.Errorf("IP allow list entry %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+// Entries are created inactive, so they do not block access to the
+// organization the tests run against.
+func testAccGithubOrganizationIpAllowListEntryConfig(value, name string) string {
+	return fmt.Sprintf(`
+resource "github_organization_ip_allow_list_entry" "test" {
+  value  = "%s"
+  name   = "%s"
+  active = false
+}
+`, value, name)
+}
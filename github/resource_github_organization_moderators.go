@@ -0,0 +1,294 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const moderatorRoleName = "moderator"
+
+type organizationRole struct {
+	ID          *int64   `json:"id,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+func (r *organizationRole) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+func (r *organizationRole) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+type organizationRoles struct {
+	TotalCount int                 `json:"total_count"`
+	Roles      []*organizationRole `json:"roles"`
+}
+
+// getOrganizationRole looks up an organization role by its name; the names
+// of the predefined roles are matched case-insensitively.
+func getOrganizationRole(ctx context.Context, client *github.Client, orgName, roleName string) (*organizationRole, error) {
+	roles := new(organizationRoles)
+	_, err := apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/organization-roles", orgName), nil, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles.Roles {
+		if role.Name != nil && strings.EqualFold(*role.Name, roleName) {
+			return role, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Could not find organization role with name: %s", roleName)
+}
+
+func listOrganizationRoleUsers(ctx context.Context, meta interface{}, orgName string, roleID int64) ([]*github.User, error) {
+	client := meta.(*Organization).client
+
+	items, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		var users []*github.User
+		resp, err := apiRequest(ctx, client, "GET",
+			fmt.Sprintf("orgs/%s/organization-roles/%d/users?per_page=%d&page=%d", orgName, roleID, opt.PerPage, opt.Page), nil, &users)
+		return users, resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var allUsers []*github.User
+	for _, v := range items {
+		allUsers = append(allUsers, v.(*github.User))
+	}
+
+	return allUsers, nil
+}
+
+func listOrganizationRoleTeams(ctx context.Context, meta interface{}, orgName string, roleID int64) ([]*github.Team, error) {
+	client := meta.(*Organization).client
+
+	items, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		var teams []*github.Team
+		resp, err := apiRequest(ctx, client, "GET",
+			fmt.Sprintf("orgs/%s/organization-roles/%d/teams?per_page=%d&page=%d", orgName, roleID, opt.PerPage, opt.Page), nil, &teams)
+		return teams, resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var allTeams []*github.Team
+	for _, v := range items {
+		allTeams = append(allTeams, v.(*github.Team))
+	}
+
+	return allTeams, nil
+}
+
+func resourceGithubOrganizationModerators() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationModeratorsCreate,
+		Read:   resourceGithubOrganizationModeratorsRead,
+		Update: resourceGithubOrganizationModeratorsUpdate,
+		Delete: resourceGithubOrganizationModeratorsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// The moderator list is managed authoritatively: users and teams
+		// holding the moderator role outside of this resource are removed.
+		Schema: map[string]*schema.Schema{
+			"users": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString, DiffSuppressFunc: caseInsensitive()},
+				Set:      hashStringCaseInsensitive,
+			},
+			"teams": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationModeratorsCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	orgName := meta.(*Organization).name
+	d.SetId(orgName)
+
+	err = resourceGithubOrganizationModeratorsUpdate(d, meta)
+	if err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationModeratorsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading organization moderators: %s", orgName)
+	role, err := getOrganizationRole(ctx, client, orgName, moderatorRoleName)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing organization moderators %s from state because the organization no longer exists in GitHub",
+					orgName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	users, err := listOrganizationRoleUsers(ctx, meta, orgName, *role.ID)
+	if err != nil {
+		return err
+	}
+	logins := []string{}
+	for _, u := range users {
+		logins = append(logins, u.GetLogin())
+	}
+
+	teams, err := listOrganizationRoleTeams(ctx, meta, orgName, *role.ID)
+	if err != nil {
+		return err
+	}
+	slugs := []string{}
+	for _, t := range teams {
+		slugs = append(slugs, t.GetSlug())
+	}
+
+	d.Set("users", logins)
+	d.Set("teams", slugs)
+
+	return nil
+}
+
+func resourceGithubOrganizationModeratorsUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	role, err := getOrganizationRole(ctx, client, orgName, moderatorRoleName)
+	if err != nil {
+		return err
+	}
+
+	// Compare against GitHub rather than prior state, so moderators added
+	// outside of Terraform are removed as well.
+	users, err := listOrganizationRoleUsers(ctx, meta, orgName, *role.ID)
+	if err != nil {
+		return err
+	}
+	current := []interface{}{}
+	for _, u := range users {
+		current = append(current, u.GetLogin())
+	}
+	err = updateOrganizationRoleAssignments(ctx, client, orgName, *role.ID, "users",
+		schema.NewSet(hashStringCaseInsensitive, current), d.Get("users").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	teams, err := listOrganizationRoleTeams(ctx, meta, orgName, *role.ID)
+	if err != nil {
+		return err
+	}
+	current = []interface{}{}
+	for _, t := range teams {
+		current = append(current, t.GetSlug())
+	}
+	err = updateOrganizationRoleAssignments(ctx, client, orgName, *role.ID, "teams",
+		schema.NewSet(schema.HashString, current), d.Get("teams").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubOrganizationModeratorsRead(d, meta)
+}
+
+func resourceGithubOrganizationModeratorsDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	role, err := getOrganizationRole(ctx, client, orgName, moderatorRoleName)
+	if err != nil {
+		return err
+	}
+
+	empty := schema.NewSet(schema.HashString, nil)
+	err = updateOrganizationRoleAssignments(ctx, client, orgName, *role.ID, "users", d.Get("users").(*schema.Set), empty)
+	if err != nil {
+		return err
+	}
+
+	return updateOrganizationRoleAssignments(ctx, client, orgName, *role.ID, "teams", d.Get("teams").(*schema.Set), empty)
+}
+
+// updateOrganizationRoleAssignments assigns and revokes the role so that the
+// users or teams (depending on kind) holding it go from current to desired.
+func updateOrganizationRoleAssignments(ctx context.Context, client *github.Client, orgName string, roleID int64, kind string, current, desired *schema.Set) error {
+	for _, name := range current.Difference(desired).List() {
+		log.Printf("[DEBUG] Revoking organization role %d from %s %s (%s)", roleID, kind, name, orgName)
+		_, err := apiRequest(ctx, client, "DELETE",
+			fmt.Sprintf("orgs/%s/organization-roles/%s/%s/%d", orgName, kind, name.(string), roleID), nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, name := range desired.Difference(current).List() {
+		log.Printf("[DEBUG] Assigning organization role %d to %s %s (%s)", roleID, kind, name, orgName)
+		_, err := apiRequest(ctx, client, "PUT",
+			fmt.Sprintf("orgs/%s/organization-roles/%s/%s/%d", orgName, kind, name.(string), roleID), nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubOrganizationModerators_basic(t *testing.T) {
+	rn := "github_organization_moderators.test"
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubOrganizationModeratorsConfig(randString, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "id", testOrganization),
+					resource.TestCheckResourceAttr(rn, "teams.#", "1"),
+					resource.TestCheckResourceAttr(rn, "users.#", "0"),
+				),
+			},
+			{
+				Config: testAccGithubOrganizationModeratorsConfig(randString, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "teams.#", "1"),
+					resource.TestCheckResourceAttr(rn, "users.#", "1"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubOrganizationModeratorsConfig(randString string, withUser bool) string {
+	users := "[]"
+	if withUser {
+		users = fmt.Sprintf("[%q]", testCollaborator)
+	}
+
+	return fmt.Sprintf(`
+resource "github_team" "test" {
+  name = "tf-acc-test-moderators-%s"
+}
+
+resource "github_organization_moderators" "test" {
+  teams = ["${github_team.test.slug}"]
+  users = %s
+}
+`, randString, users)
+}
@@ -0,0 +1,143 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// An organization pre-receive hook overrides the enforcement of a pre-receive
+// hook of the GitHub Enterprise Server for the repositories of the
+// organization. It has the ID of the hook; destroying it restores the
+// enforcement of the hook.
+func resourceGithubOrganizationPreReceiveHook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationPreReceiveHookCreateOrUpdate,
+		Read:   resourceGithubOrganizationPreReceiveHookRead,
+		Update: resourceGithubOrganizationPreReceiveHookCreateOrUpdate,
+		Delete: resourceGithubOrganizationPreReceiveHookDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				hookID, err := strconv.Atoi(d.Id())
+				if err != nil {
+					return nil, unconvertibleIdErr(d.Id(), err)
+				}
+				d.Set("hook_id", hookID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hook_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"enforcement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateValueFunc([]string{"enabled", "disabled", "testing"}),
+			},
+			// Whether the repositories of the organization may override it
+			"allow_downstream_configuration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationPreReceiveHookCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+	err = checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
+	hookID := strconv.Itoa(d.Get("hook_id").(int))
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	req := &preReceiveHook{
+		Enforcement:                  github.String(d.Get("enforcement").(string)),
+		AllowDownstreamConfiguration: github.Bool(d.Get("allow_downstream_configuration").(bool)),
+	}
+	log.Printf("[DEBUG] Overriding pre-receive hook %s for organization: %s", hookID, orgName)
+	_, err = apiRequest(ctx, client, "PATCH", fmt.Sprintf("orgs/%s/pre-receive-hooks/%s", orgName, hookID), req, nil)
+	if err != nil {
+		return err
+	}
+	d.SetId(hookID)
+
+	return untilFound(d, meta, resourceGithubOrganizationPreReceiveHookRead)
+}
+
+func resourceGithubOrganizationPreReceiveHookRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+	err = checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading pre-receive hook %s of organization: %s", d.Id(), orgName)
+	hook := new(preReceiveHook)
+	_, err = apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/pre-receive-hooks/%s", orgName, d.Id()), nil, hook)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing pre-receive hook %s of organization %s from state because it no longer exists in GitHub",
+				d.Id(), orgName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", hook.Name)
+	d.Set("enforcement", hook.Enforcement)
+	d.Set("allow_downstream_configuration", hook.AllowDownstreamConfiguration)
+
+	return nil
+}
+
+func resourceGithubOrganizationPreReceiveHookDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+	err = checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Removing the override of pre-receive hook %s for organization: %s", d.Id(), orgName)
+	_, err = apiRequest(ctx, client, "DELETE", fmt.Sprintf("orgs/%s/pre-receive-hooks/%s", orgName, d.Id()), nil, nil)
+	return err
+}
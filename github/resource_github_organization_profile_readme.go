@@ -0,0 +1,277 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"text/template"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubOrganizationProfileReadme() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationProfileReadmeCreate,
+		Read:   resourceGithubOrganizationProfileReadmeRead,
+		Update: resourceGithubOrganizationProfileReadmeUpdate,
+		Delete: resourceGithubOrganizationProfileReadmeDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGithubOrganizationProfileReadmeImport,
+		},
+
+		CustomizeDiff: resourceGithubOrganizationProfileReadmeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ".github",
+				ForceNew: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "profile/README.md",
+				ForceNew: true,
+			},
+			"branch": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"template": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vars": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"commit_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Update organization profile README",
+			},
+			"rendered": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sha": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// renderProfileReadme executes the Go template of a profile README with the
+// given variables; referencing an undefined variable is an error.
+func renderProfileReadme(text string, vars map[string]interface{}) (string, error) {
+	tmpl, err := template.New("README").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func resourceGithubOrganizationProfileReadmeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("template") || !d.NewValueKnown("vars") {
+		return d.SetNewComputed("rendered")
+	}
+
+	rendered, err := renderProfileReadme(d.Get("template").(string), d.Get("vars").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	// The rendered attribute holds the content in GitHub, so a change made
+	// outside of Terraform shows up as a difference here
+	if rendered != d.Get("rendered").(string) {
+		return d.SetNew("rendered", rendered)
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationProfileReadmeCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	path := d.Get("path").(string)
+	ctx := stopContext(meta)
+
+	// An existing README is taken over rather than reported as a conflict
+	var sha *string
+	opt := &github.RepositoryContentGetOptions{Ref: d.Get("branch").(string)}
+	file, _, _, err := client.Repositories.GetContents(ctx, owner, repoName, path, opt)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); !ok || ghErr.Response.StatusCode != http.StatusNotFound {
+			return err
+		}
+	} else if file != nil {
+		sha = file.SHA
+	}
+
+	log.Printf("[DEBUG] Writing organization profile README: %s/%s/%s", owner, repoName, path)
+	err = writeOrganizationProfileReadme(ctx, d, meta, sha)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&repoName, &path))
+
+	return untilFound(d, meta, resourceGithubOrganizationProfileReadmeRead)
+}
+
+func resourceGithubOrganizationProfileReadmeRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, path, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading organization profile README: %s/%s/%s", owner, repoName, path)
+	opt := &github.RepositoryContentGetOptions{Ref: d.Get("branch").(string)}
+	file, _, resp, err := client.Repositories.GetContents(ctx, owner, repoName, path, opt)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing organization profile README %s/%s/%s from state because it no longer exists in GitHub",
+					owner, repoName, path)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	if file == nil {
+		return fmt.Errorf("%s in %s/%s is not a file", path, owner, repoName)
+	}
+
+	content, err := file.GetContent()
+	if err != nil {
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("repository", repoName)
+	d.Set("path", path)
+	d.Set("rendered", content)
+	d.Set("sha", file.GetSHA())
+
+	return nil
+}
+
+func resourceGithubOrganizationProfileReadmeUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Updating organization profile README: %s", d.Id())
+	err = writeOrganizationProfileReadme(ctx, d, meta, github.String(d.Get("sha").(string)))
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubOrganizationProfileReadmeRead(d, meta)
+}
+
+func resourceGithubOrganizationProfileReadmeDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, path, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	opt := &github.RepositoryContentFileOptions{
+		Message: github.String("Delete organization profile README"),
+		SHA:     github.String(d.Get("sha").(string)),
+	}
+	if branch, ok := d.GetOk("branch"); ok {
+		opt.Branch = github.String(branch.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting organization profile README: %s/%s/%s", owner, repoName, path)
+	_, _, err = client.Repositories.DeleteFile(ctx, owner, repoName, path, opt)
+	return err
+}
+
+func resourceGithubOrganizationProfileReadmeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	repoName, path, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Invalid ID specified. Supplied ID must be written as <repository>:<path>")
+	}
+
+	d.Set("repository", repoName)
+	d.Set("path", path)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func writeOrganizationProfileReadme(ctx context.Context, d *schema.ResourceData, meta interface{}, sha *string) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	path := d.Get("path").(string)
+
+	rendered, err := renderProfileReadme(d.Get("template").(string), d.Get("vars").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	opt := &github.RepositoryContentFileOptions{
+		Message: github.String(d.Get("commit_message").(string)),
+		Content: []byte(rendered),
+		SHA:     sha,
+	}
+	if branch, ok := d.GetOk("branch"); ok {
+		opt.Branch = github.String(branch.(string))
+	}
+
+	_, _, err = client.Repositories.UpdateFile(ctx, owner, repoName, path, opt)
+	return err
+}
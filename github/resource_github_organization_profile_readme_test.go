@@ -0,0 +1,74 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestRenderProfileReadme(t *testing.T) {
+	rendered, err := renderProfileReadme("# {{ .name }}\n", map[string]interface{}{"name": "Example"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rendered != "# Example\n" {
+		t.Fatalf("Unexpected rendered README: %q", rendered)
+	}
+
+	if _, err := renderProfileReadme("{{ .missing }}", map[string]interface{}{}); err == nil {
+		t.Fatal("Expected an error for an undefined variable")
+	}
+}
+
+func TestAccGithubOrganizationProfileReadme_basic(t *testing.T) {
+	rn := "github_organization_profile_readme.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-profile-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubOrganizationProfileReadmeConfig(repoName, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "path", "profile/README.md"),
+					resource.TestCheckResourceAttr(rn, "rendered", "# Hello "+repoName+"\n"),
+					resource.TestCheckResourceAttrSet(rn, "sha"),
+				),
+			},
+			{
+				Config: testAccGithubOrganizationProfileReadmeConfig(repoName, "Welcome to"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "rendered", "# Welcome to "+repoName+"\n"),
+				),
+			},
+			{
+				ResourceName:            rn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template", "vars", "commit_message"},
+			},
+		},
+	})
+}
+
+func testAccGithubOrganizationProfileReadmeConfig(repoName, greeting string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+resource "github_organization_profile_readme" "test" {
+  repository = "${github_repository.test.name}"
+  template   = "# %s {{ .repository }}\n"
+
+  vars = {
+    repository = "${github_repository.test.name}"
+  }
+}
+`, repoName, greeting)
+}
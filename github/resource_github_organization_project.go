@@ -34,6 +34,10 @@ func resourceGithubOrganizationProject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -52,7 +56,7 @@ func resourceGithubOrganizationProjectCreate(d *schema.ResourceData, meta interf
 	orgName := meta.(*Organization).name
 	name := d.Get("name").(string)
 	body := d.Get("body").(string)
-	ctx := context.Background()
+	ctx := stopContext(meta)
 
 	log.Printf("[DEBUG] Creating organization project: %s (%s)", name, orgName)
 	project, _, err := client.Organizations.CreateProject(ctx,
@@ -67,7 +71,7 @@ func resourceGithubOrganizationProjectCreate(d *schema.ResourceData, meta interf
 	}
 	d.SetId(strconv.FormatInt(*project.ID, 10))
 
-	return resourceGithubOrganizationProjectRead(d, meta)
+	return untilFound(d, meta, resourceGithubOrganizationProjectRead)
 }
 
 func resourceGithubOrganizationProjectRead(d *schema.ResourceData, meta interface{}) error {
@@ -83,10 +87,8 @@ func resourceGithubOrganizationProjectRead(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return err
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading organization project: %s (%s)", d.Id(), orgName)
 	project, resp, err := client.Projects.GetProject(ctx, projectID)
@@ -106,6 +108,7 @@ func resourceGithubOrganizationProjectRead(d *schema.ResourceData, meta interfac
 	}
 
 	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("node_id", project.GetNodeID())
 	d.Set("name", project.GetName())
 	d.Set("body", project.GetBody())
 	d.Set("url", fmt.Sprintf("https://github.com/orgs/%s/projects/%d",
@@ -135,7 +138,7 @@ func resourceGithubOrganizationProjectUpdate(d *schema.ResourceData, meta interf
 	if err != nil {
 		return err
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Updating organization project: %s (%s)", d.Id(), orgName)
 	if _, _, err := client.Projects.UpdateProject(ctx, projectID, &options); err != nil {
@@ -157,7 +160,7 @@ func resourceGithubOrganizationProjectDelete(d *schema.ResourceData, meta interf
 	if err != nil {
 		return err
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting organization project: %s (%s)", d.Id(), orgName)
 	_, err = client.Projects.DeleteProject(ctx, projectID)
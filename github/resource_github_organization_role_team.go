@@ -0,0 +1,136 @@
+package github
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubOrganizationRoleTeam() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationRoleTeamCreate,
+		Read:   resourceGithubOrganizationRoleTeamRead,
+		Delete: resourceGithubOrganizationRoleTeamDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: caseInsensitive(),
+			},
+			"team_slug": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationRoleTeamCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	roleName := d.Get("role").(string)
+	teamSlug := d.Get("team_slug").(string)
+	ctx := stopContext(meta)
+
+	role, err := getOrganizationRole(ctx, client, orgName, roleName)
+	if err != nil {
+		return err
+	}
+
+	err = updateOrganizationRoleAssignments(ctx, client, orgName, role.GetID(), "teams",
+		schema.NewSet(schema.HashString, nil), schema.NewSet(schema.HashString, []interface{}{teamSlug}))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&roleName, &teamSlug))
+	d.Set("role_id", role.GetID())
+
+	return untilFound(d, meta, resourceGithubOrganizationRoleTeamRead)
+}
+
+func resourceGithubOrganizationRoleTeamRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	roleName, teamSlug, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading organization role %s of team %s (%s)", roleName, teamSlug, orgName)
+	role, err := readOrganizationRole(ctx, d, client, orgName, roleName)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		log.Printf("[WARN] Removing organization role team %s from state because the role no longer exists in GitHub",
+			d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	teams, err := listOrganizationRoleTeams(ctx, meta, orgName, role.GetID())
+	if err != nil {
+		return err
+	}
+	var team *github.Team
+	for _, t := range teams {
+		if t.GetSlug() == teamSlug {
+			team = t
+			break
+		}
+	}
+	if team == nil {
+		log.Printf("[WARN] Removing organization role team %s from state because the team no longer holds the role",
+			d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role", role.GetName())
+	d.Set("role_id", role.GetID())
+	d.Set("team_slug", team.GetSlug())
+
+	return nil
+}
+
+func resourceGithubOrganizationRoleTeamDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	teamSlug := d.Get("team_slug").(string)
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	return updateOrganizationRoleAssignments(ctx, client, orgName, int64(d.Get("role_id").(int)), "teams",
+		schema.NewSet(schema.HashString, []interface{}{teamSlug}), schema.NewSet(schema.HashString, nil))
+}
@@ -0,0 +1,47 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubOrganizationRoleTeam_basic(t *testing.T) {
+	rn := "github_organization_role_team.test"
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubOrganizationRoleTeamConfig(randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "role", "all_repo_read"),
+					resource.TestCheckResourceAttrPair(rn, "team_slug", "github_team.test", "slug"),
+					resource.TestCheckResourceAttrSet(rn, "role_id"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubOrganizationRoleTeamConfig(randString string) string {
+	return fmt.Sprintf(`
+resource "github_team" "test" {
+  name = "tf-acc-test-role-%s"
+}
+
+resource "github_organization_role_team" "test" {
+  role      = "all_repo_read"
+  team_slug = "${github_team.test.slug}"
+}
+`, randString)
+}
@@ -0,0 +1,161 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubOrganizationRoleUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationRoleUserCreate,
+		Read:   resourceGithubOrganizationRoleUserRead,
+		Delete: resourceGithubOrganizationRoleUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: caseInsensitive(),
+			},
+			"login": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: caseInsensitive(),
+			},
+			"role_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// readOrganizationRole looks up the role of an assignment, by the ID kept in
+// state where there is one so that renaming a custom role is noticed, or else
+// by its name. A nil role means it no longer exists.
+func readOrganizationRole(ctx context.Context, d *schema.ResourceData, client *github.Client, orgName, roleName string) (*organizationRole, error) {
+	roleID := int64(d.Get("role_id").(int))
+	if roleID == 0 {
+		return getOrganizationRole(ctx, client, orgName, roleName)
+	}
+
+	role := new(organizationRole)
+	_, err := apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/organization-roles/%d", orgName, roleID), nil, role)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return role, nil
+}
+
+func resourceGithubOrganizationRoleUserCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	roleName := d.Get("role").(string)
+	login := d.Get("login").(string)
+	ctx := stopContext(meta)
+
+	role, err := getOrganizationRole(ctx, client, orgName, roleName)
+	if err != nil {
+		return err
+	}
+
+	err = updateOrganizationRoleAssignments(ctx, client, orgName, role.GetID(), "users",
+		schema.NewSet(schema.HashString, nil), schema.NewSet(schema.HashString, []interface{}{login}))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&roleName, &login))
+	d.Set("role_id", role.GetID())
+
+	return untilFound(d, meta, resourceGithubOrganizationRoleUserRead)
+}
+
+func resourceGithubOrganizationRoleUserRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	roleName, login, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading organization role %s of user %s (%s)", roleName, login, orgName)
+	role, err := readOrganizationRole(ctx, d, client, orgName, roleName)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		log.Printf("[WARN] Removing organization role user %s from state because the role no longer exists in GitHub",
+			d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	users, err := listOrganizationRoleUsers(ctx, meta, orgName, role.GetID())
+	if err != nil {
+		return err
+	}
+	var user *github.User
+	for _, u := range users {
+		if strings.EqualFold(u.GetLogin(), login) {
+			user = u
+			break
+		}
+	}
+	if user == nil {
+		log.Printf("[WARN] Removing organization role user %s from state because the user no longer holds the role",
+			d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role", role.GetName())
+	d.Set("role_id", role.GetID())
+	d.Set("login", user.GetLogin())
+
+	return nil
+}
+
+func resourceGithubOrganizationRoleUserDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	login := d.Get("login").(string)
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	return updateOrganizationRoleAssignments(ctx, client, orgName, int64(d.Get("role_id").(int)), "users",
+		schema.NewSet(schema.HashString, []interface{}{login}), schema.NewSet(schema.HashString, nil))
+}
@@ -0,0 +1,41 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubOrganizationRoleUser_basic(t *testing.T) {
+	rn := "github_organization_role_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubOrganizationRoleUserConfig(testCollaborator),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "id", "all_repo_read:"+testCollaborator),
+					resource.TestCheckResourceAttr(rn, "role", "all_repo_read"),
+					resource.TestCheckResourceAttrSet(rn, "role_id"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubOrganizationRoleUserConfig(login string) string {
+	return fmt.Sprintf(`
+resource "github_organization_role_user" "test" {
+  role  = "all_repo_read"
+  login = %q
+}
+`, login)
+}
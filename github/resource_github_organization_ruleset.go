@@ -0,0 +1,905 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+type ruleset struct {
+	ID           *int64                `json:"id,omitempty"`
+	NodeID       *string               `json:"node_id,omitempty"`
+	Name         string                `json:"name"`
+	Target       string                `json:"target,omitempty"`
+	Enforcement  string                `json:"enforcement"`
+	BypassActors []*rulesetBypassActor `json:"bypass_actors"`
+	Conditions   *rulesetConditions    `json:"conditions,omitempty"`
+	Rules        []*rulesetRule        `json:"rules"`
+}
+
+type rulesetBypassActor struct {
+	ActorID    *int64 `json:"actor_id,omitempty"`
+	ActorType  string `json:"actor_type"`
+	BypassMode string `json:"bypass_mode"`
+}
+
+type rulesetConditions struct {
+	RefName            *rulesetRefNameCondition            `json:"ref_name,omitempty"`
+	RepositoryName     *rulesetRepositoryNameCondition     `json:"repository_name,omitempty"`
+	RepositoryProperty *rulesetRepositoryPropertyCondition `json:"repository_property,omitempty"`
+}
+
+type rulesetRefNameCondition struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+type rulesetRepositoryNameCondition struct {
+	Include   []string `json:"include"`
+	Exclude   []string `json:"exclude"`
+	Protected bool     `json:"protected"`
+}
+
+type rulesetRepositoryPropertyCondition struct {
+	Include []*rulesetPropertyTarget `json:"include"`
+	Exclude []*rulesetPropertyTarget `json:"exclude"`
+}
+
+type rulesetPropertyTarget struct {
+	Name           string   `json:"name"`
+	PropertyValues []string `json:"property_values"`
+	Source         string   `json:"source,omitempty"`
+}
+
+type rulesetRule struct {
+	Type       string          `json:"type"`
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+type rulesetPullRequestParameters struct {
+	RequiredApprovingReviewCount   int  `json:"required_approving_review_count"`
+	DismissStaleReviewsOnPush      bool `json:"dismiss_stale_reviews_on_push"`
+	RequireCodeOwnerReview         bool `json:"require_code_owner_review"`
+	RequireLastPushApproval        bool `json:"require_last_push_approval"`
+	RequiredReviewThreadResolution bool `json:"required_review_thread_resolution"`
+}
+
+type rulesetRequiredStatusChecksParameters struct {
+	RequiredStatusChecks             []*rulesetStatusCheck `json:"required_status_checks"`
+	StrictRequiredStatusChecksPolicy bool                  `json:"strict_required_status_checks_policy"`
+}
+
+type rulesetMergeQueueParameters struct {
+	CheckResponseTimeoutMinutes  int    `json:"check_response_timeout_minutes"`
+	GroupingStrategy             string `json:"grouping_strategy"`
+	MaxEntriesToBuild            int    `json:"max_entries_to_build"`
+	MaxEntriesToMerge            int    `json:"max_entries_to_merge"`
+	MergeMethod                  string `json:"merge_method"`
+	MinEntriesToMerge            int    `json:"min_entries_to_merge"`
+	MinEntriesToMergeWaitMinutes int    `json:"min_entries_to_merge_wait_minutes"`
+}
+
+type rulesetWorkflowsParameters struct {
+	DoNotEnforceOnCreate bool                   `json:"do_not_enforce_on_create"`
+	Workflows            []*rulesetWorkflowFile `json:"workflows"`
+}
+
+type rulesetWorkflowFile struct {
+	Path         string `json:"path"`
+	RepositoryID int64  `json:"repository_id"`
+	Ref          string `json:"ref,omitempty"`
+}
+
+type rulesetStatusCheck struct {
+	Context       string `json:"context"`
+	IntegrationID *int64 `json:"integration_id,omitempty"`
+}
+
+// The rules which have no parameters, by the name of their argument
+var rulesetToggleRules = []string{
+	"creation",
+	"update",
+	"deletion",
+	"required_linear_history",
+	"required_signatures",
+	"non_fast_forward",
+}
+
+func rulesetPatternsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func rulesetPropertyTargetsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"property_values": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"source": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "custom",
+					ValidateFunc: validateValueFunc([]string{"custom", "system"}),
+				},
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationRuleset() *schema.Resource {
+	rules := map[string]*schema.Schema{
+		"pull_request": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"required_approving_review_count": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Default:  0,
+					},
+					"dismiss_stale_reviews_on_push": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"require_code_owner_review": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"require_last_push_approval": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"required_review_thread_resolution": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		"required_status_checks": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"required_check": {
+						Type:     schema.TypeList,
+						Required: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"context": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"integration_id": {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+							},
+						},
+					},
+					"strict_required_status_checks_policy": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		"required_workflows": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"required_workflow": {
+						Type:     schema.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"repository_id": {
+									Type:     schema.TypeInt,
+									Required: true,
+								},
+								"path": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"ref": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+							},
+						},
+					},
+					"do_not_enforce_on_create": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		"merge_queue": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"merge_method": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "MERGE",
+						ValidateFunc: validateValueFunc([]string{"MERGE", "SQUASH", "REBASE"}),
+					},
+					"grouping_strategy": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "ALLGREEN",
+						ValidateFunc: validateValueFunc([]string{"ALLGREEN", "HEADGREEN"}),
+					},
+					"min_entries_to_merge": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(0, 100),
+					},
+					"max_entries_to_merge": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      5,
+						ValidateFunc: validation.IntBetween(0, 100),
+					},
+					"max_entries_to_build": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      5,
+						ValidateFunc: validation.IntBetween(0, 100),
+					},
+					"min_entries_to_merge_wait_minutes": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      5,
+						ValidateFunc: validation.IntBetween(0, 360),
+					},
+					"check_response_timeout_minutes": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      60,
+						ValidateFunc: validation.IntBetween(1, 360),
+					},
+				},
+			},
+		},
+	}
+	for _, name := range rulesetToggleRules {
+		rules[name] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
+	}
+
+	return &schema.Resource{
+		Create:        resourceGithubOrganizationRulesetCreate,
+		Read:          resourceGithubOrganizationRulesetRead,
+		Update:        resourceGithubOrganizationRulesetUpdate,
+		Delete:        resourceGithubOrganizationRulesetDelete,
+		CustomizeDiff: resourceGithubOrganizationRulesetDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "branch",
+				ValidateFunc: validateValueFunc([]string{"branch", "tag", "push"}),
+			},
+			"enforcement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateValueFunc([]string{"disabled", "active", "evaluate"}),
+			},
+			"bypass_actors": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actor_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"actor_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateValueFunc([]string{"Integration", "OrganizationAdmin", "RepositoryRole", "Team", "DeployKey"}),
+						},
+						"bypass_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "always",
+							ValidateFunc: validateValueFunc([]string{"always", "pull_request"}),
+						},
+					},
+				},
+			},
+			"conditions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ref_name": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include": rulesetPatternsSchema(),
+									"exclude": rulesetPatternsSchema(),
+								},
+							},
+						},
+						"repository_name": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include": rulesetPatternsSchema(),
+									"exclude": rulesetPatternsSchema(),
+									"protected": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+						"repository_property": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include": rulesetPropertyTargetsSchema(),
+									"exclude": rulesetPropertyTargetsSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: rules,
+				},
+			},
+			"ruleset_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceGithubOrganizationRulesetDiff makes sure the repositories a ruleset
+// applies to are chosen either by their name or by their custom properties,
+// as GitHub requires exactly one of them.
+func resourceGithubOrganizationRulesetDiff(d *schema.ResourceDiff, meta interface{}) error {
+	byName := d.Get("conditions.0.repository_name.#").(int) > 0
+	byProperty := d.Get("conditions.0.repository_property.#").(int) > 0
+	if byName == byProperty {
+		return fmt.Errorf("Exactly one of `repository_name` and `repository_property` must be set in the conditions of ruleset %s",
+			d.Get("name").(string))
+	}
+
+	// Only pull requests into branches can be merged through a queue
+	if d.Get("rules.0.merge_queue.#").(int) > 0 && d.Get("target").(string) != "branch" {
+		return fmt.Errorf("The `merge_queue` rule of ruleset %s can only be used when `target` is \"branch\"",
+			d.Get("name").(string))
+	}
+
+	return nil
+}
+
+func expandRulesetPropertyTargets(configured []interface{}) []*rulesetPropertyTarget {
+	targets := []*rulesetPropertyTarget{}
+	for _, v := range configured {
+		m := v.(map[string]interface{})
+		targets = append(targets, &rulesetPropertyTarget{
+			Name:           m["name"].(string),
+			PropertyValues: expandStringList(m["property_values"].([]interface{})),
+			Source:         m["source"].(string),
+		})
+	}
+
+	return targets
+}
+
+func flattenRulesetPropertyTargets(targets []*rulesetPropertyTarget) []interface{} {
+	configured := []interface{}{}
+	for _, t := range targets {
+		source := t.Source
+		if source == "" {
+			source = "custom"
+		}
+		configured = append(configured, map[string]interface{}{
+			"name":            t.Name,
+			"property_values": flattenStringList(t.PropertyValues),
+			"source":          source,
+		})
+	}
+
+	return configured
+}
+
+func expandRulesetConditions(configured []interface{}) *rulesetConditions {
+	conditions := &rulesetConditions{}
+	if len(configured) == 0 || configured[0] == nil {
+		return conditions
+	}
+	m := configured[0].(map[string]interface{})
+
+	if v := m["ref_name"].([]interface{}); len(v) > 0 && v[0] != nil {
+		refName := v[0].(map[string]interface{})
+		conditions.RefName = &rulesetRefNameCondition{
+			Include: expandStringList(refName["include"].([]interface{})),
+			Exclude: expandStringList(refName["exclude"].([]interface{})),
+		}
+	}
+	if v := m["repository_name"].([]interface{}); len(v) > 0 && v[0] != nil {
+		repoName := v[0].(map[string]interface{})
+		conditions.RepositoryName = &rulesetRepositoryNameCondition{
+			Include:   expandStringList(repoName["include"].([]interface{})),
+			Exclude:   expandStringList(repoName["exclude"].([]interface{})),
+			Protected: repoName["protected"].(bool),
+		}
+	}
+	if v := m["repository_property"].([]interface{}); len(v) > 0 && v[0] != nil {
+		property := v[0].(map[string]interface{})
+		conditions.RepositoryProperty = &rulesetRepositoryPropertyCondition{
+			Include: expandRulesetPropertyTargets(property["include"].([]interface{})),
+			Exclude: expandRulesetPropertyTargets(property["exclude"].([]interface{})),
+		}
+	}
+
+	return conditions
+}
+
+func flattenRulesetConditions(conditions *rulesetConditions) []interface{} {
+	if conditions == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"ref_name":            []interface{}{},
+		"repository_name":     []interface{}{},
+		"repository_property": []interface{}{},
+	}
+	if c := conditions.RefName; c != nil {
+		m["ref_name"] = []interface{}{map[string]interface{}{
+			"include": flattenStringList(c.Include),
+			"exclude": flattenStringList(c.Exclude),
+		}}
+	}
+	if c := conditions.RepositoryName; c != nil {
+		m["repository_name"] = []interface{}{map[string]interface{}{
+			"include":   flattenStringList(c.Include),
+			"exclude":   flattenStringList(c.Exclude),
+			"protected": c.Protected,
+		}}
+	}
+	if c := conditions.RepositoryProperty; c != nil {
+		m["repository_property"] = []interface{}{map[string]interface{}{
+			"include": flattenRulesetPropertyTargets(c.Include),
+			"exclude": flattenRulesetPropertyTargets(c.Exclude),
+		}}
+	}
+
+	return []interface{}{m}
+}
+
+func expandRulesetRules(configured []interface{}) ([]*rulesetRule, error) {
+	rules := []*rulesetRule{}
+	if len(configured) == 0 || configured[0] == nil {
+		return rules, nil
+	}
+	m := configured[0].(map[string]interface{})
+
+	for _, name := range rulesetToggleRules {
+		if m[name].(bool) {
+			rules = append(rules, &rulesetRule{Type: name})
+		}
+	}
+
+	if v := m["pull_request"].([]interface{}); len(v) > 0 && v[0] != nil {
+		pr := v[0].(map[string]interface{})
+		parameters, err := json.Marshal(&rulesetPullRequestParameters{
+			RequiredApprovingReviewCount:   pr["required_approving_review_count"].(int),
+			DismissStaleReviewsOnPush:      pr["dismiss_stale_reviews_on_push"].(bool),
+			RequireCodeOwnerReview:         pr["require_code_owner_review"].(bool),
+			RequireLastPushApproval:        pr["require_last_push_approval"].(bool),
+			RequiredReviewThreadResolution: pr["required_review_thread_resolution"].(bool),
+		})
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rulesetRule{Type: "pull_request", Parameters: parameters})
+	}
+
+	if v := m["required_status_checks"].([]interface{}); len(v) > 0 && v[0] != nil {
+		rsc := v[0].(map[string]interface{})
+		checks := []*rulesetStatusCheck{}
+		for _, c := range rsc["required_check"].([]interface{}) {
+			check := c.(map[string]interface{})
+			statusCheck := &rulesetStatusCheck{Context: check["context"].(string)}
+			if id := check["integration_id"].(int); id != 0 {
+				statusCheck.IntegrationID = github.Int64(int64(id))
+			}
+			checks = append(checks, statusCheck)
+		}
+		parameters, err := json.Marshal(&rulesetRequiredStatusChecksParameters{
+			RequiredStatusChecks:             checks,
+			StrictRequiredStatusChecksPolicy: rsc["strict_required_status_checks_policy"].(bool),
+		})
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rulesetRule{Type: "required_status_checks", Parameters: parameters})
+	}
+
+	if v := m["required_workflows"].([]interface{}); len(v) > 0 && v[0] != nil {
+		rw := v[0].(map[string]interface{})
+		workflows := []*rulesetWorkflowFile{}
+		for _, w := range rw["required_workflow"].([]interface{}) {
+			workflow := w.(map[string]interface{})
+			workflows = append(workflows, &rulesetWorkflowFile{
+				Path:         workflow["path"].(string),
+				RepositoryID: int64(workflow["repository_id"].(int)),
+				Ref:          workflow["ref"].(string),
+			})
+		}
+		parameters, err := json.Marshal(&rulesetWorkflowsParameters{
+			DoNotEnforceOnCreate: rw["do_not_enforce_on_create"].(bool),
+			Workflows:            workflows,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rulesetRule{Type: "workflows", Parameters: parameters})
+	}
+
+	if v := m["merge_queue"].([]interface{}); len(v) > 0 && v[0] != nil {
+		mq := v[0].(map[string]interface{})
+		parameters, err := json.Marshal(&rulesetMergeQueueParameters{
+			CheckResponseTimeoutMinutes:  mq["check_response_timeout_minutes"].(int),
+			GroupingStrategy:             mq["grouping_strategy"].(string),
+			MaxEntriesToBuild:            mq["max_entries_to_build"].(int),
+			MaxEntriesToMerge:            mq["max_entries_to_merge"].(int),
+			MergeMethod:                  mq["merge_method"].(string),
+			MinEntriesToMerge:            mq["min_entries_to_merge"].(int),
+			MinEntriesToMergeWaitMinutes: mq["min_entries_to_merge_wait_minutes"].(int),
+		})
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rulesetRule{Type: "merge_queue", Parameters: parameters})
+	}
+
+	return rules, nil
+}
+
+func flattenRulesetRules(rules []*rulesetRule) ([]interface{}, error) {
+	m := map[string]interface{}{
+		"pull_request":           []interface{}{},
+		"required_status_checks": []interface{}{},
+		"required_workflows":     []interface{}{},
+		"merge_queue":            []interface{}{},
+	}
+	for _, name := range rulesetToggleRules {
+		m[name] = false
+	}
+
+	for _, rule := range rules {
+		switch rule.Type {
+		case "pull_request":
+			parameters := new(rulesetPullRequestParameters)
+			if err := json.Unmarshal(rule.Parameters, parameters); err != nil {
+				return nil, err
+			}
+			m["pull_request"] = []interface{}{map[string]interface{}{
+				"required_approving_review_count":   parameters.RequiredApprovingReviewCount,
+				"dismiss_stale_reviews_on_push":     parameters.DismissStaleReviewsOnPush,
+				"require_code_owner_review":         parameters.RequireCodeOwnerReview,
+				"require_last_push_approval":        parameters.RequireLastPushApproval,
+				"required_review_thread_resolution": parameters.RequiredReviewThreadResolution,
+			}}
+		case "required_status_checks":
+			parameters := new(rulesetRequiredStatusChecksParameters)
+			if err := json.Unmarshal(rule.Parameters, parameters); err != nil {
+				return nil, err
+			}
+			checks := []interface{}{}
+			for _, c := range parameters.RequiredStatusChecks {
+				integrationID := 0
+				if c.IntegrationID != nil {
+					integrationID = int(*c.IntegrationID)
+				}
+				checks = append(checks, map[string]interface{}{
+					"context":        c.Context,
+					"integration_id": integrationID,
+				})
+			}
+			m["required_status_checks"] = []interface{}{map[string]interface{}{
+				"required_check":                       checks,
+				"strict_required_status_checks_policy": parameters.StrictRequiredStatusChecksPolicy,
+			}}
+		case "workflows":
+			parameters := new(rulesetWorkflowsParameters)
+			if err := json.Unmarshal(rule.Parameters, parameters); err != nil {
+				return nil, err
+			}
+			workflows := []interface{}{}
+			for _, w := range parameters.Workflows {
+				workflows = append(workflows, map[string]interface{}{
+					"repository_id": int(w.RepositoryID),
+					"path":          w.Path,
+					"ref":           w.Ref,
+				})
+			}
+			m["required_workflows"] = []interface{}{map[string]interface{}{
+				"required_workflow":        workflows,
+				"do_not_enforce_on_create": parameters.DoNotEnforceOnCreate,
+			}}
+		case "merge_queue":
+			parameters := new(rulesetMergeQueueParameters)
+			if err := json.Unmarshal(rule.Parameters, parameters); err != nil {
+				return nil, err
+			}
+			m["merge_queue"] = []interface{}{map[string]interface{}{
+				"merge_method":                      parameters.MergeMethod,
+				"grouping_strategy":                 parameters.GroupingStrategy,
+				"min_entries_to_merge":              parameters.MinEntriesToMerge,
+				"max_entries_to_merge":              parameters.MaxEntriesToMerge,
+				"max_entries_to_build":              parameters.MaxEntriesToBuild,
+				"min_entries_to_merge_wait_minutes": parameters.MinEntriesToMergeWaitMinutes,
+				"check_response_timeout_minutes":    parameters.CheckResponseTimeoutMinutes,
+			}}
+		default:
+			if _, ok := m[rule.Type].(bool); ok {
+				m[rule.Type] = true
+			} else {
+				// Rules this resource does not support are not reported, and
+				// are removed when the ruleset is updated
+				log.Printf("[WARN] Ignoring ruleset rule of unsupported type %s", rule.Type)
+			}
+		}
+	}
+
+	return []interface{}{m}, nil
+}
+
+func expandRulesetBypassActors(configured []interface{}) []*rulesetBypassActor {
+	actors := []*rulesetBypassActor{}
+	for _, v := range configured {
+		m := v.(map[string]interface{})
+		actor := &rulesetBypassActor{
+			ActorType:  m["actor_type"].(string),
+			BypassMode: m["bypass_mode"].(string),
+		}
+		if id := m["actor_id"].(int); id != 0 {
+			actor.ActorID = github.Int64(int64(id))
+		}
+		actors = append(actors, actor)
+	}
+
+	return actors
+}
+
+func flattenRulesetBypassActors(actors []*rulesetBypassActor) []interface{} {
+	configured := []interface{}{}
+	for _, a := range actors {
+		id := 0
+		if a.ActorID != nil {
+			id = int(*a.ActorID)
+		}
+		configured = append(configured, map[string]interface{}{
+			"actor_id":    id,
+			"actor_type":  a.ActorType,
+			"bypass_mode": a.BypassMode,
+		})
+	}
+
+	return configured
+}
+
+func resourceGithubOrganizationRulesetObject(d *schema.ResourceData) (*ruleset, error) {
+	rules, err := expandRulesetRules(d.Get("rules").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ruleset{
+		Name:         d.Get("name").(string),
+		Target:       d.Get("target").(string),
+		Enforcement:  d.Get("enforcement").(string),
+		BypassActors: expandRulesetBypassActors(d.Get("bypass_actors").([]interface{})),
+		Conditions:   expandRulesetConditions(d.Get("conditions").([]interface{})),
+		Rules:        rules,
+	}, nil
+}
+
+func resourceGithubOrganizationRulesetCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
+
+	body, err := resourceGithubOrganizationRulesetObject(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating ruleset: %s (%s)", body.Name, orgName)
+	rs := new(ruleset)
+	_, err = apiRequest(ctx, client, "POST", fmt.Sprintf("orgs/%s/rulesets", orgName), body, rs)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(*rs.ID, 10))
+
+	return untilFound(d, meta, resourceGithubOrganizationRulesetRead)
+}
+
+func resourceGithubOrganizationRulesetRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading ruleset: %s (%s)", d.Id(), orgName)
+	rs := new(ruleset)
+	resp, err := apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/rulesets/%d", orgName, id), nil, rs)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing ruleset %s from state because it no longer exists in GitHub",
+					d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	rules, err := flattenRulesetRules(rs.Rules)
+	if err != nil {
+		return fmt.Errorf("Error decoding the rules of ruleset %s: %s", d.Id(), err)
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("ruleset_id", id)
+	d.Set("node_id", rs.NodeID)
+	d.Set("name", rs.Name)
+	d.Set("target", rs.Target)
+	d.Set("enforcement", rs.Enforcement)
+	d.Set("bypass_actors", flattenRulesetBypassActors(rs.BypassActors))
+	d.Set("conditions", flattenRulesetConditions(rs.Conditions))
+	d.Set("rules", rules)
+
+	return nil
+}
+
+func resourceGithubOrganizationRulesetUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	body, err := resourceGithubOrganizationRulesetObject(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating ruleset: %s (%s)", d.Id(), orgName)
+	_, err = apiRequest(ctx, client, "PUT", fmt.Sprintf("orgs/%s/rulesets/%d", orgName, id), body, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubOrganizationRulesetRead(d, meta)
+}
+
+func resourceGithubOrganizationRulesetDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting ruleset: %s (%s)", d.Id(), orgName)
+	_, err = apiRequest(ctx, client, "DELETE", fmt.Sprintf("orgs/%s/rulesets/%d", orgName, id), nil, nil)
+
+	return err
+}
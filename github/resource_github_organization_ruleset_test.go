@@ -0,0 +1,203 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestRulesetConditions(t *testing.T) {
+	configured := []interface{}{map[string]interface{}{
+		"ref_name": []interface{}{map[string]interface{}{
+			"include": []interface{}{"~DEFAULT_BRANCH"},
+			"exclude": []interface{}{},
+		}},
+		"repository_name": []interface{}{},
+		"repository_property": []interface{}{map[string]interface{}{
+			"include": []interface{}{map[string]interface{}{
+				"name":            "environment",
+				"property_values": []interface{}{"production"},
+				"source":          "custom",
+			}},
+			"exclude": []interface{}{},
+		}},
+	}}
+
+	conditions := expandRulesetConditions(configured)
+	if conditions.RepositoryName != nil {
+		t.Fatal("Expected no repository name condition")
+	}
+	b, err := json.Marshal(conditions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"ref_name":{"include":["~DEFAULT_BRANCH"],"exclude":[]},` +
+		`"repository_property":{"include":[{"name":"environment","property_values":["production"],"source":"custom"}],"exclude":[]}}`
+	if string(b) != expected {
+		t.Fatalf("Expected conditions %s, got %s", expected, b)
+	}
+
+	if flattened := flattenRulesetConditions(conditions); !reflect.DeepEqual(flattened, configured) {
+		t.Fatalf("Expected the conditions to be flattened as configured, got %#v", flattened)
+	}
+}
+
+func TestRulesetRules(t *testing.T) {
+	configured := []interface{}{map[string]interface{}{
+		"creation":                false,
+		"update":                  false,
+		"deletion":                true,
+		"required_linear_history": true,
+		"required_signatures":     false,
+		"non_fast_forward":        true,
+		"pull_request": []interface{}{map[string]interface{}{
+			"required_approving_review_count":   2,
+			"dismiss_stale_reviews_on_push":     true,
+			"require_code_owner_review":         false,
+			"require_last_push_approval":        false,
+			"required_review_thread_resolution": true,
+		}},
+		"required_status_checks": []interface{}{map[string]interface{}{
+			"required_check": []interface{}{map[string]interface{}{
+				"context":        "ci/build",
+				"integration_id": 0,
+			}},
+			"strict_required_status_checks_policy": true,
+		}},
+		"required_workflows": []interface{}{map[string]interface{}{
+			"required_workflow": []interface{}{map[string]interface{}{
+				"repository_id": 1296269,
+				"path":          ".github/workflows/ci.yml",
+				"ref":           "refs/heads/main",
+			}},
+			"do_not_enforce_on_create": true,
+		}},
+		"merge_queue": []interface{}{map[string]interface{}{
+			"merge_method":                      "SQUASH",
+			"grouping_strategy":                 "HEADGREEN",
+			"min_entries_to_merge":              2,
+			"max_entries_to_merge":              10,
+			"max_entries_to_build":              5,
+			"min_entries_to_merge_wait_minutes": 10,
+			"check_response_timeout_minutes":    60,
+		}},
+	}}
+
+	rules, err := expandRulesetRules(configured)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := []string{}
+	for _, r := range rules {
+		types = append(types, r.Type)
+	}
+	if expected := []string{"deletion", "required_linear_history", "non_fast_forward", "pull_request", "required_status_checks", "workflows", "merge_queue"}; !reflect.DeepEqual(types, expected) {
+		t.Fatalf("Expected rules %v, got %v", expected, types)
+	}
+
+	// Rules this resource does not support are ignored
+	rules = append(rules, &rulesetRule{Type: "code_scanning", Parameters: json.RawMessage(`{"code_scanning_tools": []}`)})
+	flattened, err := flattenRulesetRules(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(flattened, configured) {
+		t.Fatalf("Expected the rules to be flattened as configured, got %#v", flattened)
+	}
+}
+
+func TestAccGithubOrganizationRuleset_basic(t *testing.T) {
+	rn := "github_organization_ruleset.test"
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubOrganizationRulesetConfig(randString, `
+    repository_name {
+      include = ["tf-acc-test-*"]
+    }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "enforcement", "evaluate"),
+					resource.TestCheckResourceAttr(rn, "conditions.0.repository_name.0.include.0", "tf-acc-test-*"),
+					resource.TestCheckResourceAttr(rn, "rules.0.pull_request.0.required_approving_review_count", "1"),
+					resource.TestCheckResourceAttrSet(rn, "ruleset_id"),
+				),
+			},
+			{
+				Config: testAccGithubOrganizationRulesetConfig(randString, `
+    repository_property {
+      include {
+        name            = "${github_organization_custom_property.test.property_name}"
+        property_values = ["production"]
+      }
+    }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "conditions.0.repository_name.#", "0"),
+					resource.TestCheckResourceAttr(rn, "conditions.0.repository_property.0.include.0.property_values.0", "production"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGithubOrganizationRuleset_conditionsRequired(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGithubOrganizationRulesetConfig(randString, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Exactly one of `repository_name` and `repository_property` must be set"),
+			},
+		},
+	})
+}
+
+func testAccGithubOrganizationRulesetConfig(randString, repositoryCondition string) string {
+	return fmt.Sprintf(`
+resource "github_organization_custom_property" "test" {
+  property_name  = "tf-acc-test-%s"
+  value_type     = "single_select"
+  allowed_values = ["production", "staging"]
+}
+
+resource "github_organization_ruleset" "test" {
+  name        = "tf-acc-test-%s"
+  enforcement = "evaluate"
+
+  conditions {
+    ref_name {
+      include = ["~DEFAULT_BRANCH"]
+    }
+%s
+  }
+
+  rules {
+    deletion         = true
+    non_fast_forward = true
+
+    pull_request {
+      required_approving_review_count = 1
+    }
+  }
+}
+`, randString, randString, repositoryCondition)
+}
@@ -0,0 +1,51 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubOrganizationScimUserDeprovision() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationScimUserDeprovisionCreate,
+		Read:   schema.Noop, // Nothing to read as the identity is removed once it's deprovisioned
+		Delete: schema.Noop, // Nothing to restore as a deprovisioned identity cannot be brought back
+
+		Schema: map[string]*schema.Schema{
+			"scim_user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationScimUserDeprovisionCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	orgName := meta.(*Organization).name
+	id := d.Get("scim_user_id").(string)
+	ctx := stopContext(meta)
+
+	// Also removes the user from the organization
+	log.Printf("[DEBUG] Deprovisioning SCIM identity %s of organization: %s", id, orgName)
+	_, err = scimRequest(ctx, meta, "DELETE", fmt.Sprintf("scim/v2/organizations/%s/Users/%s", orgName, id), nil)
+	if err != nil {
+		// Already deprovisioned
+		if ghErr, ok := err.(*github.ErrorResponse); !ok || ghErr.Response.StatusCode != http.StatusNotFound {
+			return err
+		}
+	}
+
+	d.SetId(id)
+
+	return nil
+}
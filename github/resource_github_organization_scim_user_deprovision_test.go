@@ -0,0 +1,35 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubOrganizationScimUserDeprovision_basic(t *testing.T) {
+	// The identity is removed from the organization for good
+	id := os.Getenv("GITHUB_TEST_SCIM_DEPROVISION_ID")
+	if id == "" {
+		t.Skip("GITHUB_TEST_SCIM_DEPROVISION_ID must be set to a disposable SCIM identity for this acceptance test")
+	}
+	rn := "github_organization_scim_user_deprovision.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "github_organization_scim_user_deprovision" "test" {
+  scim_user_id = "%s"
+}
+`, id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "scim_user_id", id),
+				),
+			},
+		},
+	})
+}
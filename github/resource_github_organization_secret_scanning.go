@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type organizationSecretScanningDefaults struct {
+	SecretScanningEnabledForNewRepositories               *bool `json:"secret_scanning_enabled_for_new_repositories,omitempty"`
+	SecretScanningPushProtectionEnabledForNewRepositories *bool `json:"secret_scanning_push_protection_enabled_for_new_repositories,omitempty"`
+}
+
+func resourceGithubOrganizationSecretScanning() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationSecretScanningCreateOrUpdate,
+		Read:   resourceGithubOrganizationSecretScanningRead,
+		Update: resourceGithubOrganizationSecretScanningCreateOrUpdate,
+		Delete: resourceGithubOrganizationSecretScanningDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"secret_scanning_enabled_for_new_repositories": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"push_protection_enabled_for_new_repositories": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationSecretScanningCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	defaults := &organizationSecretScanningDefaults{
+		SecretScanningEnabledForNewRepositories:               github.Bool(d.Get("secret_scanning_enabled_for_new_repositories").(bool)),
+		SecretScanningPushProtectionEnabledForNewRepositories: github.Bool(d.Get("push_protection_enabled_for_new_repositories").(bool)),
+	}
+
+	log.Printf("[DEBUG] Updating organization secret scanning defaults: %s", orgName)
+	_, err = apiRequest(ctx, client, "PATCH", fmt.Sprintf("orgs/%s", orgName), defaults, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(orgName)
+
+	return untilFound(d, meta, resourceGithubOrganizationSecretScanningRead)
+}
+
+func resourceGithubOrganizationSecretScanningRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading organization secret scanning defaults: %s", orgName)
+	defaults := new(organizationSecretScanningDefaults)
+	resp, err := apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s", orgName), nil, defaults)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing organization secret scanning defaults %s from state because the organization no longer exists in GitHub",
+					orgName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("secret_scanning_enabled_for_new_repositories", defaults.SecretScanningEnabledForNewRepositories)
+	d.Set("push_protection_enabled_for_new_repositories", defaults.SecretScanningPushProtectionEnabledForNewRepositories)
+
+	return nil
+}
+
+func resourceGithubOrganizationSecretScanningDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	defaults := &organizationSecretScanningDefaults{
+		SecretScanningEnabledForNewRepositories:               github.Bool(false),
+		SecretScanningPushProtectionEnabledForNewRepositories: github.Bool(false),
+	}
+
+	log.Printf("[DEBUG] Resetting organization secret scanning defaults: %s", orgName)
+	_, err = apiRequest(ctx, client, "PATCH", fmt.Sprintf("orgs/%s", orgName), defaults, nil)
+	return err
+}
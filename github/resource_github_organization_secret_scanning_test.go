@@ -0,0 +1,47 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubOrganizationSecretScanning_basic(t *testing.T) {
+	rn := "github_organization_secret_scanning.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubOrganizationSecretScanningConfig(true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "id", testOrganization),
+					resource.TestCheckResourceAttr(rn, "secret_scanning_enabled_for_new_repositories", "true"),
+					resource.TestCheckResourceAttr(rn, "push_protection_enabled_for_new_repositories", "false"),
+				),
+			},
+			{
+				Config: testAccGithubOrganizationSecretScanningConfig(true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "push_protection_enabled_for_new_repositories", "true"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubOrganizationSecretScanningConfig(secretScanning, pushProtection bool) string {
+	return fmt.Sprintf(`
+resource "github_organization_secret_scanning" "test" {
+  secret_scanning_enabled_for_new_repositories = %t
+  push_protection_enabled_for_new_repositories = %t
+}
+`, secretScanning, pushProtection)
+}
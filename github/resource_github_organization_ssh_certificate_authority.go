@@ -0,0 +1,143 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type sshCertificateAuthority struct {
+	ID          *int64  `json:"id,omitempty"`
+	Key         *string `json:"key,omitempty"`
+	Fingerprint *string `json:"fingerprint,omitempty"`
+	CreatedAt   *string `json:"created_at,omitempty"`
+}
+
+func resourceGithubOrganizationSshCertificateAuthority() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubOrganizationSshCertificateAuthorityCreate,
+		Read:   resourceGithubOrganizationSshCertificateAuthorityRead,
+		Delete: resourceGithubOrganizationSshCertificateAuthorityDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, oldV, newV string, d *schema.ResourceData) bool {
+					newTrimmed := strings.TrimSpace(newV)
+					return oldV == newTrimmed
+				},
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationSshCertificateAuthorityCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	key := strings.TrimSpace(d.Get("key").(string))
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Creating SSH certificate authority: %s", orgName)
+	ca := new(sshCertificateAuthority)
+	_, err = apiRequest(ctx, client, "POST", fmt.Sprintf("orgs/%s/ssh-certificate-authorities", orgName),
+		&sshCertificateAuthority{Key: github.String(key)}, ca)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(*ca.ID, 10))
+
+	return untilFound(d, meta, resourceGithubOrganizationSshCertificateAuthorityRead)
+}
+
+func resourceGithubOrganizationSshCertificateAuthorityRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading SSH certificate authority: %s (%s)", d.Id(), orgName)
+	ca := new(sshCertificateAuthority)
+	resp, err := apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/ssh-certificate-authorities/%d", orgName, id), nil, ca)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing SSH certificate authority %s from state because it no longer exists in GitHub",
+					d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("key", ca.Key)
+	d.Set("fingerprint", ca.Fingerprint)
+	d.Set("created_at", ca.CreatedAt)
+
+	return nil
+}
+
+func resourceGithubOrganizationSshCertificateAuthorityDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting SSH certificate authority: %s (%s)", d.Id(), orgName)
+	_, err = apiRequest(ctx, client, "DELETE", fmt.Sprintf("orgs/%s/ssh-certificate-authorities/%d", orgName, id), nil, nil)
+
+	return err
+}
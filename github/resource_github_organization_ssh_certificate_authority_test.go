@@ -0,0 +1,76 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubOrganizationSshCertificateAuthority_basic(t *testing.T) {
+	rn := "github_organization_ssh_certificate_authority.test"
+	keyRe := regexp.MustCompile("^ecdsa-sha2-nistp384 ")
+	fingerprintRe := regexp.MustCompile("^SHA256:")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubOrganizationSshCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubOrganizationSshCertificateAuthorityConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(rn, "key", keyRe),
+					resource.TestMatchResourceAttr(rn, "fingerprint", fingerprintRe),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGithubOrganizationSshCertificateAuthorityDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+	orgName := testAccProvider.Meta().(*Organization).name
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_organization_ssh_certificate_authority" {
+			continue
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return unconvertibleIdErr(rs.Primary.ID, err)
+		}
+
+		resp, err := apiRequest(context.TODO(), conn, "GET",
+			fmt.Sprintf("orgs/%s/ssh-certificate-authorities/%d", orgName, id), nil, nil)
+		if err == nil {
+			return fmt.Errorf("SSH certificate authority %s still exists", rs.Primary.ID)
+		}
+		if resp != nil && resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
+const testAccGithubOrganizationSshCertificateAuthorityConfig = `
+resource "tls_private_key" "test" {
+  algorithm   = "ECDSA"
+  ecdsa_curve = "P384"
+}
+
+resource "github_organization_ssh_certificate_authority" "test" {
+  key = "${tls_private_key.test.public_key_openssh}"
+}
+`
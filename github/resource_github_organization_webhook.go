@@ -82,7 +82,7 @@ func resourceGithubOrganizationWebhookCreate(d *schema.ResourceData, meta interf
 
 	orgName := meta.(*Organization).name
 	webhookObj := resourceGithubOrganizationWebhookObject(d)
-	ctx := context.Background()
+	ctx := stopContext(meta)
 
 	log.Printf("[DEBUG] Creating organization webhook: %d (%s)", webhookObj.GetID(), orgName)
 	hook, _, err := client.Organizations.CreateHook(ctx, orgName, webhookObj)
@@ -100,7 +100,7 @@ func resourceGithubOrganizationWebhookCreate(d *schema.ResourceData, meta interf
 	}
 	d.Set("configuration", []interface{}{hook.Config})
 
-	return resourceGithubOrganizationWebhookRead(d, meta)
+	return untilFound(d, meta, resourceGithubOrganizationWebhookRead)
 }
 
 func resourceGithubOrganizationWebhookRead(d *schema.ResourceData, meta interface{}) error {
@@ -116,10 +116,8 @@ func resourceGithubOrganizationWebhookRead(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading organization webhook: %s (%s)", d.Id(), orgName)
 	hook, resp, err := client.Organizations.GetHook(ctx, orgName, hookID)
@@ -174,7 +172,7 @@ func resourceGithubOrganizationWebhookUpdate(d *schema.ResourceData, meta interf
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Updating organization webhook: %s (%s)", d.Id(), orgName)
 
@@ -200,7 +198,7 @@ func resourceGithubOrganizationWebhookDelete(d *schema.ResourceData, meta interf
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting organization webhook: %s (%s)", d.Id(), orgName)
 	_, err = client.Organizations.DeleteHook(ctx, orgName, hookID)
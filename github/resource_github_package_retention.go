@@ -0,0 +1,314 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+type packageInfo struct {
+	Name         string `json:"name"`
+	PackageType  string `json:"package_type"`
+	Visibility   string `json:"visibility"`
+	VersionCount int    `json:"version_count"`
+	Repository   *struct {
+		Name string `json:"name"`
+	} `json:"repository"`
+}
+
+type packageVersion struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	Metadata  struct {
+		Container *struct {
+			Tags []string `json:"tags"`
+		} `json:"container"`
+	} `json:"metadata"`
+}
+
+// packageRetentionRules decide which versions of a package are deleted.
+type packageRetentionRules struct {
+	keepLatest     int
+	deleteUntagged bool
+	olderThan      time.Duration
+	keepTags       map[string]bool
+}
+
+// A package retention deletes the versions of a package of the organization
+// which its rules no longer keep, whenever it is applied. It has the ID
+// `<package type>:<package name>`. The visibility of packages and the
+// repositories they are linked to cannot be changed through the API, so they
+// are only read.
+func resourceGithubPackageRetention() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceGithubPackageRetentionCreate,
+		Read:          resourceGithubPackageRetentionRead,
+		Update:        resourceGithubPackageRetentionUpdate,
+		Delete:        resourceGithubPackageRetentionDelete,
+		CustomizeDiff: resourceGithubPackageRetentionDiff,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				packageType, packageName, err := parseTwoPartID(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("package_type", packageType)
+				d.Set("package_name", packageName)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"package_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateValueFunc([]string{"npm", "maven", "rubygems", "docker", "nuget", "container"}),
+			},
+			"package_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"keep_latest": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			// Only container versions have tags
+			"delete_untagged": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"older_than_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"keep_tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"expired_version_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Set:      schema.HashInt,
+			},
+			"visibility": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandPackageRetentionRules(get func(string) interface{}) *packageRetentionRules {
+	rules := &packageRetentionRules{
+		keepLatest:     get("keep_latest").(int),
+		deleteUntagged: get("delete_untagged").(bool),
+		olderThan:      time.Duration(get("older_than_days").(int)) * 24 * time.Hour,
+		keepTags:       map[string]bool{},
+	}
+	for _, tag := range expandStringList(get("keep_tags").(*schema.Set).List()) {
+		rules.keepTags[tag] = true
+	}
+	return rules
+}
+
+// expired returns the versions, newest first, which the rules don't keep.
+func (rules *packageRetentionRules) expired(versions []*packageVersion, now time.Time) []*packageVersion {
+	expired := []*packageVersion{}
+	for i, v := range versions {
+		var tags []string
+		if v.Metadata.Container != nil {
+			tags = v.Metadata.Container.Tags
+		}
+
+		if !(rules.keepLatest > 0 && i >= rules.keepLatest) && !(rules.deleteUntagged && len(tags) == 0) {
+			continue
+		}
+		if rules.olderThan > 0 && now.Sub(v.CreatedAt) < rules.olderThan {
+			continue
+		}
+		kept := false
+		for _, tag := range tags {
+			kept = kept || rules.keepTags[tag]
+		}
+		if !kept {
+			expired = append(expired, v)
+		}
+	}
+	return expired
+}
+
+func packageURL(meta interface{}, packageType, packageName string) string {
+	// Container names may contain slashes, which are part of the name
+	path := fmt.Sprintf("packages/%s/%s", packageType, url.PathEscape(packageName))
+	if meta.(*Organization).individual {
+		return "user/" + path
+	}
+	return fmt.Sprintf("orgs/%s/%s", meta.(*Organization).name, path)
+}
+
+func listExpiredPackageVersions(ctx context.Context, meta interface{}, packageType, packageName string, rules *packageRetentionRules) ([]*packageVersion, error) {
+	client := meta.(*Organization).client
+
+	// GitHub lists the versions newest first
+	items, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		var result []*packageVersion
+		resp, err := apiRequest(ctx, client, "GET",
+			fmt.Sprintf("%s/versions?%s", packageURL(meta, packageType, packageName), pageQuery(url.Values{}, opt)), nil, &result)
+		return result, resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]*packageVersion, 0, len(items))
+	for _, v := range items {
+		versions = append(versions, v.(*packageVersion))
+	}
+
+	return rules.expired(versions, time.Now()), nil
+}
+
+func resourceGithubPackageRetentionCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	packageType := d.Get("package_type").(string)
+	packageName := d.Get("package_name").(string)
+	if d.Get("keep_latest").(int) == 0 && !d.Get("delete_untagged").(bool) {
+		return fmt.Errorf("Package retention %s:%s requires `keep_latest` or `delete_untagged` to be set.", packageType, packageName)
+	}
+
+	d.SetId(buildTwoPartID(&packageType, &packageName))
+
+	return untilFound(d, meta, resourceGithubPackageRetentionUpdate)
+}
+
+func resourceGithubPackageRetentionRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	packageType := d.Get("package_type").(string)
+	packageName := d.Get("package_name").(string)
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading package: %s", d.Id())
+	pkg := new(packageInfo)
+	_, err = apiRequest(ctx, client, "GET", packageURL(meta, packageType, packageName), nil, pkg)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing package retention %s from state because the package no longer exists in GitHub",
+				d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	expired, err := listExpiredPackageVersions(ctx, meta, packageType, packageName, expandPackageRetentionRules(d.Get))
+	if err != nil {
+		return err
+	}
+	ids := make([]interface{}, 0, len(expired))
+	for _, v := range expired {
+		ids = append(ids, int(v.ID))
+	}
+
+	d.Set("visibility", pkg.Visibility)
+	d.Set("version_count", pkg.VersionCount)
+	if pkg.Repository != nil {
+		d.Set("repository", pkg.Repository.Name)
+	} else {
+		d.Set("repository", "")
+	}
+	d.Set("expired_version_ids", schema.NewSet(schema.HashInt, ids))
+
+	return nil
+}
+
+func resourceGithubPackageRetentionUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	packageType := d.Get("package_type").(string)
+	packageName := d.Get("package_name").(string)
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// The versions are listed again, as new versions may have been published
+	// since the package was last read
+	expired, err := listExpiredPackageVersions(ctx, meta, packageType, packageName, expandPackageRetentionRules(d.Get))
+	if err != nil {
+		return err
+	}
+	for _, v := range expired {
+		log.Printf("[DEBUG] Deleting version %s (%d) of package: %s", v.Name, v.ID, d.Id())
+		_, err = apiRequest(ctx, client, "DELETE",
+			fmt.Sprintf("%s/versions/%d", packageURL(meta, packageType, packageName), v.ID), nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubPackageRetentionRead(d, meta)
+}
+
+// resourceGithubPackageRetentionDiff plans deleting the versions which the
+// rules no longer keep, since the last read or because the rules changed.
+func resourceGithubPackageRetentionDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	expired, err := listExpiredPackageVersions(ctx, meta, d.Get("package_type").(string), d.Get("package_name").(string),
+		expandPackageRetentionRules(d.Get))
+	if err != nil {
+		return err
+	}
+	if len(expired) > 0 {
+		log.Printf("[DEBUG] Package %s has %d expired versions", d.Id(), len(expired))
+		return d.SetNew("expired_version_ids", []int{})
+	}
+
+	return nil
+}
+
+func resourceGithubPackageRetentionDelete(d *schema.ResourceData, meta interface{}) error {
+	// The versions which were kept stay in place
+	log.Printf("[DEBUG] Removing package retention %s from state", d.Id())
+	d.SetId("")
+
+	return nil
+}
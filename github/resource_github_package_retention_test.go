@@ -0,0 +1,107 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestPackageRetentionRulesExpired(t *testing.T) {
+	now := time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+	version := func(id int64, age time.Duration, tags ...string) *packageVersion {
+		v := &packageVersion{ID: id, CreatedAt: now.Add(-age)}
+		v.Metadata.Container = &struct {
+			Tags []string `json:"tags"`
+		}{Tags: tags}
+		return v
+	}
+	versions := []*packageVersion{
+		version(5, time.Hour, "v5"),
+		version(4, 2*time.Hour),
+		version(3, 10*24*time.Hour, "v3"),
+		version(2, 20*24*time.Hour, "latest"),
+		version(1, 30*24*time.Hour, "v1"),
+	}
+
+	testCases := []struct {
+		rules    *packageRetentionRules
+		expected []int64
+	}{
+		{&packageRetentionRules{keepLatest: 3}, []int64{2, 1}},
+		{&packageRetentionRules{keepLatest: 3, keepTags: map[string]bool{"latest": true}}, []int64{1}},
+		{&packageRetentionRules{deleteUntagged: true}, []int64{4}},
+		{&packageRetentionRules{keepLatest: 1, olderThan: 15 * 24 * time.Hour}, []int64{2, 1}},
+		{&packageRetentionRules{keepLatest: 2, deleteUntagged: true}, []int64{4, 3, 2, 1}},
+	}
+
+	for _, tc := range testCases {
+		expired := tc.rules.expired(versions, now)
+		ids := []int64{}
+		for _, v := range expired {
+			ids = append(ids, v.ID)
+		}
+		if len(ids) != len(tc.expected) {
+			t.Fatalf("Expected versions %v to expire with %+v, got %v", tc.expected, tc.rules, ids)
+		}
+		for i := range ids {
+			if ids[i] != tc.expected[i] {
+				t.Fatalf("Expected versions %v to expire with %+v, got %v", tc.expected, tc.rules, ids)
+			}
+		}
+	}
+}
+
+func TestGithubPackageRetentionCreate(t *testing.T) {
+	versions := `[{"id": 3, "name": "sha256:c3", "created_at": "2020-01-03T00:00:00Z", "metadata": {"container": {"tags": ["latest"]}}},
+		{"id": 2, "name": "sha256:b2", "created_at": "2020-01-02T00:00:00Z", "metadata": {"container": {"tags": []}}},
+		{"id": 1, "name": "sha256:a1", "created_at": "2020-01-01T00:00:00Z", "metadata": {"container": {"tags": ["v1"]}}}]`
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/orgs/example/packages/container/tools%2Fapi/versions?page=1&per_page=100",
+			StatusCode:   200,
+			ResponseBody: versions,
+		},
+		{
+			ExpectedUri:    "/orgs/example/packages/container/tools%2Fapi/versions/2",
+			ExpectedMethod: "DELETE",
+			StatusCode:     204,
+		},
+		{
+			ExpectedUri:  "/orgs/example/packages/container/tools%2Fapi",
+			StatusCode:   200,
+			ResponseBody: `{"name": "tools/api", "package_type": "container", "visibility": "private", "version_count": 2, "repository": {"name": "api"}}`,
+		},
+		{
+			ExpectedUri:  "/orgs/example/packages/container/tools%2Fapi/versions?page=1&per_page=100",
+			StatusCode:   200,
+			ResponseBody: `[{"id": 3, "name": "sha256:c3", "created_at": "2020-01-03T00:00:00Z", "metadata": {"container": {"tags": ["latest"]}}}]`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubPackageRetention().Schema, map[string]interface{}{
+		"package_type":    "container",
+		"package_name":    "tools/api",
+		"delete_untagged": true,
+	})
+	if err := resourceGithubPackageRetentionCreate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "container:tools/api" {
+		t.Fatalf("Unexpected ID %q", d.Id())
+	}
+	if v := d.Get("repository"); v != "api" {
+		t.Fatalf("Expected the package to be linked to the api repository, got %v", v)
+	}
+	if n := d.Get("expired_version_ids").(*schema.Set).Len(); n != 0 {
+		t.Fatalf("Expected no expired versions after the cleanup, got %d", n)
+	}
+}
@@ -31,6 +31,10 @@ func resourceGithubProjectColumn() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -40,7 +44,7 @@ func resourceGithubProjectColumn() *schema.Resource {
 }
 
 func resourceGithubProjectColumnCreate(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -56,7 +60,7 @@ func resourceGithubProjectColumnCreate(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return unconvertibleIdErr(projectIDStr, err)
 	}
-	ctx := context.Background()
+	ctx := stopContext(meta)
 
 	orgName := meta.(*Organization).name
 	log.Printf("[DEBUG] Creating project column (%s) in project %d (%s)", options.Name, projectID, orgName)
@@ -69,7 +73,7 @@ func resourceGithubProjectColumnCreate(d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(strconv.FormatInt(*column.ID, 10))
 
-	return resourceGithubProjectColumnRead(d, meta)
+	return untilFound(d, meta, resourceGithubProjectColumnRead)
 }
 
 func resourceGithubProjectColumnRead(d *schema.ResourceData, meta interface{}) error {
@@ -79,15 +83,16 @@ func resourceGithubProjectColumnRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading project column: %s", d.Id())
-	column, _, err := client.Projects.GetProjectColumn(ctx, columnID)
+	column, resp, err := client.Projects.GetProjectColumn(ctx, columnID)
 	if err != nil {
 		if err, ok := err.(*github.ErrorResponse); ok {
+			if err.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
 			if err.Response.StatusCode == http.StatusNotFound {
 				log.Printf("[WARN] Removing project column %s from state because it no longer exists in GitHub", d.Id())
 				d.SetId("")
@@ -100,7 +105,9 @@ func resourceGithubProjectColumnRead(d *schema.ResourceData, meta interface{}) e
 	projectURL := column.GetProjectURL()
 	projectID := strings.TrimPrefix(projectURL, client.BaseURL.String()+`projects/`)
 
+	d.Set("etag", resp.Header.Get("ETag"))
 	d.Set("name", column.GetName())
+	d.Set("node_id", column.GetNodeID())
 	d.Set("project_id", projectID)
 	return nil
 }
@@ -116,7 +123,7 @@ func resourceGithubProjectColumnUpdate(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Updating project column: %s", d.Id())
 	_, _, err = client.Projects.UpdateProjectColumn(ctx, columnID, &options)
@@ -134,7 +141,7 @@ func resourceGithubProjectColumnDelete(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting project column: %s", d.Id())
 	_, err = client.Projects.DeleteProjectColumn(ctx, columnID)
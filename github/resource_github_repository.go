@@ -6,21 +6,26 @@ import (
 	"log"
 	"net/http"
 	"regexp"
+	"strings"
 
 	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
-func resourceGithubRepository() *schema.Resource {
+func resourceGithubRepository(defaults *repositoryDefaults) *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGithubRepositoryCreate,
-		Read:   resourceGithubRepositoryRead,
-		Update: resourceGithubRepositoryUpdate,
-		Delete: resourceGithubRepositoryDelete,
+		Create:        resourceGithubRepositoryCreate,
+		Read:          resourceGithubRepositoryRead,
+		Update:        resourceGithubRepositoryUpdate,
+		Delete:        resourceGithubRepositoryDelete,
+		CustomizeDiff: resourceGithubRepositoryDiff,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				d.Set("auto_init", false)
+				d.Set("allow_visibility_change", false)
+				d.Set("archive_on_destroy", false)
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -40,39 +45,49 @@ func resourceGithubRepository() *schema.Resource {
 				Optional: true,
 			},
 			"private": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: defaults.defaultFunc("private", nil),
+			},
+			"allow_visibility_change": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  false,
 			},
 			"has_issues": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: defaults.defaultFunc("has_issues", nil),
 			},
 			"has_projects": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: defaults.defaultFunc("has_projects", nil),
 			},
 			"has_downloads": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: defaults.defaultFunc("has_downloads", nil),
 			},
 			"has_wiki": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: defaults.defaultFunc("has_wiki", nil),
 			},
 			"allow_merge_commit": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: defaults.defaultFunc("allow_merge_commit", true),
 			},
 			"allow_squash_merge": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: defaults.defaultFunc("allow_squash_merge", true),
 			},
 			"allow_rebase_merge": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: defaults.defaultFunc("allow_rebase_merge", true),
 			},
 			"auto_init": {
 				Type:     schema.TypeBool,
@@ -100,6 +115,67 @@ func resourceGithubRepository() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"archive_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"is_template": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"template": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"owner": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"repository": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"include_all_branches": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+							ForceNew: true,
+						},
+						// The variables are only substituted in the files
+						// of a new repository
+						"files": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vars": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"security_and_analysis": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"advanced_security":               securityAndAnalysisStatusSchema(),
+						"secret_scanning":                 securityAndAnalysisStatusSchema(),
+						"secret_scanning_push_protection": securityAndAnalysisStatusSchema(),
+						"dependabot_security_updates":     securityAndAnalysisStatusSchema(),
+					},
+				},
+			},
 			"topics": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -133,6 +209,14 @@ func resourceGithubRepository() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"repo_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -141,7 +225,7 @@ func resourceGithubRepository() *schema.Resource {
 	}
 }
 
-func resourceGithubRepositoryObject(d *schema.ResourceData) *github.Repository {
+func resourceGithubRepositoryObject(d *schema.ResourceData, meta interface{}) *github.Repository {
 	return &github.Repository{
 		Name:              github.String(d.Get("name").(string)),
 		Description:       github.String(d.Get("description").(string)),
@@ -158,12 +242,29 @@ func resourceGithubRepositoryObject(d *schema.ResourceData) *github.Repository {
 		LicenseTemplate:   github.String(d.Get("license_template").(string)),
 		GitignoreTemplate: github.String(d.Get("gitignore_template").(string)),
 		Archived:          github.Bool(d.Get("archived").(bool)),
-		Topics:            expandStringList(d.Get("topics").(*schema.Set).List()),
+		IsTemplate:        github.Bool(d.Get("is_template").(bool)),
+		Topics:            meta.(*Organization).repositoryDefaults.mergeTopics(expandStringList(d.Get("topics").(*schema.Set).List())),
+	}
+}
+
+// resourceGithubRepositoryDiff refuses to plan making a private repository
+// public unless this was explicitly allowed, since exposing a repository
+// by accident (e.g. through a mistyped variable) cannot be undone.
+func resourceGithubRepositoryDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("private") {
+		return nil
+	}
+
+	o, n := d.GetChange("private")
+	if o.(bool) && !n.(bool) && !d.Get("allow_visibility_change").(bool) {
+		return fmt.Errorf("Changing repository %q from private to public requires `allow_visibility_change` to be true.", d.Id())
 	}
+
+	return nil
 }
 
 func resourceGithubRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -175,29 +276,241 @@ func resourceGithubRepositoryCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	orgName := meta.(*Organization).name
-	repoReq := resourceGithubRepositoryObject(d)
-	ctx := context.Background()
+	repoReq := resourceGithubRepositoryObject(d, meta)
+	ctx := stopContext(meta)
 
-	log.Printf("[DEBUG] Creating repository: %s/%s", orgName, repoReq.GetName())
-	repo, _, err := client.Repositories.Create(ctx, orgName, repoReq)
+	// Repositories of an individual account are created for the authenticated user
+	owner := orgName
+	if meta.(*Organization).individual {
+		owner = ""
+	}
+
+	var repo *github.Repository
+	if vL := d.Get("template").([]interface{}); len(vL) > 0 && vL[0] != nil {
+		repo, err = generateGithubRepository(ctx, meta, owner, repoReq, vL[0].(map[string]interface{}))
+	} else {
+		log.Printf("[DEBUG] Creating repository: %s/%s", orgName, repoReq.GetName())
+		repo, _, err = client.Repositories.Create(ctx, owner, repoReq)
+	}
 	if err != nil {
 		return err
 	}
+	// The repository is recorded before it is configured further, so a
+	// failure leaves it tainted in the state rather than orphaned in GitHub
 	d.SetId(*repo.Name)
 
-	topics := repoReq.Topics
-	if len(topics) > 0 {
-		_, _, err = client.Repositories.ReplaceAllTopics(ctx, orgName, repoReq.GetName(), topics)
+	if vL := d.Get("template").([]interface{}); len(vL) > 0 && vL[0] != nil {
+		err = substituteTemplateFiles(ctx, meta, repo.GetName(), vL[0].(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+
+	return untilFound(d, meta, func(d *schema.ResourceData, meta interface{}) error {
+		topics := repoReq.Topics
+		if len(topics) > 0 {
+			_, _, err := client.Repositories.ReplaceAllTopics(ctx, orgName, repoReq.GetName(), topics)
+			if err != nil {
+				return err
+			}
+		}
+
+		return resourceGithubRepositoryUpdate(d, meta)
+	})
+}
+
+type templateRepositoryRequest struct {
+	Owner              *string `json:"owner,omitempty"`
+	Name               *string `json:"name"`
+	Description        *string `json:"description,omitempty"`
+	Private            *bool   `json:"private,omitempty"`
+	IncludeAllBranches *bool   `json:"include_all_branches,omitempty"`
+}
+
+// generateGithubRepository creates a repository from the template repository
+// of the given template block. The other settings of the repository are
+// applied by the update which follows.
+func generateGithubRepository(ctx context.Context, meta interface{}, owner string, repoReq *github.Repository, template map[string]interface{}) (*github.Repository, error) {
+	client := meta.(*Organization).client
+
+	templateOwner := template["owner"].(string)
+	templateRepo := template["repository"].(string)
+	req := &templateRepositoryRequest{
+		Name:               repoReq.Name,
+		Description:        repoReq.Description,
+		Private:            repoReq.Private,
+		IncludeAllBranches: github.Bool(template["include_all_branches"].(bool)),
+	}
+	if owner != "" {
+		req.Owner = github.String(owner)
+	}
+
+	log.Printf("[DEBUG] Generating repository %s from template: %s/%s", repoReq.GetName(), templateOwner, templateRepo)
+	repo := new(github.Repository)
+	_, err := apiRequest(ctx, client, "POST", fmt.Sprintf("repos/%s/%s/generate", templateOwner, templateRepo), req, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+// substituteTemplateVars replaces the `{{ name }}` placeholders of the given
+// variables in content. Other placeholders, e.g. the `${{ }}` expressions of
+// workflows, are left as they are.
+func substituteTemplateVars(content string, vars map[string]interface{}) string {
+	for name, value := range vars {
+		placeholder := regexp.MustCompile(`\{\{\s*` + regexp.QuoteMeta(name) + `\s*\}\}`)
+		content = placeholder.ReplaceAllLiteralString(content, value.(string))
+	}
+
+	return content
+}
+
+// substituteTemplateFiles substitutes the vars of the template block in its
+// files of the generated repository, committing each file which changes.
+func substituteTemplateFiles(ctx context.Context, meta interface{}, repoName string, template map[string]interface{}) error {
+	client := meta.(*Organization).client
+
+	orgName := meta.(*Organization).name
+	vars := template["vars"].(map[string]interface{})
+	if len(vars) == 0 {
+		return nil
+	}
+
+	for _, path := range expandStringList(template["files"].(*schema.Set).List()) {
+		// GitHub copies the content of the template after answering, so the
+		// files take a moment to appear
+		var file *github.RepositoryContent
+		err := resource.Retry(timeLeft(ctx), func() *resource.RetryError {
+			var err error
+			file, _, _, err = client.Repositories.GetContents(ctx, orgName, repoName, path, nil)
+			if err != nil {
+				if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if file == nil {
+			return fmt.Errorf("%s in %s/%s is not a file", path, orgName, repoName)
+		}
+
+		content, err := file.GetContent()
+		if err != nil {
+			return err
+		}
+		substituted := substituteTemplateVars(content, vars)
+		if substituted == content {
+			continue
+		}
+
+		log.Printf("[DEBUG] Substituting template variables: %s/%s/%s", orgName, repoName, path)
+		_, _, err = client.Repositories.UpdateFile(ctx, orgName, repoName, path, &github.RepositoryContentFileOptions{
+			Message: github.String("Substitute template variables"),
+			Content: []byte(substituted),
+			SHA:     file.SHA,
+		})
 		if err != nil {
 			return err
 		}
 	}
 
-	return resourceGithubRepositoryUpdate(d, meta)
+	return nil
+}
+
+func securityAndAnalysisStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"status": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateValueFunc([]string{"enabled", "disabled"}),
+				},
+			},
+		},
+	}
+}
+
+// securityAndAnalysisFeatures maps the blocks of security_and_analysis to
+// their settings, which are only sent to GitHub when they are configured.
+func securityAndAnalysisFeatures(s *securityAndAnalysis) map[string]**securityAndAnalysisStatus {
+	return map[string]**securityAndAnalysisStatus{
+		"advanced_security":               &s.AdvancedSecurity,
+		"secret_scanning":                 &s.SecretScanning,
+		"secret_scanning_push_protection": &s.SecretScanningPushProtection,
+		"dependabot_security_updates":     &s.DependabotSecurityUpdates,
+	}
+}
+
+func expandSecurityAndAnalysis(v []interface{}) *securityAndAnalysis {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	settings := &securityAndAnalysis{}
+	m := v[0].(map[string]interface{})
+	for feature, status := range securityAndAnalysisFeatures(settings) {
+		if fL, ok := m[feature].([]interface{}); ok && len(fL) > 0 && fL[0] != nil {
+			*status = &securityAndAnalysisStatus{Status: github.String(fL[0].(map[string]interface{})["status"].(string))}
+		}
+	}
+	return settings
+}
+
+// flattenSecurityAndAnalysis only keeps the configured settings, since GitHub
+// reports some of them (e.g. advanced_security of a public repository) even
+// though they cannot be changed.
+func flattenSecurityAndAnalysis(settings, configured *securityAndAnalysis) []interface{} {
+	if settings == nil {
+		settings = &securityAndAnalysis{}
+	}
+
+	m := map[string]interface{}{}
+	current := securityAndAnalysisFeatures(settings)
+	for feature, status := range securityAndAnalysisFeatures(configured) {
+		if *status == nil {
+			continue
+		}
+		if s := *current[feature]; s != nil && s.Status != nil {
+			m[feature] = []interface{}{map[string]interface{}{"status": *s.Status}}
+		}
+	}
+	return []interface{}{m}
+}
+
+// readRepositorySecurityAndAnalysis reads the security and analysis settings,
+// which the vendored client drops when it decodes a repository. They are only
+// returned to admins of the repository, so they are only read when configured.
+func readRepositorySecurityAndAnalysis(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	configured := expandSecurityAndAnalysis(d.Get("security_and_analysis").([]interface{}))
+	if configured == nil {
+		return nil
+	}
+
+	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
+
+	log.Printf("[DEBUG] Reading security and analysis settings of repository: %s/%s", orgName, d.Id())
+	repo := new(repositorySecurityAndAnalysis)
+	_, err := apiRequest(ctx, client, "GET", fmt.Sprintf("repos/%s/%s", orgName, d.Id()), nil, repo)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("security_and_analysis", flattenSecurityAndAnalysis(repo.SecurityAndAnalysis, configured))
 }
 
 func resourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -208,16 +521,13 @@ func resourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[DEBUG] Reading repository: %s/%s", orgName, repoName)
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
-	repo, resp, err := client.Repositories.Get(ctx, orgName, repoName)
+	repo, resp, err := client.Repositories.Get(withEtag(ctx, d, meta), orgName, repoName)
 	if err != nil {
 		if ghErr, ok := err.(*github.ErrorResponse); ok {
 			if ghErr.Response.StatusCode == http.StatusNotModified {
-				return nil
+				return readRepositorySecurityAndAnalysis(ctx, d, meta)
 			}
 			if ghErr.Response.StatusCode == http.StatusNotFound {
 				log.Printf("[WARN] Removing repository %s/%s from state because it no longer exists in GitHub",
@@ -228,9 +538,19 @@ func resourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) erro
 		}
 		return err
 	}
+	// GitHub redirects to the new location of a transferred repository,
+	// which is managed by github_repository_transfer
+	if newOwner := repo.GetOwner().GetLogin(); newOwner != "" && !strings.EqualFold(newOwner, orgName) {
+		log.Printf("[WARN] Removing repository %s/%s from state because it was transferred to %s",
+			orgName, repoName, newOwner)
+		d.SetId("")
+		return nil
+	}
 
 	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("node_id", repo.GetNodeID())
 	d.Set("name", repoName)
+	d.Set("repo_id", repo.GetID())
 	d.Set("description", repo.Description)
 	d.Set("homepage_url", repo.Homepage)
 	d.Set("private", repo.Private)
@@ -249,19 +569,22 @@ func resourceGithubRepositoryRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("git_clone_url", repo.GitURL)
 	d.Set("http_clone_url", repo.CloneURL)
 	d.Set("archived", repo.Archived)
-	d.Set("topics", flattenStringList(repo.Topics))
-	return nil
+	d.Set("is_template", repo.GetIsTemplate())
+	configuredTopics := expandStringList(d.Get("topics").(*schema.Set).List())
+	d.Set("topics", flattenStringList(meta.(*Organization).repositoryDefaults.stripTopics(repo.Topics, configuredTopics)))
+
+	return readRepositorySecurityAndAnalysis(ctx, d, meta)
 }
 
 func resourceGithubRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
 
 	client := meta.(*Organization).client
 
-	repoReq := resourceGithubRepositoryObject(d)
+	repoReq := resourceGithubRepositoryObject(d, meta)
 	// Can only set `default_branch` on an already created repository with the target branches ref already in-place
 	if v, ok := d.GetOk("default_branch"); ok {
 		branch := v.(string)
@@ -273,13 +596,28 @@ func resourceGithubRepositoryUpdate(d *schema.ResourceData, meta interface{}) er
 
 	repoName := d.Id()
 	orgName := meta.(*Organization).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// An archived repository is read-only, so it is unarchived before and
+	// archived after its other settings are changed
+	archived := d.Get("archived").(bool)
+	repoReq.Archived = nil
+	if d.HasChange("archived") && !archived {
+		log.Printf("[DEBUG] Unarchiving repository: %s/%s", orgName, repoName)
+		_, _, err = client.Repositories.Edit(ctx, orgName, repoName, &github.Repository{Archived: github.Bool(false)})
+		if err != nil {
+			return err
+		}
+	}
 
 	log.Printf("[DEBUG] Updating repository: %s/%s", orgName, repoName)
 	repo, _, err := client.Repositories.Edit(ctx, orgName, repoName, repoReq)
 	if err != nil {
 		return err
 	}
+	if repo.GetName() != repoName {
+		forgetRepositoryID(meta, repoName)
+	}
 	d.SetId(*repo.Name)
 
 	if d.HasChange("topics") {
@@ -290,11 +628,36 @@ func resourceGithubRepositoryUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.HasChange("security_and_analysis") {
+		if settings := expandSecurityAndAnalysis(d.Get("security_and_analysis").([]interface{})); settings != nil {
+			log.Printf("[DEBUG] Updating security and analysis settings of repository: %s/%s", orgName, *repo.Name)
+			_, err = apiRequest(ctx, client, "PATCH", fmt.Sprintf("repos/%s/%s", orgName, *repo.Name),
+				&repositorySecurityAndAnalysis{SecurityAndAnalysis: settings}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("archived") && archived {
+		log.Printf("[DEBUG] Archiving repository: %s/%s", orgName, *repo.Name)
+		_, _, err = client.Repositories.Edit(ctx, orgName, *repo.Name, &github.Repository{Archived: github.Bool(true)})
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceGithubRepositoryRead(d, meta)
 }
 
+// repositoryDestroyed tells whether deleting d destroys the repository rather
+// than archiving it.
+func repositoryDestroyed(d *schema.ResourceData) bool {
+	return !d.Get("archive_on_destroy").(bool)
+}
+
 func resourceGithubRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -302,10 +665,24 @@ func resourceGithubRepositoryDelete(d *schema.ResourceData, meta interface{}) er
 	client := meta.(*Organization).client
 	repoName := d.Id()
 	orgName := meta.(*Organization).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	if d.Get("archive_on_destroy").(bool) {
+		if d.Get("archived").(bool) {
+			log.Printf("[DEBUG] Leaving archived repository %s/%s in place", orgName, repoName)
+			return nil
+		}
+		log.Printf("[DEBUG] Archiving repository instead of deleting it: %s/%s", orgName, repoName)
+		_, _, err = client.Repositories.Edit(ctx, orgName, repoName, &github.Repository{Archived: github.Bool(true)})
+		return err
+	}
 
 	log.Printf("[DEBUG] Deleting repository: %s/%s", orgName, repoName)
 	_, err = client.Repositories.Delete(ctx, orgName, repoName)
+	if err != nil {
+		return err
+	}
+	forgetRepositoryID(meta, repoName)
 
-	return err
+	return nil
 }
@@ -0,0 +1,257 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubRepositoryCheckRun() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryCheckRunCreate,
+		Read:   resourceGithubRepositoryCheckRunRead,
+		Update: resourceGithubRepositoryCheckRunUpdate,
+		Delete: resourceGithubRepositoryCheckRunDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"head_sha": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "completed",
+				ValidateFunc: validateValueFunc([]string{"queued", "in_progress", "completed"}),
+			},
+			"conclusion": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validateValueFunc([]string{
+					"success", "failure", "neutral", "cancelled", "skipped", "timed_out", "action_required",
+				}),
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"summary": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"text": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"details_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"external_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"check_run_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// expandCheckRunOutput returns the output of the check run, which GitHub only
+// accepts with both a title and a summary.
+func expandCheckRunOutput(d *schema.ResourceData) (*github.CheckRunOutput, error) {
+	title := d.Get("title").(string)
+	summary := d.Get("summary").(string)
+	text := d.Get("text").(string)
+	if title == "" && summary == "" && text == "" {
+		return nil, nil
+	}
+	if title == "" || summary == "" {
+		return nil, fmt.Errorf("Both title and summary must be set to publish the output of a check run")
+	}
+
+	output := &github.CheckRunOutput{
+		Title:   github.String(title),
+		Summary: github.String(summary),
+	}
+	if text != "" {
+		output.Text = github.String(text)
+	}
+	return output, nil
+}
+
+func expandCheckRunConclusion(d *schema.ResourceData) (*string, error) {
+	conclusion := d.Get("conclusion").(string)
+	if d.Get("status").(string) != "completed" {
+		if conclusion != "" {
+			return nil, fmt.Errorf("A conclusion can only be set on a completed check run")
+		}
+		return nil, nil
+	}
+	if conclusion == "" {
+		return nil, fmt.Errorf("A completed check run requires a conclusion")
+	}
+	return github.String(conclusion), nil
+}
+
+func optionalString(d *schema.ResourceData, key string) *string {
+	if v, ok := d.GetOk(key); ok {
+		return github.String(v.(string))
+	}
+	return nil
+}
+
+func resourceGithubRepositoryCheckRunCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	output, err := expandCheckRunOutput(d)
+	if err != nil {
+		return err
+	}
+	conclusion, err := expandCheckRunConclusion(d)
+	if err != nil {
+		return err
+	}
+
+	// Check runs can only be created with the token of a GitHub App
+	log.Printf("[DEBUG] Creating check run %s: %s/%s@%s", d.Get("name").(string), owner, repoName, d.Get("head_sha").(string))
+	run, _, err := client.Checks.CreateCheckRun(ctx, owner, repoName, github.CreateCheckRunOptions{
+		Name:       d.Get("name").(string),
+		HeadSHA:    d.Get("head_sha").(string),
+		Status:     github.String(d.Get("status").(string)),
+		Conclusion: conclusion,
+		DetailsURL: optionalString(d, "details_url"),
+		ExternalID: optionalString(d, "external_id"),
+		Output:     output,
+	})
+	if err != nil {
+		return err
+	}
+
+	id := strconv.FormatInt(run.GetID(), 10)
+	d.SetId(buildTwoPartID(&repoName, &id))
+
+	return untilFound(d, meta, resourceGithubRepositoryCheckRunRead)
+}
+
+func resourceGithubRepositoryCheckRunRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, idString, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	id, err := strconv.ParseInt(idString, 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(idString, err)
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading check run: %s/%s (%d)", owner, repoName, id)
+	run, resp, err := client.Checks.GetCheckRun(ctx, owner, repoName, id)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing check run %s/%s (%d) from state because it no longer exists in GitHub",
+					owner, repoName, id)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("repository", repoName)
+	d.Set("head_sha", run.GetHeadSHA())
+	d.Set("name", run.GetName())
+	d.Set("status", run.GetStatus())
+	d.Set("conclusion", run.GetConclusion())
+	d.Set("title", run.GetOutput().GetTitle())
+	d.Set("summary", run.GetOutput().GetSummary())
+	d.Set("text", run.GetOutput().GetText())
+	d.Set("details_url", run.GetDetailsURL())
+	d.Set("external_id", run.GetExternalID())
+	d.Set("check_run_id", run.GetID())
+	d.Set("html_url", run.GetHTMLURL())
+
+	return nil
+}
+
+func resourceGithubRepositoryCheckRunUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	id := int64(d.Get("check_run_id").(int))
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	output, err := expandCheckRunOutput(d)
+	if err != nil {
+		return err
+	}
+	conclusion, err := expandCheckRunConclusion(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating check run: %s/%s (%d)", owner, repoName, id)
+	_, _, err = client.Checks.UpdateCheckRun(ctx, owner, repoName, id, github.UpdateCheckRunOptions{
+		Name:       d.Get("name").(string),
+		Status:     github.String(d.Get("status").(string)),
+		Conclusion: conclusion,
+		DetailsURL: optionalString(d, "details_url"),
+		ExternalID: optionalString(d, "external_id"),
+		Output:     output,
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryCheckRunRead(d, meta)
+}
+
+func resourceGithubRepositoryCheckRunDelete(d *schema.ResourceData, meta interface{}) error {
+	// There is no API to delete a check run
+	log.Printf("[DEBUG] Removing check run %s from state; it remains on its commit in GitHub", d.Id())
+	d.SetId("")
+
+	return nil
+}
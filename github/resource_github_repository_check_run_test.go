@@ -0,0 +1,76 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccGithubRepositoryCheckRun_basic(t *testing.T) {
+	// Check runs can only be created with the token of a GitHub App
+	appToken := os.Getenv("GITHUB_TEST_APP_TOKEN")
+	if appToken == "" {
+		t.Skip("GITHUB_TEST_APP_TOKEN must be set to an installation token for this acceptance test")
+	}
+	rn := "github_repository_check_run.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-check-%s", rs)
+
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryCheckRunConfig(appToken, repoName, "in_progress", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "status", "in_progress"),
+					resource.TestCheckResourceAttrSet(rn, "check_run_id"),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryCheckRunConfig(appToken, repoName, "completed", "success"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "status", "completed"),
+					resource.TestCheckResourceAttr(rn, "conclusion", "success"),
+					resource.TestCheckResourceAttr(rn, "summary", "All policies passed."),
+				),
+			},
+		},
+	})
+}
+
+func testAccGithubRepositoryCheckRunConfig(appToken, repoName, status, conclusion string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+data "github_branch" "test" {
+  repository = "${github_repository.test.name}"
+  branch     = "master"
+}
+
+provider "github" {
+  alias = "app"
+  token = "%s"
+}
+
+resource "github_repository_check_run" "test" {
+  provider   = "github.app"
+  repository = "${github_repository.test.name}"
+  head_sha   = "${data.github_branch.test.sha}"
+  name       = "policy"
+  status     = "%s"
+  conclusion = "%s"
+  title      = "Policy check"
+  summary    = "All policies passed."
+}
+`, appToken, repoName, status, conclusion)
+}
@@ -18,6 +18,7 @@ func resourceGithubRepositoryCollaborator() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceGithubRepositoryCollaboratorDiff,
 
 		// editing repository collaborators are not supported by github api so forcing new on any changes
 		Schema: map[string]*schema.Schema{
@@ -25,30 +26,34 @@ func resourceGithubRepositoryCollaborator() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: caseInsensitive(),
+				DiffSuppressFunc: suppressRenamedUsername(),
 			},
 			"repository": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			// A built-in or custom repository role
 			"permission": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Default:      "push",
-				ValidateFunc: validateValueFunc([]string{"pull", "push", "admin"}),
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "push",
 			},
 			"invitation_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"user_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func resourceGithubRepositoryCollaboratorCreate(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -58,7 +63,7 @@ func resourceGithubRepositoryCollaboratorCreate(d *schema.ResourceData, meta int
 	orgName := meta.(*Organization).name
 	username := d.Get("username").(string)
 	repoName := d.Get("repository").(string)
-	ctx := context.Background()
+	ctx := stopContext(meta)
 
 	log.Printf("[DEBUG] Creating repository collaborator: %s (%s/%s)",
 		username, orgName, repoName)
@@ -76,11 +81,11 @@ func resourceGithubRepositoryCollaboratorCreate(d *schema.ResourceData, meta int
 
 	d.SetId(buildTwoPartID(&repoName, &username))
 
-	return resourceGithubRepositoryCollaboratorRead(d, meta)
+	return untilFound(d, meta, resourceGithubRepositoryCollaboratorRead)
 }
 
 func resourceGithubRepositoryCollaboratorRead(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -92,7 +97,7 @@ func resourceGithubRepositoryCollaboratorRead(d *schema.ResourceData, meta inter
 	if err != nil {
 		return err
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	// First, check if the user has been invited but has not yet accepted
 	invitation, err := findRepoInvitation(client, ctx, orgName, repoName, username)
@@ -108,35 +113,43 @@ func resourceGithubRepositoryCollaboratorRead(d *schema.ResourceData, meta inter
 		}
 
 		d.Set("repository", repoName)
-		d.Set("username", username)
+		d.Set("user_id", invitation.GetInvitee().GetID())
+		setUsername(d, username)
 		d.Set("permission", permissionName)
 		d.Set("invitation_id", fmt.Sprintf("%d", invitation.GetID()))
 		return nil
 	}
 
-	// Next, check if the user has accepted the invite and is a full collaborator
-	opt := &github.ListCollaboratorsOptions{ListOptions: github.ListOptions{
-		PerPage: maxPerPage,
-	}}
-
+	// Next, check if the user has accepted the invite and is a full collaborator,
+	// which is matched by ID as well in case the user has been renamed since
+	userID := int64(d.Get("user_id").(int))
+	page := 1
 	for {
-		collaborators, resp, err := client.Repositories.ListCollaborators(ctx,
-			orgName, repoName, opt)
+		collaborators, resp, err := listCollaboratorUsers(ctx, client, orgName, repoName, "all",
+			github.ListOptions{Page: page, PerPage: perPage(meta)})
 		if err != nil {
 			return err
 		}
 		log.Printf("[DEBUG] Found %d collaborators, checking if any matches %q", len(collaborators), username)
 
 		for _, c := range collaborators {
-			if strings.EqualFold(*c.Login, username) {
+			if strings.EqualFold(c.GetLogin(), username) || (userID != 0 && c.GetID() == userID) {
 				log.Printf("[DEBUG] Matching collaborator found for %q", username)
-				permissionName, err := getRepoPermission(c.Permissions)
+				permissionName, err := c.permission()
 				if err != nil {
 					return err
 				}
 
+				if !strings.EqualFold(c.GetLogin(), username) {
+					log.Printf("[INFO] User %d was renamed from %s to %s", userID, username, c.GetLogin())
+					if err := renameUserID(d, c.GetLogin()); err != nil {
+						return err
+					}
+				}
+
 				d.Set("repository", repoName)
-				d.Set("username", c.Login)
+				d.Set("user_id", c.GetID())
+				setUsername(d, c.GetLogin())
 				d.Set("permission", permissionName)
 				return nil
 			}
@@ -145,7 +158,7 @@ func resourceGithubRepositoryCollaboratorRead(d *schema.ResourceData, meta inter
 		if resp.NextPage == 0 {
 			break
 		}
-		opt.Page = resp.NextPage
+		page = resp.NextPage
 	}
 
 	// The user is neither invited nor a collaborator
@@ -157,7 +170,7 @@ func resourceGithubRepositoryCollaboratorRead(d *schema.ResourceData, meta inter
 }
 
 func resourceGithubRepositoryCollaboratorDelete(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -165,10 +178,13 @@ func resourceGithubRepositoryCollaboratorDelete(d *schema.ResourceData, meta int
 	client := meta.(*Organization).client
 
 	orgName := meta.(*Organization).name
-	username := d.Get("username").(string)
+	username, err := userLoginFromID(d)
+	if err != nil {
+		return err
+	}
 	repoName := d.Get("repository").(string)
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	// Delete any pending invitations
 	invitation, err := findRepoInvitation(client, ctx, orgName, repoName, username)
@@ -185,6 +201,39 @@ func resourceGithubRepositoryCollaboratorDelete(d *schema.ResourceData, meta int
 	return err
 }
 
+func resourceGithubRepositoryCollaboratorDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// Permissions only known when applying are validated by GitHub then
+	if !d.HasChange("permission") || !d.NewValueKnown("permission") {
+		return nil
+	}
+
+	return validateRepoPermission(stopContext(meta), meta, d.Get("permission").(string))
+}
+
+// collaboratorUser is a collaborator of a repository along with the name of
+// its role, which the vendored library does not expose yet.
+type collaboratorUser struct {
+	github.User
+	RoleName *string `json:"role_name,omitempty"`
+}
+
+// permission returns the role of the collaborator, which may be a custom
+// role, falling back to the built-in roles its permissions amount to.
+func (u *collaboratorUser) permission() (string, error) {
+	if u.RoleName != nil && *u.RoleName != "" {
+		return repoPermissionFromRoleName(*u.RoleName), nil
+	}
+	return getRepoPermission(u.Permissions)
+}
+
+func listCollaboratorUsers(ctx context.Context, client *github.Client, owner, repoName, affiliation string, opt github.ListOptions) ([]*collaboratorUser, *github.Response, error) {
+	var users []*collaboratorUser
+	resp, err := apiRequest(ctx, client, "GET",
+		fmt.Sprintf("repos/%s/%s/collaborators?affiliation=%s&per_page=%d&page=%d",
+			owner, repoName, affiliation, opt.PerPage, opt.Page), nil, &users)
+	return users, resp, err
+}
+
 func findRepoInvitation(client *github.Client, ctx context.Context, owner, repo, collaborator string) (*github.RepositoryInvitation, error) {
 	opt := &github.ListOptions{PerPage: maxPerPage}
 	for {
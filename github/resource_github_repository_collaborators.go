@@ -0,0 +1,447 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/configs/hcl2shim"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// repositoryCollaborator is a user with access to a repository, or invited
+// to have access to it.
+type repositoryCollaborator struct {
+	username     string
+	permission   string
+	invitationID int64
+}
+
+// repositoryCollaboratorTeam is a team with access to a repository.
+type repositoryCollaboratorTeam struct {
+	id         int64
+	slug       string
+	permission string
+}
+
+func resourceGithubRepositoryCollaborators() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryCollaboratorsCreate,
+		Read:   resourceGithubRepositoryCollaboratorsRead,
+		Update: resourceGithubRepositoryCollaboratorsUpdate,
+		Delete: resourceGithubRepositoryCollaboratorsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceGithubRepositoryCollaboratorsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// A built-in or custom repository role
+						"permission": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "push",
+						},
+					},
+				},
+			},
+			"team": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Either the numeric ID or the slug of the team
+						"team_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// A built-in or custom repository role
+						"permission": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "push",
+						},
+					},
+				},
+			},
+			"invitation_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// listRepositoryCollaborators returns the direct collaborators of a
+// repository and the users invited to become one, keyed by their lowercased
+// login.
+func listRepositoryCollaborators(ctx context.Context, meta interface{}, owner, repoName string) (map[string]*repositoryCollaborator, error) {
+	client := meta.(*Organization).client
+	collaborators := map[string]*repositoryCollaborator{}
+
+	invitations, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		return client.Repositories.ListInvitations(ctx, owner, repoName, &opt.ListOptions)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range invitations {
+		i := v.(*github.RepositoryInvitation)
+		permission, err := getInvitationPermission(i)
+		if err != nil {
+			return nil, err
+		}
+		login := i.GetInvitee().GetLogin()
+		collaborators[strings.ToLower(login)] = &repositoryCollaborator{
+			username:     login,
+			permission:   permission,
+			invitationID: i.GetID(),
+		}
+	}
+
+	users, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		return listCollaboratorUsers(ctx, client, owner, repoName, "direct", opt.ListOptions)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range users {
+		u := v.(*collaboratorUser)
+		// The owner of a repository of an individual account is listed
+		// too, but cannot be removed
+		if strings.EqualFold(u.GetLogin(), owner) {
+			continue
+		}
+
+		permission, err := u.permission()
+		if err != nil {
+			return nil, err
+		}
+		collaborators[strings.ToLower(u.GetLogin())] = &repositoryCollaborator{
+			username:   u.GetLogin(),
+			permission: permission,
+		}
+	}
+
+	return collaborators, nil
+}
+
+// listRepositoryCollaboratorTeams returns the teams with access to a
+// repository, keyed by their ID.
+func listRepositoryCollaboratorTeams(ctx context.Context, meta interface{}, repoName string) (map[int64]*repositoryCollaboratorTeam, error) {
+	teams := map[int64]*repositoryCollaboratorTeam{}
+
+	// Repositories of individual accounts cannot be accessed by teams
+	if meta.(*Organization).individual {
+		return teams, nil
+	}
+
+	client := meta.(*Organization).client
+	owner := meta.(*Organization).name
+	result, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		return client.Repositories.ListTeams(ctx, owner, repoName, &opt.ListOptions)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The list only tells the base role of custom roles, which the route of
+	// each team and the repository names
+	list := make([]*repositoryCollaboratorTeam, len(result))
+	err = forEachConcurrently(concurrentRequests(meta), len(result), func(i int) error {
+		t := result[i].(*github.Team)
+		repo := new(teamRepository)
+		_, err := teamRequest(ctx, meta, "GET", t.GetID(), fmt.Sprintf("/repos/%s/%s", owner, repoName),
+			teamRepositoryMediaType, nil, repo)
+		if err != nil {
+			return err
+		}
+
+		permission, err := repo.permission()
+		if err != nil {
+			return err
+		}
+		list[i] = &repositoryCollaboratorTeam{
+			id:         t.GetID(),
+			slug:       t.GetSlug(),
+			permission: permission,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range list {
+		teams[t.id] = t
+	}
+
+	return teams, nil
+}
+
+// invitationPermission returns the name an invitation uses for a permission.
+func invitationPermission(permission string) string {
+	switch permission {
+	case pullPermission:
+		return readPermission
+	case pushPermission:
+		return writePermission
+	}
+	return permission
+}
+
+func resourceGithubRepositoryCollaboratorsDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("user") && !d.HasChange("team") {
+		return nil
+	}
+
+	// Each custom role is only looked up once
+	ctx := stopContext(meta)
+	validated := map[string]bool{}
+	for _, key := range []string{"user", "team"} {
+		// Permissions only known when applying are validated by GitHub then
+		if !d.NewValueKnown(key) {
+			continue
+		}
+		for _, v := range d.Get(key).(*schema.Set).List() {
+			permission := v.(map[string]interface{})["permission"].(string)
+			if permission == hcl2shim.UnknownVariableValue || validated[permission] {
+				continue
+			}
+			if err := validateRepoPermission(ctx, meta, permission); err != nil {
+				return err
+			}
+			validated[permission] = true
+		}
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryCollaboratorsCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("repository").(string))
+
+	return untilFound(d, meta, resourceGithubRepositoryCollaboratorsUpdate)
+}
+
+func resourceGithubRepositoryCollaboratorsRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading repository collaborators: %s/%s", owner, repoName)
+	collaborators, err := listRepositoryCollaborators(ctx, meta, owner, repoName)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing repository collaborators %s/%s from state because the repository no longer exists in GitHub",
+				owner, repoName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	teams, err := listRepositoryCollaboratorTeams(ctx, meta, repoName)
+	if err != nil {
+		return err
+	}
+
+	// Keep the configured spelling of logins and team slugs
+	configuredUsers := map[string]string{}
+	for _, u := range d.Get("user").(*schema.Set).List() {
+		username := u.(map[string]interface{})["username"].(string)
+		configuredUsers[strings.ToLower(username)] = username
+	}
+	configuredTeams := map[string]bool{}
+	for _, t := range d.Get("team").(*schema.Set).List() {
+		configuredTeams[t.(map[string]interface{})["team_id"].(string)] = true
+	}
+
+	users := []interface{}{}
+	invitationIDs := map[string]interface{}{}
+	for key, c := range collaborators {
+		username := c.username
+		if configured, ok := configuredUsers[key]; ok {
+			username = configured
+		}
+		users = append(users, map[string]interface{}{
+			"username":   username,
+			"permission": c.permission,
+		})
+		if c.invitationID != 0 {
+			invitationIDs[username] = strconv.FormatInt(c.invitationID, 10)
+		}
+	}
+
+	teamList := []interface{}{}
+	for _, t := range teams {
+		teamID := strconv.FormatInt(t.id, 10)
+		if configuredTeams[t.slug] {
+			teamID = t.slug
+		}
+		teamList = append(teamList, map[string]interface{}{
+			"team_id":    teamID,
+			"permission": t.permission,
+		})
+	}
+
+	d.Set("repository", repoName)
+	d.Set("user", users)
+	d.Set("team", teamList)
+	d.Set("invitation_ids", invitationIDs)
+
+	return nil
+}
+
+func resourceGithubRepositoryCollaboratorsUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	current, err := listRepositoryCollaborators(ctx, meta, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	desired := map[string]*repositoryCollaborator{}
+	for _, u := range d.Get("user").(*schema.Set).List() {
+		m := u.(map[string]interface{})
+		username := m["username"].(string)
+		desired[strings.ToLower(username)] = &repositoryCollaborator{
+			username:   username,
+			permission: m["permission"].(string),
+		}
+	}
+
+	for key, c := range current {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+
+		if c.invitationID != 0 {
+			log.Printf("[DEBUG] Deleting invitation of repository collaborator: %s (%s/%s)", c.username, owner, repoName)
+			_, err = client.Repositories.DeleteInvitation(ctx, owner, repoName, c.invitationID)
+		} else {
+			log.Printf("[DEBUG] Deleting repository collaborator: %s (%s/%s)", c.username, owner, repoName)
+			_, err = client.Repositories.RemoveCollaborator(ctx, owner, repoName, c.username)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for key, c := range desired {
+		existing, ok := current[key]
+		if ok && existing.permission == c.permission {
+			continue
+		}
+
+		// The permission of a pending invitation is changed on the invitation
+		if ok && existing.invitationID != 0 {
+			log.Printf("[DEBUG] Updating invitation of repository collaborator: %s:%s (%s/%s)",
+				c.username, c.permission, owner, repoName)
+			_, _, err = client.Repositories.UpdateInvitation(ctx, owner, repoName, existing.invitationID,
+				invitationPermission(c.permission))
+		} else {
+			log.Printf("[DEBUG] Adding repository collaborator: %s:%s (%s/%s)", c.username, c.permission, owner, repoName)
+			_, err = client.Repositories.AddCollaborator(ctx, owner, repoName, c.username,
+				&github.RepositoryAddCollaboratorOptions{
+					Permission: c.permission,
+				})
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	currentTeams, err := listRepositoryCollaboratorTeams(ctx, meta, repoName)
+	if err != nil {
+		return err
+	}
+
+	desiredTeams := map[int64]string{}
+	for _, t := range d.Get("team").(*schema.Set).List() {
+		m := t.(map[string]interface{})
+		teamID, err := getTeamID(ctx, meta, m["team_id"].(string))
+		if err != nil {
+			return err
+		}
+		desiredTeams[teamID] = m["permission"].(string)
+	}
+
+	for teamID := range currentTeams {
+		if _, ok := desiredTeams[teamID]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting team repository association: %d (%s/%s)", teamID, owner, repoName)
+		_, err = teamRequest(ctx, meta, "DELETE", teamID, fmt.Sprintf("/repos/%s/%s", owner, repoName), "", nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	for teamID, permission := range desiredTeams {
+		if existing, ok := currentTeams[teamID]; ok && existing.permission == permission {
+			continue
+		}
+
+		log.Printf("[DEBUG] Adding team repository association: %d:%s (%s/%s)", teamID, permission, owner, repoName)
+		_, err = teamRequest(ctx, meta, "PUT", teamID, fmt.Sprintf("/repos/%s/%s", owner, repoName), "",
+			&github.TeamAddTeamRepoOptions{
+				Permission: permission,
+			}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceGithubRepositoryCollaboratorsRead(d, meta)
+}
+
+func resourceGithubRepositoryCollaboratorsDelete(d *schema.ResourceData, meta interface{}) error {
+	// Removing every user and team leaves the repository to its owners
+	d.Set("user", []interface{}{})
+	d.Set("team", []interface{}{})
+
+	log.Printf("[DEBUG] Deleting repository collaborators: %s", d.Id())
+	err := resourceGithubRepositoryCollaboratorsUpdate(d, meta)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,141 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubRepositoryCollaborators_basic(t *testing.T) {
+	rn := "github_repository_collaborators.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-collabs-%s", rs)
+	teamName := fmt.Sprintf("tf-acc-test-collabs-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryCollaboratorsConfig(repoName, teamName, "push"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "user.#", "1"),
+					resource.TestCheckResourceAttr(rn, "team.#", "1"),
+					// The collaborator has not accepted the invitation yet
+					resource.TestCheckResourceAttrSet(rn, fmt.Sprintf("invitation_ids.%s", testCollaborator)),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryCollaboratorsConfig(repoName, teamName, "pull"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "user.#", "1"),
+					resource.TestCheckResourceAttr(rn, "team.#", "1"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubRepositoryCollaboratorsConfig(repoName, teamName, permission string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_team" "test" {
+  name = "%s"
+}
+
+resource "github_repository_collaborators" "test" {
+  repository = "${github_repository.test.name}"
+
+  user {
+    username   = "%s"
+    permission = "%s"
+  }
+
+  team {
+    team_id    = "${github_team.test.id}"
+    permission = "%s"
+  }
+}
+`, repoName, teamName, testCollaborator, permission, permission)
+}
+
+func TestListRepositoryCollaboratorTeams_customRole(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/repos/example/service/teams?page=1&per_page=100",
+			ResponseBody: `[{"id": 1, "slug": "security", "permission": "push"}]`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/teams/1/repos/example/service",
+			ResponseBody: `{"name": "service", "role_name": "security-reviewer", "permissions": {"pull": true, "push": true}}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+	meta.teamsRoutes.resolved = true
+
+	teams, err := listRepositoryCollaboratorTeams(context.Background(), meta, "service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if team := teams[1]; team == nil || team.slug != "security" || team.permission != "security-reviewer" {
+		t.Fatalf("Expected the custom role of the team, got %#v", team)
+	}
+}
+
+func TestListRepositoryCollaborators_invitationPages(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/example/service/invitations?page=1&per_page=100",
+			ResponseHeaders: map[string]string{
+				"Link": `<https://api.github.com/repos/example/service/invitations?page=2&per_page=100>; rel="next"`,
+			},
+			ResponseBody: `[{"id": 1, "invitee": {"login": "octocat"}, "permissions": "write"}]`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/repos/example/service/invitations?page=2&per_page=100",
+			ResponseBody: `[{"id": 2, "invitee": {"login": "Hubot"}, "permissions": "read"}]`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/repos/example/service/collaborators?affiliation=direct&per_page=100&page=1",
+			ResponseBody: `[]`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	collaborators, err := listRepositoryCollaborators(context.Background(), meta, "example", "service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := collaborators["hubot"]; c == nil || c.username != "Hubot" || c.invitationID != 2 || c.permission != "pull" {
+		t.Fatalf("Expected the invitation of the second page, got %#v", c)
+	}
+	if c := collaborators["octocat"]; c == nil || c.invitationID != 1 || c.permission != "push" {
+		t.Fatalf("Expected the invitation of the first page, got %#v", c)
+	}
+}
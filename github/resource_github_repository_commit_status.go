@@ -0,0 +1,184 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubRepositoryCommitStatus() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryCommitStatusCreateOrUpdate,
+		Read:   resourceGithubRepositoryCommitStatusRead,
+		Update: resourceGithubRepositoryCommitStatusCreateOrUpdate,
+		Delete: resourceGithubRepositoryCommitStatusDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sha": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"context": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "default",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateValueFunc([]string{"error", "failure", "pending", "success"}),
+			},
+			"target_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"creator": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// parseCommitStatusID splits the ID of a commit status, which is made of the
+// repository, the SHA of the commit and the context of the status.
+func parseCommitStatusID(id string) (string, string, string, error) {
+	repoName, rest, err := parseTwoPartID(id)
+	if err != nil {
+		return "", "", "", err
+	}
+	sha, statusContext, err := parseTwoPartID(rest)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return repoName, sha, statusContext, nil
+}
+
+func resourceGithubRepositoryCommitStatusCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	sha := d.Get("sha").(string)
+	statusContext := d.Get("context").(string)
+	ctx := stopContext(meta)
+
+	// Statuses cannot be changed; the latest status of a context supersedes
+	// the earlier ones
+	log.Printf("[DEBUG] Setting commit status %s: %s/%s@%s", statusContext, owner, repoName, sha)
+	_, _, err := client.Repositories.CreateStatus(ctx, owner, repoName, sha, &github.RepoStatus{
+		State:       github.String(d.Get("state").(string)),
+		Context:     github.String(statusContext),
+		TargetURL:   optionalString(d, "target_url"),
+		Description: optionalString(d, "description"),
+	})
+	if err != nil {
+		return err
+	}
+
+	shaContext := buildTwoPartID(&sha, &statusContext)
+	d.SetId(buildTwoPartID(&repoName, &shaContext))
+
+	return untilFound(d, meta, resourceGithubRepositoryCommitStatusRead)
+}
+
+func resourceGithubRepositoryCommitStatusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, sha, statusContext, err := parseCommitStatusID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading commit status %s: %s/%s@%s", statusContext, owner, repoName, sha)
+	var status *github.RepoStatus
+	var etag string
+	opt := &github.ListOptions{PerPage: maxPerPage}
+	for status == nil {
+		combined, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repoName, sha, opt)
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok {
+				if ghErr.Response.StatusCode == http.StatusNotModified {
+					return nil
+				}
+				if ghErr.Response.StatusCode == http.StatusNotFound {
+					log.Printf("[WARN] Removing commit status %s from state because it no longer exists in GitHub",
+						d.Id())
+					d.SetId("")
+					return nil
+				}
+			}
+			return err
+		}
+		if opt.Page == 0 {
+			etag = resp.Header.Get("ETag")
+		}
+
+		// The combined status holds the latest status of each context
+		for i := range combined.Statuses {
+			if combined.Statuses[i].GetContext() == statusContext {
+				status = &combined.Statuses[i]
+				break
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+
+		// Only the first page is requested conditionally
+		ctx = context.WithValue(stopContext(meta), ctxId, d.Id())
+	}
+
+	if status == nil {
+		log.Printf("[WARN] Removing commit status %s from state because it no longer exists in GitHub",
+			d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("etag", etag)
+	d.Set("repository", repoName)
+	d.Set("sha", sha)
+	d.Set("context", statusContext)
+	d.Set("state", status.GetState())
+	d.Set("target_url", status.GetTargetURL())
+	d.Set("description", status.GetDescription())
+	d.Set("creator", status.GetCreator().GetLogin())
+
+	return nil
+}
+
+func resourceGithubRepositoryCommitStatusDelete(d *schema.ResourceData, meta interface{}) error {
+	// There is no API to delete a commit status
+	log.Printf("[DEBUG] Removing commit status %s from state; it remains on its commit in GitHub", d.Id())
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestParseCommitStatusID(t *testing.T) {
+	repoName, sha, statusContext, err := parseCommitStatusID("example:abc123:ci/build:linux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repoName != "example" || sha != "abc123" || statusContext != "ci/build:linux" {
+		t.Fatalf("Unexpected parts of commit status ID: %q, %q, %q", repoName, sha, statusContext)
+	}
+
+	if _, _, _, err := parseCommitStatusID("example:abc123"); err == nil {
+		t.Fatal("Expected an error for an ID without a context")
+	}
+}
+
+func TestAccGithubRepositoryCommitStatus_basic(t *testing.T) {
+	rn := "github_repository_commit_status.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-status-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryCommitStatusConfig(repoName, "pending"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "state", "pending"),
+					resource.TestCheckResourceAttr(rn, "context", "ci/orchestration"),
+					resource.TestCheckResourceAttrSet(rn, "creator"),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryCommitStatusConfig(repoName, "success"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "state", "success"),
+					resource.TestCheckResourceAttr(rn, "target_url", "https://ci.example.com/runs/1"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubRepositoryCommitStatusConfig(repoName, state string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+data "github_branch" "test" {
+  repository = "${github_repository.test.name}"
+  branch     = "master"
+}
+
+resource "github_repository_commit_status" "test" {
+  repository  = "${github_repository.test.name}"
+  sha         = "${data.github_branch.test.sha}"
+  context     = "ci/orchestration"
+  state       = "%s"
+  target_url  = "https://ci.example.com/runs/1"
+  description = "Orchestration run"
+}
+`, repoName, state)
+}
@@ -0,0 +1,314 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// communityFiles maps the attributes of the single health files to their
+// paths; issue templates are kept in communityIssueTemplateDir.
+var communityFiles = map[string]string{
+	"pull_request_template": ".github/PULL_REQUEST_TEMPLATE.md",
+	"support":               ".github/SUPPORT.md",
+	"security":              ".github/SECURITY.md",
+}
+
+const communityIssueTemplateDir = ".github/ISSUE_TEMPLATE"
+
+type communityTreeBlob struct {
+	Path    string `json:"path"`
+	Mode    string `json:"mode"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
+// communityTreeDeletion removes a path from a tree; the SHA must be sent as
+// null, which the vendored go-github TreeEntry cannot do.
+type communityTreeDeletion struct {
+	Path string  `json:"path"`
+	Mode string  `json:"mode"`
+	Type string  `json:"type"`
+	SHA  *string `json:"sha"`
+}
+
+type communityTree struct {
+	BaseTree string        `json:"base_tree"`
+	Tree     []interface{} `json:"tree"`
+}
+
+func resourceGithubRepositoryCommunityFiles() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryCommunityFilesCreate,
+		Read:   resourceGithubRepositoryCommunityFilesRead,
+		Update: resourceGithubRepositoryCommunityFilesUpdate,
+		Delete: resourceGithubRepositoryCommunityFilesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"branch": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"pull_request_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"support": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"security": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"issue_template": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"content": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"commit_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Update community health files",
+			},
+			"commit_sha": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// communityFilesContents returns the content of every file described by the
+// given attribute values, keyed by path; empty files are left out.
+func communityFilesContents(get func(string) interface{}) map[string]string {
+	files := map[string]string{}
+	for k, path := range communityFiles {
+		if content := get(k).(string); content != "" {
+			files[path] = content
+		}
+	}
+	for _, t := range get("issue_template").([]interface{}) {
+		tmpl := t.(map[string]interface{})
+		files[communityIssueTemplatePath(tmpl["name"].(string))] = tmpl["content"].(string)
+	}
+	return files
+}
+
+func communityIssueTemplatePath(name string) string {
+	return fmt.Sprintf("%s/%s.md", communityIssueTemplateDir, name)
+}
+
+func resourceGithubRepositoryCommunityFilesCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	if _, ok := d.GetOk("branch"); !ok {
+		repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+		if err != nil {
+			return err
+		}
+		d.Set("branch", repo.GetDefaultBranch())
+	}
+
+	// Existing files are overwritten rather than reported as conflicts
+	err := commitCommunityFiles(ctx, d, meta, map[string]string{}, communityFilesContents(d.Get))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+
+	return untilFound(d, meta, resourceGithubRepositoryCommunityFilesRead)
+}
+
+func resourceGithubRepositoryCommunityFilesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	branch := d.Get("branch").(string)
+	if branch == "" {
+		repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing community files of %s/%s from state because the repository no longer exists in GitHub",
+					owner, repoName)
+				d.SetId("")
+				return nil
+			}
+			return err
+		}
+		branch = repo.GetDefaultBranch()
+	}
+
+	log.Printf("[DEBUG] Reading community files: %s/%s (%s)", owner, repoName, branch)
+	ref, _, err := client.Git.GetRef(ctx, owner, repoName, "heads/"+branch)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing community files of %s/%s from state because branch %s no longer exists in GitHub",
+				owner, repoName, branch)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Each file is read separately so that drift shows up on its attribute
+	for k, path := range communityFiles {
+		content, err := readCommunityFile(ctx, client, owner, repoName, branch, path)
+		if err != nil {
+			return err
+		}
+		d.Set(k, content)
+	}
+
+	templates := make([]interface{}, 0)
+	for _, t := range d.Get("issue_template").([]interface{}) {
+		name := t.(map[string]interface{})["name"].(string)
+		content, err := readCommunityFile(ctx, client, owner, repoName, branch, communityIssueTemplatePath(name))
+		if err != nil {
+			return err
+		}
+		if content == "" {
+			continue
+		}
+		templates = append(templates, map[string]interface{}{
+			"name":    name,
+			"content": content,
+		})
+	}
+
+	d.Set("repository", repoName)
+	d.Set("branch", branch)
+	d.Set("issue_template", templates)
+	d.Set("commit_sha", ref.GetObject().GetSHA())
+
+	return nil
+}
+
+func resourceGithubRepositoryCommunityFilesUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	o, n := map[string]interface{}{}, map[string]interface{}{}
+	for _, k := range []string{"pull_request_template", "support", "security", "issue_template"} {
+		o[k], n[k] = d.GetChange(k)
+	}
+	current := communityFilesContents(func(k string) interface{} { return o[k] })
+	desired := communityFilesContents(func(k string) interface{} { return n[k] })
+
+	err := commitCommunityFiles(ctx, d, meta, current, desired)
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryCommunityFilesRead(d, meta)
+}
+
+func resourceGithubRepositoryCommunityFilesDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	return commitCommunityFiles(ctx, d, meta, communityFilesContents(d.Get), map[string]string{})
+}
+
+func readCommunityFile(ctx context.Context, client *github.Client, owner, repoName, branch, path string) (string, error) {
+	opt := &github.RepositoryContentGetOptions{Ref: branch}
+	file, _, _, err := client.Repositories.GetContents(ctx, owner, repoName, path, opt)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	if file == nil {
+		return "", nil
+	}
+
+	return file.GetContent()
+}
+
+// commitCommunityFiles makes a single commit on the configured branch which
+// changes the files present in GitHub, current, into desired.
+func commitCommunityFiles(ctx context.Context, d *schema.ResourceData, meta interface{}, current, desired map[string]string) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	branch := d.Get("branch").(string)
+
+	entries := []interface{}{}
+	for path, content := range desired {
+		if c, ok := current[path]; ok && c == content {
+			continue
+		}
+		entries = append(entries, &communityTreeBlob{Path: path, Mode: "100644", Type: "blob", Content: content})
+	}
+	for path := range current {
+		if _, ok := desired[path]; !ok {
+			entries = append(entries, &communityTreeDeletion{Path: path, Mode: "100644", Type: "blob"})
+		}
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	ref, _, err := client.Git.GetRef(ctx, owner, repoName, "heads/"+branch)
+	if err != nil {
+		return err
+	}
+	parent, _, err := client.Git.GetCommit(ctx, owner, repoName, ref.GetObject().GetSHA())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Committing %d community file changes: %s/%s (%s)", len(entries), owner, repoName, branch)
+	tree := new(github.Tree)
+	_, err = apiRequest(ctx, client, "POST", fmt.Sprintf("repos/%s/%s/git/trees", owner, repoName),
+		&communityTree{BaseTree: parent.GetTree().GetSHA(), Tree: entries}, tree)
+	if err != nil {
+		return err
+	}
+
+	commit, _, err := client.Git.CreateCommit(ctx, owner, repoName, &github.Commit{
+		Message: github.String(d.Get("commit_message").(string)),
+		Tree:    tree,
+		Parents: []github.Commit{{SHA: parent.SHA}},
+	})
+	if err != nil {
+		return err
+	}
+
+	ref.Object.SHA = commit.SHA
+	_, _, err = client.Git.UpdateRef(ctx, owner, repoName, ref, false)
+	return err
+}
@@ -0,0 +1,80 @@
+package github
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestCommunityFilesContents(t *testing.T) {
+	attrs := map[string]interface{}{
+		"pull_request_template": "## Checklist",
+		"support":               "",
+		"security":              "Report to security@example.com",
+		"issue_template": []interface{}{
+			map[string]interface{}{"name": "bug_report", "content": "Describe the bug"},
+		},
+	}
+
+	files := communityFilesContents(func(k string) interface{} { return attrs[k] })
+	expected := map[string]string{
+		".github/PULL_REQUEST_TEMPLATE.md":     "## Checklist",
+		".github/SECURITY.md":                  "Report to security@example.com",
+		".github/ISSUE_TEMPLATE/bug_report.md": "Describe the bug",
+	}
+	if !reflect.DeepEqual(files, expected) {
+		t.Fatalf("Expected files %v, got %v", expected, files)
+	}
+}
+
+func TestAccGithubRepositoryCommunityFiles_basic(t *testing.T) {
+	rn := "github_repository_community_files.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-community-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryCommunityFilesConfig(repoName, "Please report vulnerabilities privately."),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "branch", "master"),
+					resource.TestCheckResourceAttr(rn, "security", "Please report vulnerabilities privately.\n"),
+					resource.TestCheckResourceAttr(rn, "issue_template.#", "1"),
+					resource.TestCheckResourceAttr(rn, "issue_template.0.name", "bug_report"),
+					resource.TestCheckResourceAttrSet(rn, "commit_sha"),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryCommunityFilesConfig(repoName, "Email security@example.com."),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "security", "Email security@example.com.\n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGithubRepositoryCommunityFilesConfig(repoName, security string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+resource "github_repository_community_files" "test" {
+  repository            = "${github_repository.test.name}"
+  pull_request_template = "## Checklist\n"
+  security              = "%s\n"
+
+  issue_template {
+    name    = "bug_report"
+    content = "Describe the bug.\n"
+  }
+}
+`, repoName, security)
+}
@@ -0,0 +1,165 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type customPropertyValues struct {
+	Properties []*customPropertyValue `json:"properties"`
+}
+
+func resourceGithubRepositoryCustomProperty() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryCustomPropertyCreateOrUpdate,
+		Read:   resourceGithubRepositoryCustomPropertyRead,
+		Update: resourceGithubRepositoryCustomPropertyCreateOrUpdate,
+		Delete: resourceGithubRepositoryCustomPropertyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"property_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"property_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateValueFunc([]string{"string", "single_select", "multi_select", "true_false"}),
+			},
+			"property_value": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func setRepositoryCustomPropertyValue(ctx context.Context, client *github.Client, owner, repoName, name string, value interface{}) error {
+	body := &customPropertyValues{
+		Properties: []*customPropertyValue{{PropertyName: name, Value: value}},
+	}
+	_, err := apiRequest(ctx, client, "PATCH", fmt.Sprintf("repos/%s/%s/properties/values", owner, repoName), body, nil)
+
+	return err
+}
+
+func resourceGithubRepositoryCustomPropertyCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	name := d.Get("property_name").(string)
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Setting custom property %s of repository: %s/%s", name, owner, repoName)
+	err = setRepositoryCustomPropertyValue(ctx, client, owner, repoName, name,
+		expandCustomPropertyValue(d.Get("property_type").(string), d.Get("property_value").([]interface{})))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&repoName, &name))
+
+	return untilFound(d, meta, resourceGithubRepositoryCustomPropertyRead)
+}
+
+func resourceGithubRepositoryCustomPropertyRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, name, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading custom property %s of repository: %s/%s", name, owner, repoName)
+	var values []*customPropertyValue
+	_, err = apiRequest(ctx, client, "GET", fmt.Sprintf("repos/%s/%s/properties/values", owner, repoName), nil, &values)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing custom property %s from state because the repository no longer exists in GitHub",
+				d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	var value *customPropertyValue
+	for _, v := range values {
+		if v.PropertyName == name && v.Value != nil {
+			value = v
+			break
+		}
+	}
+	if value == nil {
+		log.Printf("[WARN] Removing custom property %s from state because it is no longer set in GitHub",
+			d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// The type of the property is not returned along with its value
+	if _, ok := d.GetOk("property_type"); !ok {
+		property := new(customProperty)
+		_, err = apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/properties/schema/%s", owner, name), nil, property)
+		if err != nil {
+			return err
+		}
+		d.Set("property_type", property.ValueType)
+	}
+
+	d.Set("repository", repoName)
+	d.Set("property_name", name)
+	d.Set("property_value", flattenCustomPropertyValue(value.Value))
+
+	return nil
+}
+
+func resourceGithubRepositoryCustomPropertyDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOrganization(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, name, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	// Properties are unset with a null value; required properties return to
+	// their default value
+	log.Printf("[DEBUG] Unsetting custom property %s of repository: %s/%s", name, owner, repoName)
+	return setRepositoryCustomPropertyValue(ctx, client, owner, repoName, name, nil)
+}
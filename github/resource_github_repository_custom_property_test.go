@@ -0,0 +1,66 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubRepositoryCustomProperty_basic(t *testing.T) {
+	rn := "github_repository_custom_property.test"
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryCustomPropertyConfig(randString, `["backend"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "property_value.#", "1"),
+					resource.TestCheckResourceAttr(rn, "property_value.0", "backend"),
+					resource.TestCheckResourceAttr("data.github_organization_custom_property_values.test", "repository_names.#", "1"),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryCustomPropertyConfig(randString, `["backend", "frontend"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "property_value.#", "2"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubRepositoryCustomPropertyConfig(randString, values string) string {
+	return fmt.Sprintf(`
+resource "github_organization_custom_property" "test" {
+  property_name  = "tf-acc-test-%[1]s"
+  value_type     = "multi_select"
+  allowed_values = ["backend", "frontend"]
+}
+
+resource "github_repository" "test" {
+  name = "tf-acc-test-%[1]s"
+}
+
+resource "github_repository_custom_property" "test" {
+  repository     = "${github_repository.test.name}"
+  property_name  = "${github_organization_custom_property.test.property_name}"
+  property_type  = "multi_select"
+  property_value = %[2]s
+}
+
+data "github_organization_custom_property_values" "test" {
+  repository_query = "props.tf-acc-test-%[1]s:backend"
+  depends_on       = ["github_repository_custom_property.test"]
+}
+`, randString, values)
+}
@@ -54,7 +54,7 @@ func resourceGithubRepositoryDeployKey() *schema.Resource {
 }
 
 func resourceGithubRepositoryDeployKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -66,7 +66,7 @@ func resourceGithubRepositoryDeployKeyCreate(d *schema.ResourceData, meta interf
 	title := d.Get("title").(string)
 	readOnly := d.Get("read_only").(bool)
 	owner := meta.(*Organization).name
-	ctx := context.Background()
+	ctx := stopContext(meta)
 
 	log.Printf("[DEBUG] Creating repository deploy key: %s (%s/%s)", title, owner, repoName)
 	resultKey, _, err := client.Repositories.CreateKey(ctx, owner, repoName, &github.Key{
@@ -83,11 +83,11 @@ func resourceGithubRepositoryDeployKeyCreate(d *schema.ResourceData, meta interf
 
 	d.SetId(buildTwoPartID(&repoName, &id))
 
-	return resourceGithubRepositoryDeployKeyRead(d, meta)
+	return untilFound(d, meta, resourceGithubRepositoryDeployKeyRead)
 }
 
 func resourceGithubRepositoryDeployKeyRead(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -104,10 +104,8 @@ func resourceGithubRepositoryDeployKeyRead(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return unconvertibleIdErr(idString, err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading repository deploy key: %s (%s/%s)", d.Id(), owner, repoName)
 	key, resp, err := client.Repositories.GetKey(ctx, owner, repoName, id)
@@ -136,7 +134,7 @@ func resourceGithubRepositoryDeployKeyRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceGithubRepositoryDeployKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -153,7 +151,7 @@ func resourceGithubRepositoryDeployKeyDelete(d *schema.ResourceData, meta interf
 	if err != nil {
 		return unconvertibleIdErr(idString, err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting repository deploy key: %s (%s/%s)", idString, owner, repoName)
 	_, err = client.Repositories.DeleteKey(ctx, owner, repoName, id)
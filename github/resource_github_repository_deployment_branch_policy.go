@@ -0,0 +1,220 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type deploymentBranchPolicy struct {
+	ID     *int64  `json:"id,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	Type   *string `json:"type,omitempty"`
+}
+
+func resourceGithubRepositoryDeploymentBranchPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryDeploymentBranchPolicyCreate,
+		Read:   resourceGithubRepositoryDeploymentBranchPolicyRead,
+		Update: resourceGithubRepositoryDeploymentBranchPolicyUpdate,
+		Delete: resourceGithubRepositoryDeploymentBranchPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "branch",
+				ValidateFunc: validateValueFunc([]string{"branch", "tag"}),
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// parseDeploymentBranchPolicyID returns the pieces of id
+// `repository:environment:policy_id`. The environment is split off at the
+// last colon, since only the policy ID is known not to contain one.
+func parseDeploymentBranchPolicyID(id string) (string, string, int64, error) {
+	repoName, rest, err := parseTwoPartID(id)
+	if err != nil {
+		return "", "", 0, err
+	}
+	i := strings.LastIndex(rest, ":")
+	if i < 0 {
+		return "", "", 0, fmt.Errorf("Unexpected ID format (%q). Expected repository:environment:policy_id", id)
+	}
+	policyID, err := strconv.ParseInt(rest[i+1:], 10, 64)
+	if err != nil {
+		return "", "", 0, unconvertibleIdErr(rest[i+1:], err)
+	}
+
+	return repoName, rest[:i], policyID, nil
+}
+
+func deploymentBranchPoliciesURL(owner, repoName, envName string) string {
+	return environmentURL(owner, repoName, envName) + "/deployment-branch-policies"
+}
+
+func resourceGithubRepositoryDeploymentBranchPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	envName := d.Get("environment").(string)
+	ctx := stopContext(meta)
+
+	body := &deploymentBranchPolicy{
+		Name: github.String(d.Get("name").(string)),
+		Type: github.String(d.Get("type").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating deployment branch policy %s: %s (%s/%s)", *body.Name, envName, owner, repoName)
+	policy := new(deploymentBranchPolicy)
+	_, err = apiRequest(ctx, client, "POST", deploymentBranchPoliciesURL(owner, repoName, envName), body, policy)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", buildTwoPartID(&repoName, &envName), policy.GetID()))
+
+	return untilFound(d, meta, resourceGithubRepositoryDeploymentBranchPolicyRead)
+}
+
+func resourceGithubRepositoryDeploymentBranchPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, envName, policyID, err := parseDeploymentBranchPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading deployment branch policy: %s", d.Id())
+	policy := new(deploymentBranchPolicy)
+	resp, err := apiRequest(ctx, client, "GET",
+		fmt.Sprintf("%s/%d", deploymentBranchPoliciesURL(owner, repoName, envName), policyID), nil, policy)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing deployment branch policy %s from state because it no longer exists in GitHub",
+					d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("repository", repoName)
+	d.Set("environment", envName)
+	d.Set("name", policy.Name)
+	if policy.Type != nil {
+		d.Set("type", policy.Type)
+	} else {
+		d.Set("type", "branch")
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryDeploymentBranchPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, envName, policyID, err := parseDeploymentBranchPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	body := &deploymentBranchPolicy{
+		Name: github.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating deployment branch policy: %s", d.Id())
+	_, err = apiRequest(ctx, client, "PUT",
+		fmt.Sprintf("%s/%d", deploymentBranchPoliciesURL(owner, repoName, envName), policyID), body, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryDeploymentBranchPolicyRead(d, meta)
+}
+
+func resourceGithubRepositoryDeploymentBranchPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, envName, policyID, err := parseDeploymentBranchPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting deployment branch policy: %s", d.Id())
+	_, err = apiRequest(ctx, client, "DELETE",
+		fmt.Sprintf("%s/%d", deploymentBranchPoliciesURL(owner, repoName, envName), policyID), nil, nil)
+
+	return err
+}
+
+func (p *deploymentBranchPolicy) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
@@ -0,0 +1,137 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseDeploymentBranchPolicyID(t *testing.T) {
+	repoName, envName, policyID, err := parseDeploymentBranchPolicyID("example:staging:eu:42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repoName != "example" || envName != "staging:eu" || policyID != 42 {
+		t.Fatalf("Unexpected pieces: %q, %q, %d", repoName, envName, policyID)
+	}
+
+	for _, id := range []string{"example", "example:staging", "example:staging:main"} {
+		if _, _, _, err := parseDeploymentBranchPolicyID(id); err == nil {
+			t.Fatalf("Expected an error parsing %q", id)
+		}
+	}
+}
+
+func TestAccGithubRepositoryDeploymentBranchPolicy_basic(t *testing.T) {
+	rn := "github_repository_deployment_branch_policy.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-env-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDeploymentBranchPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryDeploymentBranchPolicyConfig(repoName, "release/*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryDeploymentBranchPolicyExists(rn),
+					resource.TestCheckResourceAttr(rn, "name", "release/*"),
+					resource.TestCheckResourceAttr(rn, "type", "branch"),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryDeploymentBranchPolicyConfig(repoName, "releases/*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryDeploymentBranchPolicyExists(rn),
+					resource.TestCheckResourceAttr(rn, "name", "releases/*"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGithubRepositoryDeploymentBranchPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No deployment branch policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*Organization).client
+		orgName := testAccProvider.Meta().(*Organization).name
+		repoName, envName, policyID, err := parseDeploymentBranchPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = apiRequest(context.TODO(), conn, "GET",
+			fmt.Sprintf("%s/%d", deploymentBranchPoliciesURL(orgName, repoName, envName), policyID), nil, nil)
+		return err
+	}
+}
+
+func testAccCheckGithubRepositoryDeploymentBranchPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_repository_deployment_branch_policy" {
+			continue
+		}
+
+		orgName := testAccProvider.Meta().(*Organization).name
+		repoName, envName, policyID, err := parseDeploymentBranchPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := apiRequest(context.TODO(), conn, "GET",
+			fmt.Sprintf("%s/%d", deploymentBranchPoliciesURL(orgName, repoName, envName), policyID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("Deployment branch policy %s still exists", rs.Primary.ID)
+		}
+		if resp != nil && resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+
+	return nil
+}
+
+func testAccGithubRepositoryDeploymentBranchPolicyConfig(repoName, pattern string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_repository_environment" "test" {
+  repository  = "${github_repository.test.name}"
+  environment = "production"
+
+  deployment_branch_policy {
+    protected_branches     = false
+    custom_branch_policies = true
+  }
+}
+
+resource "github_repository_deployment_branch_policy" "test" {
+  repository  = "${github_repository.test.name}"
+  environment = "${github_repository_environment.test.environment}"
+  name        = "%s"
+}
+`, repoName, pattern)
+}
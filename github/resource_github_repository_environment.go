@@ -0,0 +1,317 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+type repositoryEnvironment struct {
+	ID                     *int64                             `json:"id,omitempty"`
+	NodeID                 *string                            `json:"node_id,omitempty"`
+	Name                   *string                            `json:"name,omitempty"`
+	ProtectionRules        []*environmentProtectionRule       `json:"protection_rules,omitempty"`
+	DeploymentBranchPolicy *environmentDeploymentBranchPolicy `json:"deployment_branch_policy,omitempty"`
+}
+
+type environmentProtectionRule struct {
+	Type      *string                `json:"type,omitempty"`
+	WaitTimer *int                   `json:"wait_timer,omitempty"`
+	Reviewers []*environmentReviewer `json:"reviewers,omitempty"`
+}
+
+type environmentReviewer struct {
+	Type     *string `json:"type,omitempty"`
+	Reviewer *struct {
+		ID *int64 `json:"id,omitempty"`
+	} `json:"reviewer,omitempty"`
+}
+
+type environmentDeploymentBranchPolicy struct {
+	ProtectedBranches    bool `json:"protected_branches"`
+	CustomBranchPolicies bool `json:"custom_branch_policies"`
+}
+
+// The deployment branch policy is sent even when it is nil, because the API
+// only clears a previously configured policy when it receives an explicit null.
+type repositoryEnvironmentRequest struct {
+	WaitTimer              int                                `json:"wait_timer"`
+	Reviewers              []*environmentReviewerRequest      `json:"reviewers"`
+	DeploymentBranchPolicy *environmentDeploymentBranchPolicy `json:"deployment_branch_policy"`
+}
+
+type environmentReviewerRequest struct {
+	Type string `json:"type"`
+	ID   int64  `json:"id"`
+}
+
+func resourceGithubRepositoryEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryEnvironmentCreate,
+		Read:   resourceGithubRepositoryEnvironmentRead,
+		Update: resourceGithubRepositoryEnvironmentUpdate,
+		Delete: resourceGithubRepositoryEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// Everything except the repository and environment name is updated in
+		// place, since recreating an environment briefly drops its protection.
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"wait_timer": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 43200),
+			},
+			"reviewers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"users": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"teams": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"deployment_branch_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protected_branches": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"custom_branch_policies": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubRepositoryEnvironmentObject(d *schema.ResourceData) (*repositoryEnvironmentRequest, error) {
+	req := &repositoryEnvironmentRequest{
+		WaitTimer: d.Get("wait_timer").(int),
+		Reviewers: []*environmentReviewerRequest{},
+	}
+
+	if v, ok := d.GetOk("reviewers"); ok {
+		vL := v.([]interface{})
+		if len(vL) > 0 && vL[0] != nil {
+			m := vL[0].(map[string]interface{})
+			for _, id := range m["users"].(*schema.Set).List() {
+				req.Reviewers = append(req.Reviewers, &environmentReviewerRequest{Type: "User", ID: int64(id.(int))})
+			}
+			for _, id := range m["teams"].(*schema.Set).List() {
+				req.Reviewers = append(req.Reviewers, &environmentReviewerRequest{Type: "Team", ID: int64(id.(int))})
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("deployment_branch_policy"); ok {
+		vL := v.([]interface{})
+		if len(vL) > 0 && vL[0] != nil {
+			m := vL[0].(map[string]interface{})
+			policy := &environmentDeploymentBranchPolicy{
+				ProtectedBranches:    m["protected_branches"].(bool),
+				CustomBranchPolicies: m["custom_branch_policies"].(bool),
+			}
+			if policy.ProtectedBranches == policy.CustomBranchPolicies {
+				return nil, fmt.Errorf("Exactly one of `protected_branches` and `custom_branch_policies` must be true.")
+			}
+			req.DeploymentBranchPolicy = policy
+		}
+	}
+
+	return req, nil
+}
+
+func environmentURL(owner, repoName, envName string) string {
+	return fmt.Sprintf("repos/%s/%s/environments/%s", owner, repoName, url.PathEscape(envName))
+}
+
+func resourceGithubRepositoryEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	envName := d.Get("environment").(string)
+	ctx := stopContext(meta)
+
+	envReq, err := resourceGithubRepositoryEnvironmentObject(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating repository environment: %s (%s/%s)", envName, owner, repoName)
+	_, err = apiRequest(ctx, client, "PUT", environmentURL(owner, repoName, envName), envReq, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&repoName, &envName))
+
+	return untilFound(d, meta, resourceGithubRepositoryEnvironmentRead)
+}
+
+func resourceGithubRepositoryEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, envName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading repository environment: %s (%s/%s)", envName, owner, repoName)
+	env := new(repositoryEnvironment)
+	resp, err := apiRequest(ctx, client, "GET", environmentURL(owner, repoName, envName), nil, env)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing repository environment %s from state because it no longer exists in GitHub",
+					d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("repository", repoName)
+	d.Set("environment", env.Name)
+
+	waitTimer := 0
+	users := []interface{}{}
+	teams := []interface{}{}
+	for _, rule := range env.ProtectionRules {
+		if rule.Type == nil {
+			continue
+		}
+		switch *rule.Type {
+		case "wait_timer":
+			if rule.WaitTimer != nil {
+				waitTimer = *rule.WaitTimer
+			}
+		case "required_reviewers":
+			for _, r := range rule.Reviewers {
+				if r.Type == nil || r.Reviewer == nil || r.Reviewer.ID == nil {
+					continue
+				}
+				switch *r.Type {
+				case "User":
+					users = append(users, int(*r.Reviewer.ID))
+				case "Team":
+					teams = append(teams, int(*r.Reviewer.ID))
+				}
+			}
+		}
+	}
+	d.Set("wait_timer", waitTimer)
+
+	if len(users) > 0 || len(teams) > 0 {
+		d.Set("reviewers", []interface{}{
+			map[string]interface{}{
+				"users": schema.NewSet(schema.HashInt, users),
+				"teams": schema.NewSet(schema.HashInt, teams),
+			},
+		})
+	} else {
+		d.Set("reviewers", []interface{}{})
+	}
+
+	if policy := env.DeploymentBranchPolicy; policy != nil {
+		d.Set("deployment_branch_policy", []interface{}{
+			map[string]interface{}{
+				"protected_branches":     policy.ProtectedBranches,
+				"custom_branch_policies": policy.CustomBranchPolicies,
+			},
+		})
+	} else {
+		d.Set("deployment_branch_policy", []interface{}{})
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, envName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	envReq, err := resourceGithubRepositoryEnvironmentObject(d)
+	if err != nil {
+		return err
+	}
+
+	// The create-or-update endpoint modifies the existing environment, so
+	// reviewers and policies are changed without ever removing protection.
+	log.Printf("[DEBUG] Updating repository environment: %s (%s/%s)", envName, owner, repoName)
+	_, err = apiRequest(ctx, client, "PUT", environmentURL(owner, repoName, envName), envReq, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryEnvironmentRead(d, meta)
+}
+
+func resourceGithubRepositoryEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, envName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting repository environment: %s (%s/%s)", envName, owner, repoName)
+	_, err = apiRequest(ctx, client, "DELETE", environmentURL(owner, repoName, envName), nil, nil)
+	return err
+}
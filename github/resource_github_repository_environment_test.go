@@ -0,0 +1,131 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubRepositoryEnvironment_basic(t *testing.T) {
+	rn := "github_repository_environment.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-env-%s", rs)
+	var envID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryEnvironmentConfig(repoName, 10, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryEnvironmentExists(rn, &envID),
+					resource.TestCheckResourceAttr(rn, "environment", "production"),
+					resource.TestCheckResourceAttr(rn, "wait_timer", "10"),
+					resource.TestCheckResourceAttr(rn, "deployment_branch_policy.#", "1"),
+					resource.TestCheckResourceAttr(rn, "deployment_branch_policy.0.protected_branches", "true"),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryEnvironmentConfig(repoName, 20, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryEnvironmentExists(rn, &envID),
+					resource.TestCheckResourceAttr(rn, "wait_timer", "20"),
+					resource.TestCheckResourceAttr(rn, "deployment_branch_policy.0.protected_branches", "false"),
+					resource.TestCheckResourceAttr(rn, "deployment_branch_policy.0.custom_branch_policies", "true"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGithubRepositoryEnvironmentExists(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No environment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*Organization).client
+		orgName := testAccProvider.Meta().(*Organization).name
+		repoName, envName, err := parseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		env := new(repositoryEnvironment)
+		_, err = apiRequest(context.TODO(), conn, "GET", environmentURL(orgName, repoName, envName), nil, env)
+		if err != nil {
+			return err
+		}
+
+		// Updates must modify the environment in place rather than recreate it
+		envID := fmt.Sprintf("%d", *env.ID)
+		if *id != "" && *id != envID {
+			return fmt.Errorf("Environment was recreated: expected ID %s, got %s", *id, envID)
+		}
+		*id = envID
+
+		return nil
+	}
+}
+
+func testAccCheckGithubRepositoryEnvironmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_repository_environment" {
+			continue
+		}
+
+		orgName := testAccProvider.Meta().(*Organization).name
+		repoName, envName, err := parseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := apiRequest(context.TODO(), conn, "GET", environmentURL(orgName, repoName, envName), nil, nil)
+		if err == nil {
+			return fmt.Errorf("Repository environment %s still exists", rs.Primary.ID)
+		}
+		if resp != nil && resp.StatusCode != 404 {
+			return err
+		}
+		return nil
+	}
+
+	return nil
+}
+
+func testAccGithubRepositoryEnvironmentConfig(repoName string, waitTimer int, protectedBranches bool) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_repository_environment" "test" {
+  repository  = "${github_repository.test.name}"
+  environment = "production"
+  wait_timer  = %d
+
+  deployment_branch_policy {
+    protected_branches     = %t
+    custom_branch_policies = %t
+  }
+}
+`, repoName, waitTimer, protectedBranches, !protectedBranches)
+}
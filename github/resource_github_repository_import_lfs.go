@@ -0,0 +1,149 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubRepositoryImportLfs() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryImportLfsCreateOrUpdate,
+		Read:   resourceGithubRepositoryImportLfsRead,
+		Update: resourceGithubRepositoryImportLfsCreateOrUpdate,
+		Delete: resourceGithubRepositoryImportLfsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"use_lfs": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"has_large_files": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"large_files_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"large_files_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"large_files": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"oid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGithubRepositoryImportLfsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	useLFS := "opt_out"
+	if d.Get("use_lfs").(bool) {
+		useLFS = "opt_in"
+	}
+
+	log.Printf("[DEBUG] Updating source import LFS preference: %s/%s (%s)", owner, repoName, useLFS)
+	_, _, err := client.Migrations.SetLFSPreference(ctx, owner, repoName, &github.Import{
+		UseLFS: github.String(useLFS),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+
+	return untilFound(d, meta, resourceGithubRepositoryImportLfsRead)
+}
+
+func resourceGithubRepositoryImportLfsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading source import: %s/%s", owner, repoName)
+	imp, _, err := client.Migrations.ImportProgress(ctx, owner, repoName)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing source import LFS preference %s/%s from state because no import exists in GitHub",
+					owner, repoName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("repository", repoName)
+	if imp.UseLFS != nil {
+		d.Set("use_lfs", *imp.UseLFS == "opt_in")
+	}
+	d.Set("has_large_files", imp.GetHasLargeFiles())
+	d.Set("large_files_size", imp.GetLargeFilesSize())
+	d.Set("large_files_count", imp.GetLargeFilesCount())
+
+	largeFiles := []interface{}{}
+	if imp.GetHasLargeFiles() {
+		files, _, err := client.Migrations.LargeFiles(ctx, owner, repoName)
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			largeFiles = append(largeFiles, map[string]interface{}{
+				"path": f.GetPath(),
+				"oid":  f.GetOID(),
+				"size": f.GetSize(),
+			})
+		}
+	}
+	d.Set("large_files", largeFiles)
+
+	return nil
+}
+
+func resourceGithubRepositoryImportLfsDelete(d *schema.ResourceData, meta interface{}) error {
+	// The preference only applies while the import is running, so there is
+	// nothing to restore once it is no longer managed
+	log.Printf("[DEBUG] Removing source import LFS preference from state: %s", d.Id())
+	return nil
+}
@@ -0,0 +1,52 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubRepositoryImportLfs_basic(t *testing.T) {
+	// A source import can only be started outside of Terraform, so the test
+	// runs against a repository whose import is already in progress
+	repoName := os.Getenv("GITHUB_TEST_IMPORT_REPOSITORY")
+	if repoName == "" {
+		t.Skip("Skipping because `GITHUB_TEST_IMPORT_REPOSITORY` is not set")
+	}
+	rn := "github_repository_import_lfs.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryImportLfsConfig(repoName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "use_lfs", "true"),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryImportLfsConfig(repoName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "use_lfs", "false"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubRepositoryImportLfsConfig(repoName string, useLFS bool) string {
+	return fmt.Sprintf(`
+resource "github_repository_import_lfs" "test" {
+  repository = "%s"
+  use_lfs    = %t
+}
+`, repoName, useLFS)
+}
@@ -0,0 +1,315 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceGithubRepositoryPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceGithubRepositoryPolicyCreate,
+		Read:          resourceGithubRepositoryPolicyRead,
+		Update:        resourceGithubRepositoryPolicyUpdate,
+		Delete:        resourceGithubRepositoryPolicyDelete,
+		CustomizeDiff: resourceGithubRepositoryPolicyDiff,
+
+		// This resource never changes anything in GitHub; it evaluates the
+		// organization's repositories during every plan, enforcing the
+		// policy on those outside of the configuration, and enforces it on
+		// the others when applied, once they were created or updated.
+		Schema: map[string]*schema.Schema{
+			"name_filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"include_archived": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"required_topics": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_prefixes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enforcement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "error",
+				ValidateFunc: validateValueFunc([]string{"error", "warn"}),
+			},
+			// Repositories of the same configuration, whose violations the
+			// apply may still fix
+			"managed_repositories": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"repositories": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"violations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+type repositoryPolicy struct {
+	NameFilter      *regexp.Regexp
+	IncludeArchived bool
+	RequiredTopics  []string
+	NamePrefixes    []string
+}
+
+// evaluate returns the sorted names of the repositories the policy applies to,
+// a description of every violation found among them and the names of the
+// repositories violating it.
+func (p *repositoryPolicy) evaluate(repos []*github.Repository) ([]string, []string, []string) {
+	matched := []string{}
+	violations := []string{}
+	violating := []string{}
+
+	sort.Strings(p.RequiredTopics)
+	sort.Slice(repos, func(i, j int) bool { return repos[i].GetName() < repos[j].GetName() })
+
+	for _, repo := range repos {
+		name := repo.GetName()
+		if repo.GetArchived() && !p.IncludeArchived {
+			continue
+		}
+		if p.NameFilter != nil && !p.NameFilter.MatchString(name) {
+			continue
+		}
+		matched = append(matched, name)
+		found := len(violations)
+
+		if len(p.NamePrefixes) > 0 {
+			hasPrefix := false
+			for _, prefix := range p.NamePrefixes {
+				if strings.HasPrefix(name, prefix) {
+					hasPrefix = true
+					break
+				}
+			}
+			if !hasPrefix {
+				violations = append(violations, fmt.Sprintf("%s: name does not start with any of %q", name, p.NamePrefixes))
+			}
+		}
+
+		topics := make(map[string]bool, len(repo.Topics))
+		for _, t := range repo.Topics {
+			topics[t] = true
+		}
+		for _, t := range p.RequiredTopics {
+			if !topics[t] {
+				violations = append(violations, fmt.Sprintf("%s: missing required topic %q", name, t))
+			}
+		}
+
+		if len(violations) > found {
+			violating = append(violating, name)
+		}
+	}
+
+	return matched, violations, violating
+}
+
+type resourceDataGetter interface {
+	Get(string) interface{}
+}
+
+func expandRepositoryPolicy(d resourceDataGetter) (*repositoryPolicy, error) {
+	p := &repositoryPolicy{
+		IncludeArchived: d.Get("include_archived").(bool),
+		RequiredTopics:  expandStringList(d.Get("required_topics").(*schema.Set).List()),
+		NamePrefixes:    expandStringList(d.Get("name_prefixes").(*schema.Set).List()),
+	}
+	sort.Strings(p.NamePrefixes)
+
+	if filter := d.Get("name_filter").(string); filter != "" {
+		re, err := regexp.Compile(filter)
+		if err != nil {
+			return nil, err
+		}
+		p.NameFilter = re
+	}
+
+	return p, nil
+}
+
+func listOrganizationRepositories(ctx context.Context, meta interface{}, orgName string) ([]*github.Repository, error) {
+	client := meta.(*Organization).client
+
+	repos, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		return client.Repositories.ListByOrg(ctx, orgName, &github.RepositoryListByOrgOptions{ListOptions: opt.ListOptions})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var allRepos []*github.Repository
+	for _, v := range repos {
+		allRepos = append(allRepos, v.(*github.Repository))
+	}
+
+	return allRepos, nil
+}
+
+func evaluateRepositoryPolicy(d resourceDataGetter, meta interface{}) ([]string, []string, []string, error) {
+	err := checkOrganization(meta)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	orgName := meta.(*Organization).name
+
+	policy, err := expandRepositoryPolicy(d)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	log.Printf("[DEBUG] Evaluating repository policy for organization: %s", orgName)
+	repos, err := listOrganizationRepositories(stopContext(meta), meta, orgName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	matched, violations, violating := policy.evaluate(repos)
+	return matched, violations, violating, nil
+}
+
+func repositoryPolicyViolatedError(violations, violating []string) error {
+	return fmt.Errorf("Repository policy violated by %d repositories:\n  %s",
+		len(violating), strings.Join(violations, "\n  "))
+}
+
+// unmanagedViolations returns the violations of the repositories which are not
+// managed in the same configuration, along with the names of those
+// repositories.
+func unmanagedViolations(violations, violating []string, managed *schema.Set) ([]string, []string) {
+	unmanaged := []string{}
+	for _, name := range violating {
+		if !managed.Contains(name) {
+			unmanaged = append(unmanaged, name)
+		}
+	}
+
+	found := []string{}
+	for _, v := range violations {
+		for _, name := range unmanaged {
+			if strings.HasPrefix(v, name+": ") {
+				found = append(found, v)
+				break
+			}
+		}
+	}
+
+	return found, unmanaged
+}
+
+func resourceGithubRepositoryPolicyDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// The policy is evaluated once what it is configured with is known,
+	// e.g. the name of a repository of the same configuration
+	for _, key := range []string{"name_filter", "include_archived", "required_topics", "name_prefixes", "managed_repositories"} {
+		if !d.NewValueKnown(key) {
+			if err := d.SetNewComputed("repositories"); err != nil {
+				return err
+			}
+			return d.SetNewComputed("violations")
+		}
+	}
+
+	matched, violations, violating, err := evaluateRepositoryPolicy(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if err := d.SetNew("repositories", matched); err != nil {
+		return err
+	}
+
+	// Only the repositories of the same configuration can be fixed by
+	// applying the plan, so any other violation fails the plan; the others
+	// fail the apply, which evaluates them again once every repository of
+	// the configuration is applied, and until then are planned to change
+	if len(violations) > 0 && d.Get("enforcement").(string) == "error" {
+		unmanaged, unmanagedViolating := unmanagedViolations(violations, violating, d.Get("managed_repositories").(*schema.Set))
+		if len(unmanaged) > 0 {
+			return repositoryPolicyViolatedError(unmanaged, unmanagedViolating)
+		}
+		return d.SetNewComputed("violations")
+	}
+
+	// Warnings are planned, so the plan shows them whenever they change
+	return d.SetNew("violations", violations)
+}
+
+func resourceGithubRepositoryPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	matched, violations, violating, err := evaluateRepositoryPolicy(d, meta)
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 && d.Get("enforcement").(string) == "error" {
+		return repositoryPolicyViolatedError(violations, violating)
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("repositories", matched)
+	d.Set("violations", violations)
+
+	return nil
+}
+
+func resourceGithubRepositoryPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	matched, violations, _, err := evaluateRepositoryPolicy(d, meta)
+	if err != nil {
+		return err
+	}
+
+	d.Set("repositories", matched)
+	d.Set("violations", violations)
+
+	return nil
+}
+
+func resourceGithubRepositoryPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	matched, violations, violating, err := evaluateRepositoryPolicy(d, meta)
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 && d.Get("enforcement").(string) == "error" {
+		// Nothing planned is kept, so the next plan enforces it again
+		d.Partial(true)
+		return repositoryPolicyViolatedError(violations, violating)
+	}
+
+	d.Set("repositories", matched)
+	d.Set("violations", violations)
+
+	return nil
+}
+
+func resourceGithubRepositoryPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,197 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestRepositoryPolicyEvaluate(t *testing.T) {
+	repos := []*github.Repository{
+		{Name: github.String("svc-billing"), Topics: []string{"team-a", "service"}},
+		{Name: github.String("svc-search"), Topics: []string{"service"}},
+		{Name: github.String("search-ui"), Topics: []string{}},
+		{Name: github.String("svc-legacy"), Archived: github.Bool(true)},
+	}
+
+	policy := &repositoryPolicy{
+		NameFilter:     regexp.MustCompile(`search|billing|legacy`),
+		RequiredTopics: []string{"service", "team-a"},
+		NamePrefixes:   []string{"svc-"},
+	}
+
+	matched, violations, violating := policy.evaluate(repos)
+
+	expectedMatched := []string{"search-ui", "svc-billing", "svc-search"}
+	if !reflect.DeepEqual(matched, expectedMatched) {
+		t.Fatalf("Expected matched repositories %v, got %v", expectedMatched, matched)
+	}
+
+	expectedViolations := []string{
+		`search-ui: name does not start with any of ["svc-"]`,
+		`search-ui: missing required topic "service"`,
+		`search-ui: missing required topic "team-a"`,
+		`svc-search: missing required topic "team-a"`,
+	}
+	if !reflect.DeepEqual(violations, expectedViolations) {
+		t.Fatalf("Expected violations %v, got %v", expectedViolations, violations)
+	}
+
+	expectedViolating := []string{"search-ui", "svc-search"}
+	if !reflect.DeepEqual(violating, expectedViolating) {
+		t.Fatalf("Expected violating repositories %v, got %v", expectedViolating, violating)
+	}
+}
+
+func TestResourceGithubRepositoryPolicyUpdate(t *testing.T) {
+	repos := `[{"name": "svc-billing", "topics": ["service"]}, {"name": "search-ui", "topics": []}]`
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/orgs/example/repos?page=1&per_page=100",
+			ResponseBody: repos,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/orgs/example/repos?page=1&per_page=100",
+			ResponseBody: repos,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	r := resourceGithubRepositoryPolicy()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"required_topics": []interface{}{"service", "owned"},
+		"enforcement":     "warn",
+	})
+	d.SetId("policy")
+
+	// Warnings are recorded, for the plan to show them
+	if err := resourceGithubRepositoryPolicyUpdate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(d.Get("violations").([]interface{})); n != 3 {
+		t.Fatalf("Expected 3 violations, got %d", n)
+	}
+
+	// Errors fail the apply, counting the repositories violating the policy
+	d.Set("enforcement", "error")
+	err := resourceGithubRepositoryPolicyUpdate(d, meta)
+	if err == nil || !strings.HasPrefix(err.Error(), "Repository policy violated by 2 repositories") {
+		t.Fatalf("Expected the violations to fail the apply, got %v", err)
+	}
+}
+
+func TestResourceGithubRepositoryPolicyDiff(t *testing.T) {
+	repos := `[{"name": "svc-billing", "topics": ["service"]}, {"name": "search-ui", "topics": []}]`
+	var responses []*mockResponse
+	for i := 0; i < 4; i++ {
+		responses = append(responses, &mockResponse{
+			ExpectedUri:  "/orgs/example/repos?page=1&per_page=100",
+			ResponseBody: repos,
+			StatusCode:   200,
+		})
+	}
+	ts := githubApiMock(responses)
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	r := resourceGithubRepositoryPolicy()
+	config := func(enforcement string, managed ...interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"required_topics":      []interface{}{"service", "owned"},
+			"enforcement":          enforcement,
+			"managed_repositories": managed,
+		})
+	}
+
+	// Repositories outside of the configuration cannot be fixed by the apply
+	_, err := r.Diff(nil, config("error"), meta)
+	if err == nil || !strings.HasPrefix(err.Error(), "Repository policy violated by 2 repositories") {
+		t.Fatalf("Expected the violations to fail the plan, got %v", err)
+	}
+	_, err = r.Diff(nil, config("error", "search-ui"), meta)
+	if err == nil || !strings.HasPrefix(err.Error(), "Repository policy violated by 1 repositories") ||
+		strings.Contains(err.Error(), "search-ui") {
+		t.Fatalf("Expected the violations of svc-billing to fail the plan, got %v", err)
+	}
+
+	// Violations of the configuration's repositories are left to the apply
+	diff, err := r.Diff(nil, config("error", "search-ui", "svc-billing"), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attr := diff.Attributes["violations.#"]; attr == nil || !attr.NewComputed {
+		t.Fatalf("Expected the violations to be planned to change, got %#v", attr)
+	}
+
+	// Warnings are planned
+	diff, err = r.Diff(nil, config("warn"), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attr := diff.Attributes["violations.#"]; attr == nil || attr.New != "3" {
+		t.Fatalf("Expected 3 violations to be planned, got %#v", attr)
+	}
+	if attr := diff.Attributes["violations.0"]; attr == nil || attr.New != `search-ui: missing required topic "owned"` {
+		t.Fatalf("Expected the violations to be planned, got %#v", attr)
+	}
+}
+
+func TestAccGithubRepositoryPolicy_basic(t *testing.T) {
+	rn := "github_repository_policy.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-policy-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryPolicyConfig(repoName, "warn"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "repositories.#", "1"),
+					resource.TestCheckResourceAttr(rn, "repositories.0", repoName),
+					resource.TestCheckResourceAttr(rn, "violations.#", "1"),
+				),
+			},
+			{
+				Config:      testAccGithubRepositoryPolicyConfig(repoName, "error"),
+				ExpectError: regexp.MustCompile(`missing required topic "compliance"`),
+			},
+		},
+	})
+}
+
+func testAccGithubRepositoryPolicyConfig(repoName, enforcement string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name   = "%s"
+  topics = ["terraform"]
+}
+
+resource "github_repository_policy" "test" {
+  name_filter     = "^${github_repository.test.name}$"
+  required_topics = ["terraform", "compliance"]
+  enforcement     = "%s"
+
+  managed_repositories = ["${github_repository.test.name}"]
+}
+`, repoName, enforcement)
+}
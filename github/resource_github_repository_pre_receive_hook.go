@@ -0,0 +1,135 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// A repository pre-receive hook overrides the enforcement of a pre-receive
+// hook of the GitHub Enterprise Server for a repository, if the hook and the
+// organization allow it. It has the ID `<repository>:<hook id>`; destroying it
+// restores the enforcement of the organization.
+func resourceGithubRepositoryPreReceiveHook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryPreReceiveHookCreateOrUpdate,
+		Read:   resourceGithubRepositoryPreReceiveHookRead,
+		Update: resourceGithubRepositoryPreReceiveHookCreateOrUpdate,
+		Delete: resourceGithubRepositoryPreReceiveHookDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				repoName, hookID, err := parseTwoPartID(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				id, err := strconv.Atoi(hookID)
+				if err != nil {
+					return nil, unconvertibleIdErr(hookID, err)
+				}
+				d.Set("repository", repoName)
+				d.Set("hook_id", id)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"hook_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"enforcement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateValueFunc([]string{"enabled", "disabled", "testing"}),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func repositoryPreReceiveHookURL(d *schema.ResourceData, meta interface{}) string {
+	return fmt.Sprintf("repos/%s/%s/pre-receive-hooks/%d", meta.(*Organization).name, d.Get("repository").(string), d.Get("hook_id").(int))
+}
+
+func resourceGithubRepositoryPreReceiveHookCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	repoName := d.Get("repository").(string)
+	hookID := strconv.Itoa(d.Get("hook_id").(int))
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	req := &preReceiveHook{
+		Enforcement: github.String(d.Get("enforcement").(string)),
+	}
+	log.Printf("[DEBUG] Overriding pre-receive hook %s for repository: %s", hookID, repoName)
+	_, err = apiRequest(ctx, client, "PATCH", repositoryPreReceiveHookURL(d, meta), req, nil)
+	if err != nil {
+		return err
+	}
+	d.SetId(buildTwoPartID(&repoName, &hookID))
+
+	return untilFound(d, meta, resourceGithubRepositoryPreReceiveHookRead)
+}
+
+func resourceGithubRepositoryPreReceiveHookRead(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading repository pre-receive hook: %s", d.Id())
+	hook := new(preReceiveHook)
+	_, err = apiRequest(ctx, client, "GET", repositoryPreReceiveHookURL(d, meta), nil, hook)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing repository pre-receive hook %s from state because it no longer exists in GitHub",
+				d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", hook.Name)
+	d.Set("enforcement", hook.Enforcement)
+
+	return nil
+}
+
+func resourceGithubRepositoryPreReceiveHookDelete(d *schema.ResourceData, meta interface{}) error {
+	err := checkEnterpriseServer(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Removing the override of repository pre-receive hook: %s", d.Id())
+	_, err = apiRequest(ctx, client, "DELETE", repositoryPreReceiveHookURL(d, meta), nil, nil)
+	return err
+}
@@ -0,0 +1,47 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestGithubRepositoryPreReceiveHookCreate(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/example/api/pre-receive-hooks/5",
+			ExpectedMethod: "PATCH",
+			ExpectedBody:   []byte(`{"enforcement":"enabled"}` + "\n"),
+			StatusCode:     200,
+			ResponseBody:   `{"id": 5, "name": "Check commit messages", "enforcement": "enabled"}`,
+		},
+		{
+			ExpectedUri:  "/repos/example/api/pre-receive-hooks/5",
+			StatusCode:   200,
+			ResponseBody: `{"id": 5, "name": "Check commit messages", "enforcement": "enabled"}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{enterpriseServer: true}}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubRepositoryPreReceiveHook().Schema, map[string]interface{}{
+		"repository":  "api",
+		"hook_id":     5,
+		"enforcement": "enabled",
+	})
+	if err := resourceGithubRepositoryPreReceiveHookCreateOrUpdate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "api:5" {
+		t.Fatalf("Unexpected ID %q", d.Id())
+	}
+	if v := d.Get("name"); v != "Check commit messages" {
+		t.Fatalf("Unexpected name %v", v)
+	}
+}
@@ -48,6 +48,10 @@ func resourceGithubRepositoryProject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -57,7 +61,7 @@ func resourceGithubRepositoryProject() *schema.Resource {
 }
 
 func resourceGithubRepositoryProjectCreate(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -73,7 +77,7 @@ func resourceGithubRepositoryProjectCreate(d *schema.ResourceData, meta interfac
 		Name: &name,
 		Body: &body,
 	}
-	ctx := context.Background()
+	ctx := stopContext(meta)
 
 	log.Printf("[DEBUG] Creating repository project: %s (%s/%s)", name, orgName, repoName)
 	project, _, err := client.Repositories.CreateProject(ctx,
@@ -83,11 +87,11 @@ func resourceGithubRepositoryProjectCreate(d *schema.ResourceData, meta interfac
 	}
 	d.SetId(strconv.FormatInt(*project.ID, 10))
 
-	return resourceGithubRepositoryProjectRead(d, meta)
+	return untilFound(d, meta, resourceGithubRepositoryProjectRead)
 }
 
 func resourceGithubRepositoryProjectRead(d *schema.ResourceData, meta interface{}) error {
-	err := checkOrganization(meta)
+	err := checkOwner(meta)
 	if err != nil {
 		return err
 	}
@@ -99,10 +103,8 @@ func resourceGithubRepositoryProjectRead(d *schema.ResourceData, meta interface{
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading repository project: %s", d.Id())
 	project, resp, err := client.Projects.GetProject(ctx, projectID)
@@ -122,6 +124,7 @@ func resourceGithubRepositoryProjectRead(d *schema.ResourceData, meta interface{
 	}
 
 	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("node_id", project.GetNodeID())
 	d.Set("name", project.GetName())
 	d.Set("body", project.GetBody())
 	d.Set("url", fmt.Sprintf("https://github.com/%s/%s/projects/%d",
@@ -145,7 +148,7 @@ func resourceGithubRepositoryProjectUpdate(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Updating repository project: %s", d.Id())
 	_, _, err = client.Projects.UpdateProject(ctx, projectID, &options)
@@ -163,7 +166,7 @@ func resourceGithubRepositoryProjectDelete(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting repository project: %s", d.Id())
 	_, err = client.Projects.DeleteProject(ctx, projectID)
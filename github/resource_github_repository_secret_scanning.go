@@ -0,0 +1,161 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type securityAndAnalysisStatus struct {
+	Status *string `json:"status,omitempty"`
+}
+
+type securityAndAnalysis struct {
+	AdvancedSecurity             *securityAndAnalysisStatus `json:"advanced_security,omitempty"`
+	SecretScanning               *securityAndAnalysisStatus `json:"secret_scanning,omitempty"`
+	SecretScanningPushProtection *securityAndAnalysisStatus `json:"secret_scanning_push_protection,omitempty"`
+	DependabotSecurityUpdates    *securityAndAnalysisStatus `json:"dependabot_security_updates,omitempty"`
+}
+
+type repositorySecurityAndAnalysis struct {
+	SecurityAndAnalysis *securityAndAnalysis `json:"security_and_analysis,omitempty"`
+}
+
+func resourceGithubRepositorySecretScanning() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositorySecretScanningCreateOrUpdate,
+		Read:   resourceGithubRepositorySecretScanningRead,
+		Update: resourceGithubRepositorySecretScanningCreateOrUpdate,
+		Delete: resourceGithubRepositorySecretScanningDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"secret_scanning_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"push_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func securityAndAnalysisStatusValue(enabled bool) *securityAndAnalysisStatus {
+	if enabled {
+		return &securityAndAnalysisStatus{Status: github.String("enabled")}
+	}
+	return &securityAndAnalysisStatus{Status: github.String("disabled")}
+}
+
+func securityAndAnalysisStatusEnabled(s *securityAndAnalysisStatus) bool {
+	return s != nil && s.Status != nil && *s.Status == "enabled"
+}
+
+func updateRepositorySecretScanning(ctx context.Context, client *github.Client, owner, repoName string, secretScanning, pushProtection bool) error {
+	// Push protection can only be turned on together with, or after, secret scanning
+	settings := &repositorySecurityAndAnalysis{
+		SecurityAndAnalysis: &securityAndAnalysis{
+			SecretScanning:               securityAndAnalysisStatusValue(secretScanning),
+			SecretScanningPushProtection: securityAndAnalysisStatusValue(pushProtection),
+		},
+	}
+
+	_, err := apiRequest(ctx, client, "PATCH", fmt.Sprintf("repos/%s/%s", owner, repoName), settings, nil)
+	return err
+}
+
+func resourceGithubRepositorySecretScanningCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	secretScanning := d.Get("secret_scanning_enabled").(bool)
+	pushProtection := d.Get("push_protection_enabled").(bool)
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	if pushProtection && !secretScanning {
+		return fmt.Errorf("`push_protection_enabled` requires `secret_scanning_enabled` to be true.")
+	}
+
+	log.Printf("[DEBUG] Updating repository secret scanning: %s/%s", owner, repoName)
+	err := updateRepositorySecretScanning(ctx, client, owner, repoName, secretScanning, pushProtection)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+
+	return untilFound(d, meta, resourceGithubRepositorySecretScanningRead)
+}
+
+func resourceGithubRepositorySecretScanningRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading repository secret scanning: %s/%s", owner, repoName)
+	repo := new(repositorySecurityAndAnalysis)
+	resp, err := apiRequest(ctx, client, "GET", fmt.Sprintf("repos/%s/%s", owner, repoName), nil, repo)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing repository secret scanning %s/%s from state because the repository no longer exists in GitHub",
+					owner, repoName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("repository", repoName)
+
+	settings := repo.SecurityAndAnalysis
+	if settings == nil {
+		settings = &securityAndAnalysis{}
+	}
+	d.Set("secret_scanning_enabled", securityAndAnalysisStatusEnabled(settings.SecretScanning))
+	d.Set("push_protection_enabled", securityAndAnalysisStatusEnabled(settings.SecretScanningPushProtection))
+
+	return nil
+}
+
+func resourceGithubRepositorySecretScanningDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Disabling repository secret scanning: %s/%s", owner, repoName)
+	return updateRepositorySecretScanning(ctx, client, owner, repoName, false, false)
+}
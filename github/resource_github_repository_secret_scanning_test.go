@@ -0,0 +1,56 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGithubRepositorySecretScanning_basic(t *testing.T) {
+	rn := "github_repository_secret_scanning.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-scanning-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositorySecretScanningConfig(repoName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "repository", repoName),
+					resource.TestCheckResourceAttr(rn, "secret_scanning_enabled", "true"),
+					resource.TestCheckResourceAttr(rn, "push_protection_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccGithubRepositorySecretScanningConfig(repoName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "secret_scanning_enabled", "true"),
+					resource.TestCheckResourceAttr(rn, "push_protection_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubRepositorySecretScanningConfig(repoName string, pushProtection bool) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_repository_secret_scanning" "test" {
+  repository              = "${github_repository.test.name}"
+  secret_scanning_enabled = true
+  push_protection_enabled = %t
+}
+`, repoName, pushProtection)
+}
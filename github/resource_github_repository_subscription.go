@@ -0,0 +1,119 @@
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubRepositorySubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositorySubscriptionCreateOrUpdate,
+		Read:   resourceGithubRepositorySubscriptionRead,
+		Update: resourceGithubRepositorySubscriptionCreateOrUpdate,
+		Delete: resourceGithubRepositorySubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subscription": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "watching",
+				ValidateFunc: validateValueFunc([]string{"watching", "ignoring"}),
+			},
+			"reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubRepositorySubscriptionCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ignoring := d.Get("subscription").(string) == "ignoring"
+	ctx := stopContext(meta)
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxId, d.Id())
+	}
+
+	log.Printf("[DEBUG] Setting repository subscription: %s/%s (%s)", owner, repoName, d.Get("subscription").(string))
+	_, _, err := client.Activity.SetRepositorySubscription(ctx, owner, repoName, &github.Subscription{
+		Subscribed: github.Bool(!ignoring),
+		Ignored:    github.Bool(ignoring),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoName)
+
+	return untilFound(d, meta, resourceGithubRepositorySubscriptionRead)
+}
+
+func resourceGithubRepositorySubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading repository subscription: %s/%s", owner, repoName)
+	sub, resp, err := client.Activity.GetRepositorySubscription(ctx, owner, repoName)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+		}
+		return err
+	}
+	// A missing subscription is reported without an error
+	if sub == nil {
+		log.Printf("[WARN] Removing repository subscription %s/%s from state because it no longer exists in GitHub",
+			owner, repoName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("repository", repoName)
+	if sub.GetIgnored() {
+		d.Set("subscription", "ignoring")
+	} else {
+		d.Set("subscription", "watching")
+	}
+	d.Set("reason", sub.GetReason())
+
+	return nil
+}
+
+func resourceGithubRepositorySubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Id()
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Deleting repository subscription: %s/%s", owner, repoName)
+	_, err := client.Activity.DeleteRepositorySubscription(ctx, owner, repoName)
+	return err
+}
@@ -0,0 +1,73 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubRepositorySubscription_basic(t *testing.T) {
+	rn := "github_repository_subscription.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-watch-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositorySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositorySubscriptionConfig(repoName, "watching"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "subscription", "watching"),
+				),
+			},
+			{
+				Config: testAccGithubRepositorySubscriptionConfig(repoName, "ignoring"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "subscription", "ignoring"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGithubRepositorySubscriptionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+	orgName := testAccProvider.Meta().(*Organization).name
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_repository_subscription" {
+			continue
+		}
+
+		sub, _, err := conn.Activity.GetRepositorySubscription(context.TODO(), orgName, rs.Primary.ID)
+		if err == nil && sub != nil {
+			return fmt.Errorf("Repository subscription %s still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccGithubRepositorySubscriptionConfig(repoName, subscription string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_repository_subscription" "test" {
+  repository   = "${github_repository.test.name}"
+  subscription = "%s"
+}
+`, repoName, subscription)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"regexp"
 	"sort"
@@ -13,6 +14,7 @@ import (
 	"github.com/google/go-github/v28/github"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -490,6 +492,351 @@ func TestAccGithubRepository_autoInitForceNew(t *testing.T) {
 	})
 }
 
+func TestAccGithubRepository_visibilityChange(t *testing.T) {
+	var repo github.Repository
+
+	rn := "github_repository.foo"
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryConfigVisibility(randString, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryExists(rn, &repo),
+					resource.TestCheckResourceAttr(rn, "private", "true"),
+				),
+			},
+			{
+				Config:      testAccGithubRepositoryConfigVisibility(randString, false, false),
+				ExpectError: regexp.MustCompile("requires `allow_visibility_change` to be true"),
+			},
+			{
+				Config: testAccGithubRepositoryConfigVisibility(randString, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryExists(rn, &repo),
+					resource.TestCheckResourceAttr(rn, "private", "false"),
+				),
+			},
+			{
+				ResourceName:            rn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_init", "allow_visibility_change"},
+			},
+		},
+	})
+}
+
+func TestAccGithubRepository_fromTemplate(t *testing.T) {
+	rn := "github_repository.foo"
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryConfigFromTemplate(randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("github_repository.template", "is_template", "true"),
+					resource.TestCheckResourceAttr(rn, "is_template", "false"),
+					resource.TestCheckResourceAttr(rn, "has_wiki", "false"),
+					testAccCheckGithubRepositoryFileContent(rn, ".github/workflows/ci.yml",
+						"name: CI of tf-acc-test-"+randString+"\non: push\njobs: {}\n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubRepositoryFileContent(n, path, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*Organization).client
+		orgName := testAccProvider.Meta().(*Organization).name
+		file, _, _, err := conn.Repositories.GetContents(context.TODO(), orgName, rs.Primary.ID, path, nil)
+		if err != nil {
+			return err
+		}
+		content, err := file.GetContent()
+		if err != nil {
+			return err
+		}
+		if content != want {
+			return fmt.Errorf("Expected %s to be %q, got %q", path, want, content)
+		}
+		return nil
+	}
+}
+
+func TestSubstituteTemplateVars(t *testing.T) {
+	content := "# {{ name }}\n{{team}} owns {{ name }}; ${{ github.sha }} {{ unknown }}\n"
+	got := substituteTemplateVars(content, map[string]interface{}{
+		"name":   "service",
+		"team":   "platform",
+		"github": "x",
+	})
+
+	want := "# service\nplatform owns service; ${{ github.sha }} {{ unknown }}\n"
+	if got != want {
+		t.Fatalf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestGithubRepositoryTemplateVarsDiff(t *testing.T) {
+	defaults := &repositoryDefaults{}
+	r := resourceGithubRepository(defaults)
+	meta := &Organization{name: "example", organizationOptions: organizationOptions{repositoryDefaults: defaults}}
+	state := &terraform.InstanceState{
+		ID: "service",
+		Attributes: map[string]string{
+			"name":                            "service",
+			"template.#":                      "1",
+			"template.0.owner":                "example",
+			"template.0.repository":           "template",
+			"template.0.include_all_branches": "false",
+			"template.0.vars.%":               "1",
+			"template.0.vars.name":            "service",
+		},
+	}
+	config := func(repository, name string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": "service",
+			"template": []interface{}{map[string]interface{}{
+				"owner":      "example",
+				"repository": repository,
+				"vars":       map[string]interface{}{"name": name},
+			}},
+		})
+	}
+
+	diff, err := r.Diff(state, config("template", "renamed"), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.RequiresNew() {
+		t.Fatal("Expected changing the template variables not to replace the repository")
+	}
+
+	diff, err = r.Diff(state, config("other-template", "service"), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.RequiresNew() {
+		t.Fatal("Expected changing the template repository to replace the repository")
+	}
+}
+
+func TestGithubRepositoryUnarchive(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/example/service",
+			ExpectedMethod: "PATCH",
+			ExpectedBody:   []byte(`{"archived":false}` + "\n"),
+			StatusCode:     200,
+			ResponseBody:   `{"name": "service", "archived": false}`,
+		},
+		{
+			ExpectedUri:    "/repos/example/service",
+			ExpectedMethod: "PATCH",
+			StatusCode:     200,
+			ResponseBody:   `{"name": "service", "description": "Revived", "archived": false}`,
+		},
+		{
+			ExpectedUri:  "/repos/example/service",
+			StatusCode:   200,
+			ResponseBody: `{"name": "service", "description": "Revived", "archived": false, "owner": {"login": "example"}}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	defaults := &repositoryDefaults{}
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{repositoryDefaults: defaults}}
+
+	r := resourceGithubRepository(defaults)
+	state := &terraform.InstanceState{
+		ID: "service",
+		Attributes: map[string]string{
+			"name":     "service",
+			"archived": "true",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":        "service",
+		"description": "Revived",
+		"archived":    false,
+	})
+	diff, err := r.Diff(state, config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The repository is unarchived before its description is changed
+	state, err = r.Apply(state, diff, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Attributes["archived"] != "false" {
+		t.Fatalf("Expected the repository to be unarchived, got %q", state.Attributes["archived"])
+	}
+}
+
+func TestGithubRepositorySecurityAndAnalysis(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/example/service",
+			ExpectedMethod: "PATCH",
+			StatusCode:     200,
+			ResponseBody:   `{"name": "service"}`,
+		},
+		{
+			ExpectedUri:    "/repos/example/service",
+			ExpectedMethod: "PATCH",
+			ExpectedBody:   []byte(`{"security_and_analysis":{"secret_scanning":{"status":"enabled"},"secret_scanning_push_protection":{"status":"enabled"}}}` + "\n"),
+			StatusCode:     200,
+			ResponseBody:   `{"name": "service"}`,
+		},
+		{
+			ExpectedUri:  "/repos/example/service",
+			StatusCode:   200,
+			ResponseBody: `{"name": "service", "owner": {"login": "example"}}`,
+		},
+		{
+			ExpectedUri: "/repos/example/service",
+			StatusCode:  200,
+			ResponseBody: `{"name": "service", "security_and_analysis": {"advanced_security": {"status": "enabled"},
+				"secret_scanning": {"status": "enabled"}, "secret_scanning_push_protection": {"status": "enabled"}}}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	defaults := &repositoryDefaults{}
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{repositoryDefaults: defaults}}
+
+	r := resourceGithubRepository(defaults)
+	state := &terraform.InstanceState{
+		ID: "service",
+		Attributes: map[string]string{
+			"name": "service",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "service",
+		"security_and_analysis": []interface{}{
+			map[string]interface{}{
+				"secret_scanning":                 []interface{}{map[string]interface{}{"status": "enabled"}},
+				"secret_scanning_push_protection": []interface{}{map[string]interface{}{"status": "enabled"}},
+			},
+		},
+	})
+	diff, err := r.Diff(state, config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, err = r.Apply(state, diff, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Settings which are not configured are not kept in state
+	if v := state.Attributes["security_and_analysis.0.advanced_security.#"]; v != "" && v != "0" {
+		t.Fatalf("Expected advanced_security not to be read, got %v", state.Attributes)
+	}
+	if v := state.Attributes["security_and_analysis.0.secret_scanning.0.status"]; v != "enabled" {
+		t.Fatalf("Expected secret scanning to be enabled, got %q", v)
+	}
+}
+
+func TestGithubRepositoryArchiveOnDestroy(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/example/service",
+			ExpectedMethod: "PATCH",
+			ExpectedBody:   []byte(`{"archived":true}` + "\n"),
+			StatusCode:     200,
+			ResponseBody:   `{"name": "service", "archived": true}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	defaults := &repositoryDefaults{}
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{repositoryDefaults: defaults}}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubRepository(defaults).Schema, map[string]interface{}{
+		"name":               "service",
+		"archive_on_destroy": true,
+	})
+	d.SetId("service")
+
+	// A DELETE request would not match the mock
+	if err := resourceGithubRepositoryDelete(d, meta); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAccGithubRepository_providerDefaults(t *testing.T) {
+	var repo github.Repository
+
+	rn := "github_repository.foo"
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryConfigProviderDefaults(randString, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryExists(rn, &repo),
+					resource.TestCheckResourceAttr(rn, "has_issues", "true"),
+					resource.TestCheckResourceAttr(rn, "allow_merge_commit", "false"),
+					resource.TestCheckResourceAttr(rn, "allow_rebase_merge", "true"),
+					resource.TestCheckResourceAttr(rn, "topics.#", "1"),
+					testAccCheckGithubRepositoryTopics(&repo, []string{"own", "managed"}),
+				),
+			},
+			{
+				// Arguments set on the repository win over the defaults
+				Config: testAccGithubRepositoryConfigProviderDefaults(randString, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubRepositoryExists(rn, &repo),
+					resource.TestCheckResourceAttr(rn, "allow_rebase_merge", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGithubRepositoryTopics(repo *github.Repository, want []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(repo.Topics) != len(want) {
+			return fmt.Errorf("got topics %v; want %v", repo.Topics, want)
+		}
+		for _, t := range want {
+			if !containsString(repo.Topics, t) {
+				return fmt.Errorf("got topics %v; want %v", repo.Topics, want)
+			}
+		}
+		return nil
+	}
+}
+
 func testAccCheckGithubRepositoryExists(n string, repo *github.Repository) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -881,3 +1228,64 @@ resource "github_branch_protection" "repo_name_master" {
 }
 `, randString)
 }
+
+func testAccGithubRepositoryConfigVisibility(randString string, private, allowVisibilityChange bool) string {
+	return fmt.Sprintf(`
+resource "github_repository" "foo" {
+  name                    = "tf-acc-test-%s"
+  private                 = %t
+  allow_visibility_change = %t
+}
+`, randString, private, allowVisibilityChange)
+}
+
+func testAccGithubRepositoryConfigFromTemplate(randString string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "template" {
+  name        = "tf-acc-test-template-%s"
+  auto_init   = true
+  is_template = true
+}
+
+resource "github_repository_workflow" "template" {
+  repository = "${github_repository.template.name}"
+  name       = "ci"
+  content    = "name: CI of {{ repository }}\\non: push\\njobs: {}\\n"
+}
+
+resource "github_repository" "foo" {
+  name     = "tf-acc-test-%s"
+  has_wiki = false
+
+  template {
+    owner      = "%s"
+    repository = "${github_repository.template.name}"
+    files      = [".github/workflows/ci.yml"]
+
+    vars = {
+      repository = "tf-acc-test-%s"
+    }
+  }
+
+  depends_on = ["github_repository_workflow.template"]
+}
+`, randString, randString, testOrganization, randString)
+}
+
+func testAccGithubRepositoryConfigProviderDefaults(randString, allowRebaseMerge string) string {
+	return fmt.Sprintf(`
+provider "github" {
+  repository_defaults {
+    has_issues         = true
+    allow_merge_commit = false
+    topics             = ["managed"]
+  }
+}
+
+resource "github_repository" "foo" {
+  name               = "tf-acc-test-%s"
+  allow_rebase_merge = %s
+  topics             = ["own"]
+}
+`, randString, allowRebaseMerge)
+}
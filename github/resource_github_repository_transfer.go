@@ -0,0 +1,161 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+type repositoryTransferRequest struct {
+	NewOwner string  `json:"new_owner"`
+	NewName  string  `json:"new_name,omitempty"`
+	TeamIDs  []int64 `json:"team_ids,omitempty"`
+}
+
+// A repository transfer moves a repository of the organization to another
+// owner when it is created. It has the ID `<new owner>/<name>` of the moved
+// repository, which stays with its new owner when the transfer is destroyed.
+func resourceGithubRepositoryTransfer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryTransferCreate,
+		Read:   resourceGithubRepositoryTransferRead,
+		Delete: resourceGithubRepositoryTransferDelete,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"new_owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"new_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			// Teams of the new owner, which get access to the repository
+			"team_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Set:      schema.HashInt,
+			},
+			"full_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repo_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubRepositoryTransferCreate(d *schema.ResourceData, meta interface{}) error {
+	err := checkOwner(meta)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	req := &repositoryTransferRequest{
+		NewOwner: d.Get("new_owner").(string),
+		NewName:  d.Get("new_name").(string),
+	}
+	for _, id := range d.Get("team_ids").(*schema.Set).List() {
+		req.TeamIDs = append(req.TeamIDs, int64(id.(int)))
+	}
+
+	// GitHub answers 202 Accepted with the repository at its new location,
+	// and moves it in the background
+	log.Printf("[DEBUG] Transferring repository %s/%s to: %s", owner, repoName, req.NewOwner)
+	repo := new(github.Repository)
+	_, err = apiRequest(ctx, client, "POST", fmt.Sprintf("repos/%s/%s/transfer", owner, repoName), req, repo)
+	if aErr, ok := err.(*github.AcceptedError); ok {
+		err = json.Unmarshal(aErr.Raw, repo)
+	}
+	if err != nil {
+		return err
+	}
+	forgetRepositoryID(meta, repoName)
+
+	newName := req.NewName
+	if newName == "" {
+		newName = repoName
+	}
+	d.SetId(fmt.Sprintf("%s/%s", req.NewOwner, newName))
+
+	log.Printf("[DEBUG] Waiting for repository to be transferred: %s", d.Id())
+	err = resource.Retry(timeLeft(ctx), func() *resource.RetryError {
+		_, err := apiRequest(ctx, client, "GET", "repos/"+d.Id(), nil, nil)
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+				return resource.RetryableError(fmt.Errorf("Repository %s/%s was not transferred to %s yet", owner, repoName, req.NewOwner))
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return untilFound(d, meta, resourceGithubRepositoryTransferRead)
+}
+
+func resourceGithubRepositoryTransferRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("Unexpected ID format (%q). Expected owner/name", d.Id())
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading transferred repository: %s", d.Id())
+	repo, _, err := client.Repositories.Get(ctx, parts[0], parts[1])
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing repository transfer %s from state because the repository no longer exists in GitHub",
+				d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("new_owner", parts[0])
+	d.Set("new_name", parts[1])
+	d.Set("full_name", repo.GetFullName())
+	d.Set("repo_id", repo.GetID())
+
+	return nil
+}
+
+func resourceGithubRepositoryTransferDelete(d *schema.ResourceData, meta interface{}) error {
+	// Transferring the repository back may not be allowed to the same
+	// credentials, so it is left with its new owner
+	log.Printf("[DEBUG] Removing repository transfer %s from state; the repository remains with its new owner", d.Id())
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestGithubRepositoryTransferCreate(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/repos/example/legacy/transfer",
+			ExpectedMethod: "POST",
+			ExpectedBody:   []byte(`{"new_owner":"consolidated","new_name":"service","team_ids":[12]}` + "\n"),
+			StatusCode:     202,
+			ResponseBody:   `{"id": 1296269, "name": "service", "full_name": "consolidated/service"}`,
+		},
+		{
+			ExpectedUri:  "/repos/consolidated/service",
+			StatusCode:   200,
+			ResponseBody: `{"id": 1296269, "name": "service", "full_name": "consolidated/service"}`,
+		},
+		{
+			ExpectedUri:  "/repos/consolidated/service",
+			StatusCode:   200,
+			ResponseBody: `{"id": 1296269, "name": "service", "full_name": "consolidated/service"}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubRepositoryTransfer().Schema, map[string]interface{}{
+		"repository": "legacy",
+		"new_owner":  "consolidated",
+		"new_name":   "service",
+		"team_ids":   []interface{}{12},
+	})
+	if err := resourceGithubRepositoryTransferCreate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "consolidated/service" {
+		t.Fatalf("Expected the full name of the transferred repository as ID, got %q", d.Id())
+	}
+	if v := d.Get("repo_id"); v != 1296269 {
+		t.Fatalf("Unexpected repository ID %v", v)
+	}
+}
+
+func TestGithubRepositoryReadTransferred(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/repos/example/legacy",
+			StatusCode:   200,
+			ResponseBody: `{"id": 1296269, "name": "service", "owner": {"login": "consolidated"}}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	defaults := &repositoryDefaults{}
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{repositoryDefaults: defaults}}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubRepository(defaults).Schema, map[string]interface{}{})
+	d.SetId("legacy")
+	if err := resourceGithubRepositoryRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("Expected a transferred repository to be removed from state, got %q", d.Id())
+	}
+}
@@ -0,0 +1,307 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	yaml "github.com/zclconf/go-cty-yaml"
+)
+
+type repositoryWorkflow struct {
+	ID    *int64  `json:"id,omitempty"`
+	Name  *string `json:"name,omitempty"`
+	Path  *string `json:"path,omitempty"`
+	State *string `json:"state,omitempty"`
+}
+
+// A repository workflow is a workflow file committed to .github/workflows of
+// a repository, with the ID `<repository>:<name>`.
+func resourceGithubRepositoryWorkflow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubRepositoryWorkflowCreate,
+		Read:   resourceGithubRepositoryWorkflowRead,
+		Update: resourceGithubRepositoryWorkflowUpdate,
+		Delete: resourceGithubRepositoryWorkflowDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGithubRepositoryWorkflowImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_.-]+$`), "must be a file name without extension"),
+			},
+			"branch": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateWorkflowContent,
+			},
+			"commit_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workflow_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"sha": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateWorkflowContent checks that a workflow is a YAML mapping with the
+// keys every workflow needs, so a broken workflow fails the plan rather than
+// silently never running. YAML 1.1 reads the `on` key as true.
+func validateWorkflowContent(v interface{}, k string) (ws []string, errors []error) {
+	ty, err := yaml.Standard.ImpliedType([]byte(v.(string)))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s is not valid YAML: %s", k, err))
+		return
+	}
+	if !ty.IsObjectType() {
+		errors = append(errors, fmt.Errorf("%s must be a YAML mapping", k))
+		return
+	}
+	if !ty.HasAttribute("on") && !ty.HasAttribute("true") {
+		errors = append(errors, fmt.Errorf("%s must have the events which trigger the workflow under `on`", k))
+	}
+	if !ty.HasAttribute("jobs") {
+		errors = append(errors, fmt.Errorf("%s must have the jobs of the workflow under `jobs`", k))
+	}
+
+	return
+}
+
+func repositoryWorkflowPath(name string) string {
+	return fmt.Sprintf(".github/workflows/%s.yml", name)
+}
+
+func resourceGithubRepositoryWorkflowCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	name := d.Get("name").(string)
+	path := repositoryWorkflowPath(name)
+	ctx := stopContext(meta)
+
+	// An existing workflow file is taken over rather than reported as a
+	// conflict
+	var sha *string
+	opt := &github.RepositoryContentGetOptions{Ref: d.Get("branch").(string)}
+	file, _, _, err := client.Repositories.GetContents(ctx, owner, repoName, path, opt)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); !ok || ghErr.Response.StatusCode != http.StatusNotFound {
+			return err
+		}
+	} else if file != nil {
+		sha = file.SHA
+	}
+
+	log.Printf("[DEBUG] Writing workflow: %s/%s/%s", owner, repoName, path)
+	err = writeRepositoryWorkflow(ctx, d, meta, sha, fmt.Sprintf("Add workflow %s", name))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&repoName, &name))
+
+	err = waitForRepositoryWorkflow(ctx, d, meta)
+	if err != nil {
+		return err
+	}
+
+	return untilFound(d, meta, resourceGithubRepositoryWorkflowRead)
+}
+
+// waitForRepositoryWorkflow waits for GitHub to register the workflow which
+// was just committed. Only workflows on the default branch are registered.
+func waitForRepositoryWorkflow(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	if d.Get("branch").(string) != "" {
+		return nil
+	}
+
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Waiting for workflow to be registered: %s/%s/%s", owner, repoName, name)
+	return resource.Retry(timeLeft(ctx), func() *resource.RetryError {
+		_, err := apiRequest(ctx, client, "GET",
+			fmt.Sprintf("repos/%s/%s/actions/workflows/%s.yml", owner, repoName, name), nil, nil)
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+				return resource.RetryableError(fmt.Errorf("GitHub did not register workflow %s in %s/%s", name, owner, repoName))
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func resourceGithubRepositoryWorkflowRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, name, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	path := repositoryWorkflowPath(name)
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
+
+	log.Printf("[DEBUG] Reading workflow: %s/%s/%s", owner, repoName, path)
+	opt := &github.RepositoryContentGetOptions{Ref: d.Get("branch").(string)}
+	file, _, resp, err := client.Repositories.GetContents(ctx, owner, repoName, path, opt)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing workflow %s/%s/%s from state because it no longer exists in GitHub",
+					owner, repoName, path)
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	if file == nil {
+		return fmt.Errorf("%s in %s/%s is not a file", path, owner, repoName)
+	}
+
+	content, err := file.GetContent()
+	if err != nil {
+		return err
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("repository", repoName)
+	d.Set("name", name)
+	d.Set("path", path)
+	d.Set("content", content)
+	d.Set("sha", file.GetSHA())
+
+	// Workflows on other branches than the default one are not registered
+	workflow := new(repositoryWorkflow)
+	if d.Get("branch").(string) == "" {
+		_, err = apiRequest(context.WithValue(stopContext(meta), ctxId, d.Id()), client, "GET",
+			fmt.Sprintf("repos/%s/%s/actions/workflows/%s.yml", owner, repoName, name), nil, workflow)
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); !ok || ghErr.Response.StatusCode != http.StatusNotFound {
+				return err
+			}
+		}
+	}
+	d.Set("workflow_id", workflow.ID)
+
+	return nil
+}
+
+func resourceGithubRepositoryWorkflowUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Updating workflow: %s", d.Id())
+	err := writeRepositoryWorkflow(ctx, d, meta, github.String(d.Get("sha").(string)),
+		fmt.Sprintf("Update workflow %s", d.Get("name").(string)))
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubRepositoryWorkflowRead(d, meta)
+}
+
+func resourceGithubRepositoryWorkflowDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName, name, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	path := repositoryWorkflowPath(name)
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	opt := &github.RepositoryContentFileOptions{
+		Message: github.String(fmt.Sprintf("Delete workflow %s", name)),
+		SHA:     github.String(d.Get("sha").(string)),
+	}
+	if branch, ok := d.GetOk("branch"); ok {
+		opt.Branch = github.String(branch.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting workflow: %s/%s/%s", owner, repoName, path)
+	_, _, err = client.Repositories.DeleteFile(ctx, owner, repoName, path, opt)
+	return err
+}
+
+func resourceGithubRepositoryWorkflowImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	repoName, name, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Invalid ID specified. Supplied ID must be written as <repository>:<name>")
+	}
+
+	d.Set("repository", repoName)
+	d.Set("name", name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// writeRepositoryWorkflow commits the content of the workflow, with the
+// commit_message of d or else the given default message.
+func writeRepositoryWorkflow(ctx context.Context, d *schema.ResourceData, meta interface{}, sha *string, message string) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	repoName := d.Get("repository").(string)
+	path := repositoryWorkflowPath(d.Get("name").(string))
+
+	if v, ok := d.GetOk("commit_message"); ok {
+		message = v.(string)
+	}
+	opt := &github.RepositoryContentFileOptions{
+		Message: github.String(message),
+		Content: []byte(d.Get("content").(string)),
+		SHA:     sha,
+	}
+	if branch, ok := d.GetOk("branch"); ok {
+		opt.Branch = github.String(branch.(string))
+	}
+
+	_, _, err := client.Repositories.UpdateFile(ctx, owner, repoName, path, opt)
+	return err
+}
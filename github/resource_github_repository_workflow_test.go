@@ -0,0 +1,79 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestValidateWorkflowContent(t *testing.T) {
+	cases := []struct {
+		content string
+		errors  int
+	}{
+		{"on: push\njobs:\n  build:\n    runs-on: ubuntu-latest\n", 0},
+		{"name: CI\n'on':\n  workflow_dispatch:\njobs: {}\n", 0},
+		{"on: push\n", 1},
+		{"name: CI\n", 2},
+		{"- on\n- jobs\n", 1},
+		{"jobs: [build\n", 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateWorkflowContent(tc.content, "content")
+		if len(errors) != tc.errors {
+			t.Fatalf("Expected %d errors for %q, got %v", tc.errors, tc.content, errors)
+		}
+	}
+}
+
+func TestAccGithubRepositoryWorkflow_basic(t *testing.T) {
+	rn := "github_repository_workflow.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-workflow-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubRepositoryWorkflowConfig(repoName, "push"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "path", ".github/workflows/ci.yml"),
+					resource.TestCheckResourceAttrSet(rn, "workflow_id"),
+					resource.TestCheckResourceAttrSet(rn, "sha"),
+				),
+			},
+			{
+				Config: testAccGithubRepositoryWorkflowConfig(repoName, "pull_request"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "content",
+						"on: pull_request\njobs:\n  build:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo ok\n"),
+				),
+			},
+			{
+				ResourceName:            rn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_message"},
+			},
+		},
+	})
+}
+
+func testAccGithubRepositoryWorkflowConfig(repoName, event string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name      = "%s"
+  auto_init = true
+}
+
+resource "github_repository_workflow" "test" {
+  repository = "${github_repository.test.name}"
+  name       = "ci"
+  content    = "on: %s\njobs:\n  build:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo ok\n"
+}
+`, repoName, event)
+}
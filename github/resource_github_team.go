@@ -4,7 +4,9 @@ import (
 	"context"
 	"log"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/google/go-github/v28/github"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,8 +19,9 @@ func resourceGithubTeam() *schema.Resource {
 		Update: resourceGithubTeamUpdate,
 		Delete: resourceGithubTeamDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceGithubTeamImport,
 		},
+		CustomizeDiff: resourceGithubTeamDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -43,10 +46,31 @@ func resourceGithubTeam() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"expected_members": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"remove_unmanaged_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"unmanaged_members": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 			"slug": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -74,7 +98,7 @@ func resourceGithubTeamCreate(d *schema.ResourceData, meta interface{}) error {
 		id := int64(parentTeamID.(int))
 		newTeam.ParentTeamID = &id
 	}
-	ctx := context.Background()
+	ctx := stopContext(meta)
 
 	log.Printf("[DEBUG] Creating team: %s (%s)", name, orgName)
 	githubTeam, _, err := client.Teams.CreateTeam(ctx,
@@ -83,6 +107,10 @@ func resourceGithubTeamCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// The team is recorded before it is configured further, so a failure
+	// leaves it tainted in the state rather than orphaned in GitHub
+	d.SetId(strconv.FormatInt(*githubTeam.ID, 10))
+
 	if ldapDN := d.Get("ldap_dn").(string); ldapDN != "" {
 		mapping := &github.TeamLDAPMapping{
 			LDAPDN: github.String(ldapDN),
@@ -93,28 +121,33 @@ func resourceGithubTeamCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	d.SetId(strconv.FormatInt(*githubTeam.ID, 10))
-	return resourceGithubTeamRead(d, meta)
+	// The creator of a team becomes one of its maintainers
+	if d.Get("remove_unmanaged_members").(bool) {
+		err = removeGithubTeamUnmanagedMembers(d, meta, *githubTeam.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return untilFound(d, meta, resourceGithubTeamRead)
 }
 
 func resourceGithubTeamRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*Organization).client
-
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading team: %s", d.Id())
-	team, resp, err := client.Teams.GetTeam(ctx, id)
+	team := new(github.Team)
+	resp, err := teamRequest(ctx, meta, "GET", id, "", teamsNestedPreviewMediaType, nil, team)
 	if err != nil {
 		if ghErr, ok := err.(*github.ErrorResponse); ok {
 			if ghErr.Response.StatusCode == http.StatusNotModified {
-				return nil
+				// Members are added without changing the team itself
+				return readGithubTeamUnmanagedMembers(d, meta, id)
 			}
 			if ghErr.Response.StatusCode == http.StatusNotFound {
 				log.Printf("[WARN] Removing team %s from state because it no longer exists in GitHub",
@@ -127,6 +160,7 @@ func resourceGithubTeamRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("etag", resp.Header.Get("ETag"))
+	d.Set("node_id", team.GetNodeID())
 	d.Set("description", team.Description)
 	d.Set("name", team.Name)
 	d.Set("privacy", team.Privacy)
@@ -137,8 +171,9 @@ func resourceGithubTeamRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("ldap_dn", team.GetLDAPDN())
 	d.Set("slug", team.GetSlug())
+	d.Set("remove_unmanaged_members", d.Get("remove_unmanaged_members").(bool))
 
-	return nil
+	return readGithubTeamUnmanagedMembers(d, meta, id)
 }
 
 func resourceGithubTeamUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -158,13 +193,25 @@ func resourceGithubTeamUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Updating team: %s", d.Id())
-	team, _, err := client.Teams.EditTeam(ctx, teamId, editedTeam)
+	team := new(github.Team)
+	_, err = teamRequest(ctx, meta, "PATCH", teamId, "", teamsNestedPreviewMediaType, editedTeam, team)
 	if err != nil {
 		return err
 	}
+	if d.HasChange("name") {
+		// Renaming the team changes its slug
+		meta.(*Organization).base().teamIDs.forget(d.Get("slug").(string))
+	}
+
+	if d.Get("remove_unmanaged_members").(bool) {
+		err = removeGithubTeamUnmanagedMembers(d, meta, teamId)
+		if err != nil {
+			return err
+		}
+	}
 
 	if d.HasChange("ldap_dn") {
 		ldapDN := d.Get("ldap_dn").(string)
@@ -182,15 +229,131 @@ func resourceGithubTeamUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGithubTeamDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*Organization).client
-
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting team: %s", d.Id())
-	_, err = client.Teams.DeleteTeam(ctx, id)
-	return err
+	_, err = teamRequest(ctx, meta, "DELETE", id, "", "", nil, nil)
+	if err != nil {
+		return err
+	}
+	meta.(*Organization).base().teamIDs.forget(d.Get("slug").(string))
+
+	return nil
+}
+
+// unmanagedTeamMembers returns the sorted members of a team which are not
+// expected by the configuration. Logins are compared case-insensitively.
+func unmanagedTeamMembers(members, expected []string) []string {
+	expectedSet := make(map[string]bool, len(expected))
+	for _, login := range expected {
+		expectedSet[strings.ToLower(login)] = true
+	}
+
+	unmanaged := []string{}
+	for _, login := range members {
+		if !expectedSet[strings.ToLower(login)] {
+			unmanaged = append(unmanaged, login)
+		}
+	}
+
+	sort.Strings(unmanaged)
+	return unmanaged
+}
+
+func listGithubTeamUnmanagedMembers(expected *schema.Set, meta interface{}, teamID int64) ([]string, error) {
+	ctx := context.WithValue(stopContext(meta), ctxId, strconv.FormatInt(teamID, 10))
+	members, err := listGithubTeamMembers(ctx, meta, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmanagedTeamMembers(members, expandStringList(expected.List())), nil
+}
+
+// readGithubTeamUnmanagedMembers records the members of the team which are
+// not expected, if the configuration lists the members it expects.
+func readGithubTeamUnmanagedMembers(d *schema.ResourceData, meta interface{}, teamID int64) error {
+	expected, ok := d.GetOk("expected_members")
+	if !ok {
+		d.Set("unmanaged_members", []string{})
+		return nil
+	}
+
+	unmanaged, err := listGithubTeamUnmanagedMembers(expected.(*schema.Set), meta, teamID)
+	if err != nil {
+		return err
+	}
+	d.Set("unmanaged_members", unmanaged)
+
+	return nil
+}
+
+func removeGithubTeamUnmanagedMembers(d *schema.ResourceData, meta interface{}, teamID int64) error {
+	// The members are listed again, as members may have become expected
+	// since the team was last read
+	unmanaged, err := listGithubTeamUnmanagedMembers(d.Get("expected_members").(*schema.Set), meta, teamID)
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	for _, username := range unmanaged {
+		log.Printf("[DEBUG] Removing unmanaged member %s from team: %s", username, d.Id())
+		_, err = teamRequest(ctx, meta, "DELETE", teamID, "/memberships/"+username, "", nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceGithubTeamDiff reports the members of the team which are not
+// expected in the plan, planning their removal. The members are only removed
+// if remove_unmanaged_members is set; otherwise they keep being reported
+// until they are expected or removed by hand.
+func resourceGithubTeamDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	expected, ok := d.GetOk("expected_members")
+	if !ok {
+		return nil
+	}
+
+	teamID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return unconvertibleIdErr(d.Id(), err)
+	}
+
+	// The expected members may have changed since the team was last read
+	unmanaged, err := listGithubTeamUnmanagedMembers(expected.(*schema.Set), meta, teamID)
+	if err != nil {
+		return err
+	}
+	if len(unmanaged) > 0 {
+		log.Printf("[WARN] Team %s has members which are not expected: %s", d.Id(), strings.Join(unmanaged, ", "))
+		// Only an apply which removes them changes unmanaged_members; otherwise
+		// the plan would never settle
+		if d.Get("remove_unmanaged_members").(bool) {
+			return d.SetNew("unmanaged_members", []string{})
+		}
+	}
+
+	return nil
+}
+
+// resourceGithubTeamImport imports a team by its numeric ID or its slug.
+func resourceGithubTeamImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	teamID, err := getTeamID(stopContext(meta), meta, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(strconv.FormatInt(teamID, 10))
+
+	return []*schema.ResourceData{d}, nil
 }
@@ -19,21 +19,21 @@ func resourceGithubTeamMembership() *schema.Resource {
 		Update: resourceGithubTeamMembershipCreateOrUpdate,
 		Delete: resourceGithubTeamMembershipDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importTeamTwoPartID,
 		},
 
 		Schema: map[string]*schema.Schema{
+			// Either the numeric ID or the slug of the team
 			"team_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validateTeamIDFunc,
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
 			},
 			"username": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: caseInsensitive(),
+				DiffSuppressFunc: suppressRenamedUsername(),
 			},
 			"role": {
 				Type:         schema.TypeString,
@@ -41,6 +41,10 @@ func resourceGithubTeamMembership() *schema.Resource {
 				Default:      "member",
 				ValidateFunc: validateValueFunc([]string{"member", "maintainer"}),
 			},
+			"user_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -50,37 +54,36 @@ func resourceGithubTeamMembership() *schema.Resource {
 }
 
 func resourceGithubTeamMembershipCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*Organization).client
-
-	teamIdString := d.Get("team_id").(string)
-	teamId, err := strconv.ParseInt(teamIdString, 10, 64)
+	ctx := stopContext(meta)
+	teamId, err := getTeamID(ctx, meta, d.Get("team_id").(string))
 	if err != nil {
-		return unconvertibleIdErr(teamIdString, err)
+		return err
 	}
-	ctx := context.Background()
+	teamIdString := strconv.FormatInt(teamId, 10)
 
 	username := d.Get("username").(string)
+	if !d.IsNewResource() {
+		if username, err = userLoginFromID(d); err != nil {
+			return err
+		}
+	}
 	role := d.Get("role").(string)
 
 	log.Printf("[DEBUG] Creating team membership: %s/%s (%s)", teamIdString, username, role)
-	_, _, err = client.Teams.AddTeamMembership(ctx,
-		teamId,
-		username,
+	_, err = teamRequest(ctx, meta, "PUT", teamId, "/memberships/"+username, "",
 		&github.TeamAddTeamMembershipOptions{
 			Role: role,
-		},
-	)
+		}, nil)
 	if err != nil {
 		return err
 	}
 
 	d.SetId(buildTwoPartID(&teamIdString, &username))
 
-	return resourceGithubTeamMembershipRead(d, meta)
+	return untilFound(d, meta, resourceGithubTeamMembershipRead)
 }
 
 func resourceGithubTeamMembershipRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*Organization).client
 	teamIdString, username, err := parseTwoPartID(d.Id())
 	if err != nil {
 		return err
@@ -90,20 +93,29 @@ func resourceGithubTeamMembershipRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return unconvertibleIdErr(teamIdString, err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading team membership: %s/%s", teamIdString, username)
-	membership, resp, err := client.Teams.GetTeamMembership(ctx,
-		teamId, username)
+	membership := new(github.Membership)
+	resp, err := teamRequest(ctx, meta, "GET", teamId, "/memberships/"+username, "", nil, membership)
 	if err != nil {
 		if ghErr, ok := err.(*github.ErrorResponse); ok {
 			if ghErr.Response.StatusCode == http.StatusNotModified {
 				return nil
 			}
 			if ghErr.Response.StatusCode == http.StatusNotFound {
+				renamed, err := renamedUserLogin(stopContext(meta), d, meta, username)
+				if err != nil {
+					return err
+				}
+				if renamed != "" {
+					if err := renameUserID(d, renamed); err != nil {
+						return err
+					}
+					return resourceGithubTeamMembershipRead(d, meta)
+				}
+
 				log.Printf("[WARN] Removing team membership %s from state because it no longer exists in GitHub",
 					d.Id())
 				d.SetId("")
@@ -116,26 +128,34 @@ func resourceGithubTeamMembershipRead(d *schema.ResourceData, meta interface{})
 	team, user := getTeamAndUserFromURL(membership.URL)
 
 	d.Set("etag", resp.Header.Get("ETag"))
-	d.Set("username", user)
+	// The membership does not include the user, whose ID is looked up once
+	if d.Get("user_id").(int) == 0 {
+		userID, err := getUserID(meta, user)
+		if err != nil {
+			return err
+		}
+		d.Set("user_id", userID)
+	}
+	setUsername(d, user)
 	d.Set("role", membership.Role)
-	d.Set("team_id", team)
+	setTeamIDReference(d, team)
 
 	return nil
 }
 
 func resourceGithubTeamMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*Organization).client
-
-	teamIdString := d.Get("team_id").(string)
+	teamIdString, username, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
 	teamId, err := strconv.ParseInt(teamIdString, 10, 64)
 	if err != nil {
 		return unconvertibleIdErr(teamIdString, err)
 	}
-	username := d.Get("username").(string)
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting team membership: %s/%s", teamIdString, username)
-	_, err = client.Teams.RemoveTeamMembership(ctx, teamId, username)
+	_, err = teamRequest(ctx, meta, "DELETE", teamId, "/memberships/"+username, "", nil, nil)
 
 	return err
 }
@@ -145,7 +165,8 @@ func getTeamAndUserFromURL(url *string) (string, string) {
 
 	urlSlice := strings.Split(*url, "/")
 	for v := range urlSlice {
-		if urlSlice[v] == "teams" {
+		// Legacy routes use "teams", organization-scoped ones "team"
+		if urlSlice[v] == "teams" || urlSlice[v] == "team" {
 			team = urlSlice[v+1]
 		}
 		if urlSlice[v] == "memberships" {
@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
@@ -17,26 +18,27 @@ func resourceGithubTeamRepository() *schema.Resource {
 		Update: resourceGithubTeamRepositoryUpdate,
 		Delete: resourceGithubTeamRepositoryDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importTeamTwoPartID,
 		},
+		CustomizeDiff: resourceGithubTeamRepositoryDiff,
 
 		Schema: map[string]*schema.Schema{
+			// Either the numeric ID or the slug of the team
 			"team_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validateTeamIDFunc,
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
 			},
 			"repository": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			// A built-in or custom repository role
 			"permission": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "pull",
-				ValidateFunc: validateValueFunc([]string{"pull", "push", "admin"}),
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "pull",
 			},
 			"etag": {
 				Type:     schema.TypeString,
@@ -52,28 +54,23 @@ func resourceGithubTeamRepositoryCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	client := meta.(*Organization).client
-
-	teamIdString := d.Get("team_id").(string)
-	teamId, err := strconv.ParseInt(teamIdString, 10, 64)
-	if err != nil {
-		return unconvertibleIdErr(teamIdString, err)
-	}
 	orgName := meta.(*Organization).name
 	repoName := d.Get("repository").(string)
 	permission := d.Get("permission").(string)
-	ctx := context.Background()
+	ctx := stopContext(meta)
+
+	teamId, err := getTeamID(ctx, meta, d.Get("team_id").(string))
+	if err != nil {
+		return err
+	}
+	teamIdString := strconv.FormatInt(teamId, 10)
 
 	log.Printf("[DEBUG] Creating team repository association: %s:%s (%s/%s)",
 		teamIdString, permission, orgName, repoName)
-	_, err = client.Teams.AddTeamRepo(ctx,
-		teamId,
-		orgName,
-		repoName,
+	_, err = teamRequest(ctx, meta, "PUT", teamId, fmt.Sprintf("/repos/%s/%s", orgName, repoName), "",
 		&github.TeamAddTeamRepoOptions{
 			Permission: permission,
-		},
-	)
+		}, nil)
 
 	if err != nil {
 		return err
@@ -81,7 +78,7 @@ func resourceGithubTeamRepositoryCreate(d *schema.ResourceData, meta interface{}
 
 	d.SetId(buildTwoPartID(&teamIdString, &repoName))
 
-	return resourceGithubTeamRepositoryRead(d, meta)
+	return untilFound(d, meta, resourceGithubTeamRepositoryRead)
 }
 
 func resourceGithubTeamRepositoryRead(d *schema.ResourceData, meta interface{}) error {
@@ -90,8 +87,6 @@ func resourceGithubTeamRepositoryRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	client := meta.(*Organization).client
-
 	teamIdString, repoName, err := parseTwoPartID(d.Id())
 	if err != nil {
 		return err
@@ -102,13 +97,13 @@ func resourceGithubTeamRepositoryRead(d *schema.ResourceData, meta interface{})
 		return unconvertibleIdErr(teamIdString, err)
 	}
 	orgName := meta.(*Organization).name
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading team repository association: %s (%s/%s)", teamIdString, orgName, repoName)
-	repo, resp, repoErr := client.Teams.IsTeamRepo(ctx, teamId, orgName, repoName)
+	repo := new(teamRepository)
+	resp, repoErr := teamRequest(ctx, meta, "GET", teamId, fmt.Sprintf("/repos/%s/%s", orgName, repoName),
+		teamRepositoryMediaType, nil, repo)
 	if repoErr != nil {
 		if ghErr, ok := repoErr.(*github.ErrorResponse); ok {
 			if ghErr.Response.StatusCode == http.StatusNotModified {
@@ -121,14 +116,14 @@ func resourceGithubTeamRepositoryRead(d *schema.ResourceData, meta interface{})
 				return nil
 			}
 		}
-		return err
+		return repoErr
 	}
 
 	d.Set("etag", resp.Header.Get("ETag"))
-	d.Set("team_id", teamIdString)
+	setTeamIDReference(d, teamIdString)
 	d.Set("repository", repo.Name)
 
-	permName, permErr := getRepoPermission(repo.Permissions)
+	permName, permErr := repo.permission()
 	if permErr != nil {
 		return permErr
 	}
@@ -144,29 +139,25 @@ func resourceGithubTeamRepositoryUpdate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	client := meta.(*Organization).client
-
-	teamIdString := d.Get("team_id").(string)
+	teamIdString, repoName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
 	teamId, err := strconv.ParseInt(teamIdString, 10, 64)
 	if err != nil {
 		return unconvertibleIdErr(teamIdString, err)
 	}
 	orgName := meta.(*Organization).name
-	repoName := d.Get("repository").(string)
 	permission := d.Get("permission").(string)
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Updating team repository association: %s:%s (%s/%s)",
 		teamIdString, permission, orgName, repoName)
 	// the go-github library's AddTeamRepo method uses the add/update endpoint from Github API
-	_, err = client.Teams.AddTeamRepo(ctx,
-		teamId,
-		orgName,
-		repoName,
+	_, err = teamRequest(ctx, meta, "PUT", teamId, fmt.Sprintf("/repos/%s/%s", orgName, repoName), "",
 		&github.TeamAddTeamRepoOptions{
 			Permission: permission,
-		},
-	)
+		}, nil)
 
 	if err != nil {
 		return err
@@ -182,21 +173,44 @@ func resourceGithubTeamRepositoryDelete(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	client := meta.(*Organization).client
-
-	teamIdString := d.Get("team_id").(string)
-
+	teamIdString, repoName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
 	teamId, err := strconv.ParseInt(teamIdString, 10, 64)
 	if err != nil {
 		return unconvertibleIdErr(teamIdString, err)
 	}
 	orgName := meta.(*Organization).name
-	repoName := d.Get("repository").(string)
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting team repository association: %s (%s/%s)",
 		teamIdString, orgName, repoName)
-	_, err = client.Teams.RemoveTeamRepo(ctx,
-		teamId, orgName, repoName)
+	_, err = teamRequest(ctx, meta, "DELETE", teamId, fmt.Sprintf("/repos/%s/%s", orgName, repoName), "", nil, nil)
 	return err
 }
+
+func resourceGithubTeamRepositoryDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// Permissions only known when applying are validated by GitHub then
+	if !d.HasChange("permission") || !d.NewValueKnown("permission") {
+		return nil
+	}
+
+	return validateRepoPermission(stopContext(meta), meta, d.Get("permission").(string))
+}
+
+// teamRepository is a repository of a team along with the name of the role of
+// the team, which the vendored library does not expose yet.
+type teamRepository struct {
+	github.Repository
+	RoleName *string `json:"role_name,omitempty"`
+}
+
+// permission returns the role of the team, which may be a custom role,
+// falling back to the built-in roles its permissions amount to.
+func (r *teamRepository) permission() (string, error) {
+	if r.RoleName != nil && *r.RoleName != "" {
+		return repoPermissionFromRoleName(*r.RoleName), nil
+	}
+	return getRepoPermission(r.Permissions)
+}
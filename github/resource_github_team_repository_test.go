@@ -47,6 +47,36 @@ func TestAccGithubTeamRepository_basic(t *testing.T) {
 	})
 }
 
+func TestAccGithubTeamRepository_slug(t *testing.T) {
+	var repository github.Repository
+
+	rn := "github_team_repository.test_team_test_repo"
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-team-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubTeamRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubTeamRepositorySlugConfig(randString, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGithubTeamRepositoryExists(rn, &repository),
+					testAccCheckGithubTeamRepositoryRoleState("pull", &repository),
+					resource.TestCheckResourceAttrPair(rn, "team_id", "github_team.test_team", "slug"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: testAccGithubTeamRepositorySlugImportStateIdFunc(rn),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccCheckGetPermissions(t *testing.T) {
 	pullMap := map[string]bool{"pull": true, "push": false, "admin": false}
 	pushMap := map[string]bool{"pull": true, "push": true, "admin": false}
@@ -123,6 +153,17 @@ func testAccCheckGithubTeamRepositoryExists(n string, repository *github.Reposit
 	}
 }
 
+func testAccGithubTeamRepositorySlugImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not Found: %s", n)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["repository"]), nil
+	}
+}
+
 func testAccCheckGithubTeamRepositoryDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*Organization).client
 
@@ -196,3 +237,22 @@ resource "github_team_repository" "test_team_test_repo" {
 }
 `, randString, repoName)
 }
+
+func testAccGithubTeamRepositorySlugConfig(randString, repoName string) string {
+	return fmt.Sprintf(`
+resource "github_team" "test_team" {
+  name        = "tf-acc-test-team-repo-%s"
+  description = "Terraform acc test group"
+}
+
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_team_repository" "test_team_test_repo" {
+  team_id    = "${github_team.test_team.slug}"
+  repository = "${github_repository.test.name}"
+  permission = "pull"
+}
+`, randString, repoName)
+}
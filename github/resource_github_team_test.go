@@ -3,12 +3,15 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-github/v28/github"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -38,6 +41,7 @@ func TestAccGithubTeam_basic(t *testing.T) {
 					resource.TestCheckNoResourceAttr(rn, "parent_team_id"),
 					resource.TestCheckResourceAttr(rn, "ldap_dn", ""),
 					resource.TestCheckResourceAttr(rn, "slug", name),
+					resource.TestCheckResourceAttrSet(rn, "node_id"),
 				),
 			},
 			{
@@ -129,6 +133,120 @@ func TestAccGithubTeam_hierarchical(t *testing.T) {
 	})
 }
 
+func TestResourceGithubTeamCreate_partialState(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/orgs/example/teams",
+			ExpectedMethod: "POST",
+			ResponseBody:   `{"id": 1234, "name": "example"}`,
+			StatusCode:     201,
+		},
+		{
+			ExpectedUri:    "/admin/ldap/teams/1234/mapping",
+			ExpectedMethod: "PATCH",
+			ResponseBody:   `{"message": "Server Error"}`,
+			StatusCode:     500,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	d := schema.TestResourceDataRaw(t, resourceGithubTeam().Schema, map[string]interface{}{
+		"name":    "example",
+		"ldap_dn": "cn=example,ou=groups,dc=example,dc=com",
+	})
+	if err := resourceGithubTeamCreate(d, meta); err == nil {
+		t.Fatal("Expected the failure to map the team to be reported")
+	}
+
+	// The team was created, so it must be recorded to be tainted
+	if d.Id() != "1234" {
+		t.Fatalf("Expected the created team to be recorded, got ID %q", d.Id())
+	}
+}
+
+func TestResourceGithubTeamCreate_eventuallyConsistent(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:    "/orgs/example/teams",
+			ExpectedMethod: "POST",
+			ResponseBody:   `{"id": 1234, "name": "example"}`,
+			StatusCode:     201,
+		},
+		{
+			ExpectedUri:  "/teams/1234",
+			ResponseBody: `{"message": "Not Found"}`,
+			StatusCode:   404,
+		},
+		{
+			ExpectedUri:  "/teams/1234",
+			ResponseBody: `{"id": 1234, "name": "example", "slug": "example"}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{consistencyTimeout: 10 * time.Second}}
+	meta.teamsRoutes.resolved = true
+
+	d := schema.TestResourceDataRaw(t, resourceGithubTeam().Schema, map[string]interface{}{
+		"name": "example",
+	})
+	d.MarkNewResource()
+	if err := resourceGithubTeamCreate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	// The team GitHub did not find at first is read again
+	if d.Id() != "1234" || d.Get("slug").(string) != "example" {
+		t.Fatalf("Expected the created team to be read, got ID %q", d.Id())
+	}
+}
+
+func TestUnmanagedTeamMembers(t *testing.T) {
+	unmanaged := unmanagedTeamMembers([]string{"octocat", "Hubot", "monalisa"}, []string{"hubot", "OctoCat"})
+	if len(unmanaged) != 1 || unmanaged[0] != "monalisa" {
+		t.Fatalf("Expected only monalisa to be unmanaged, got %v", unmanaged)
+	}
+
+	if unmanaged := unmanagedTeamMembers([]string{}, []string{"octocat"}); len(unmanaged) != 0 {
+		t.Fatalf("Expected no unmanaged members, got %v", unmanaged)
+	}
+}
+
+func TestAccGithubTeam_unmanagedMembers(t *testing.T) {
+	rn := "github_team.foo"
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-%s", randString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The creator of the team becomes a maintainer which is
+				// reported, but not removed, without changing the plan
+				Config: testAccGithubTeamUnmanagedMembersConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "unmanaged_members.#", "1"),
+				),
+			},
+			{
+				Config: testAccGithubTeamUnmanagedMembersConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "unmanaged_members.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGithubTeamExists(n string, team *github.Team) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -215,6 +333,16 @@ resource "github_team" "foo" {
 `, teamName)
 }
 
+func testAccGithubTeamUnmanagedMembersConfig(teamName string, remove bool) string {
+	return fmt.Sprintf(`
+resource "github_team" "foo" {
+  name                     = "%s"
+  expected_members         = ["%s"]
+  remove_unmanaged_members = %t
+}
+`, teamName, testCollaborator, remove)
+}
+
 func testAccGithubTeamUpdateConfig(randString string) string {
 	return fmt.Sprintf(`
 resource "github_team" "foo" {
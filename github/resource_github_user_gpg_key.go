@@ -5,6 +5,7 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/google/go-github/v28/github"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -26,6 +27,23 @@ func resourceGithubUserGpgKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"emails": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"can_sign": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -38,7 +56,7 @@ func resourceGithubUserGpgKeyCreate(d *schema.ResourceData, meta interface{}) er
 	client := meta.(*Organization).client
 
 	pubKey := d.Get("armored_public_key").(string)
-	ctx := context.Background()
+	ctx := stopContext(meta)
 
 	log.Printf("[DEBUG] Creating user GPG key:\n%s", pubKey)
 	key, _, err := client.Users.CreateGPGKey(ctx, pubKey)
@@ -48,7 +66,7 @@ func resourceGithubUserGpgKeyCreate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(strconv.FormatInt(*key.ID, 10))
 
-	return resourceGithubUserGpgKeyRead(d, meta)
+	return untilFound(d, meta, resourceGithubUserGpgKeyRead)
 }
 
 func resourceGithubUserGpgKeyRead(d *schema.ResourceData, meta interface{}) error {
@@ -58,13 +76,11 @@ func resourceGithubUserGpgKeyRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading user GPG key: %s", d.Id())
-	key, _, err := client.Users.GetGPGKey(ctx, id)
+	key, resp, err := client.Users.GetGPGKey(ctx, id)
 	if err != nil {
 		if ghErr, ok := err.(*github.ErrorResponse); ok {
 			if ghErr.Response.StatusCode == http.StatusNotModified {
@@ -80,7 +96,24 @@ func resourceGithubUserGpgKeyRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	emails := make([]string, 0, len(key.Emails))
+	for _, e := range key.Emails {
+		emails = append(emails, e.GetEmail())
+	}
+
+	d.Set("etag", resp.Header.Get("ETag"))
 	d.Set("key_id", key.KeyID)
+	d.Set("emails", emails)
+	d.Set("can_sign", key.GetCanSign())
+	if key.CreatedAt != nil {
+		d.Set("created_at", key.CreatedAt.Format(time.RFC3339))
+	}
+	// Keys that never expire have no expiry date
+	if key.ExpiresAt != nil {
+		d.Set("expires_at", key.ExpiresAt.Format(time.RFC3339))
+	} else {
+		d.Set("expires_at", "")
+	}
 
 	return nil
 }
@@ -92,7 +125,7 @@ func resourceGithubUserGpgKeyDelete(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting user GPG key: %s", d.Id())
 	_, err = client.Users.DeleteGPGKey(ctx, id)
@@ -31,6 +31,7 @@ func TestAccGithubUserGpgKey_basic(t *testing.T) {
 					testAccCheckGithubUserGpgKeyExists(rn, &key),
 					resource.TestMatchResourceAttr(rn, "armored_public_key", keyRe),
 					resource.TestCheckResourceAttr(rn, "key_id", "AC541D2D1709CD33"),
+					resource.TestCheckResourceAttrSet(rn, "created_at"),
 				),
 			},
 		},
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-github/v28/github"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -39,6 +40,10 @@ func resourceGithubUserSshKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -52,7 +57,7 @@ func resourceGithubUserSshKeyCreate(d *schema.ResourceData, meta interface{}) er
 
 	title := d.Get("title").(string)
 	key := d.Get("key").(string)
-	ctx := context.Background()
+	ctx := stopContext(meta)
 
 	log.Printf("[DEBUG] Creating user SSH key: %s", title)
 	userKey, _, err := client.Users.CreateKey(ctx, &github.Key{
@@ -65,7 +70,7 @@ func resourceGithubUserSshKeyCreate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(strconv.FormatInt(*userKey.ID, 10))
 
-	return resourceGithubUserSshKeyRead(d, meta)
+	return untilFound(d, meta, resourceGithubUserSshKeyRead)
 }
 
 func resourceGithubUserSshKeyRead(d *schema.ResourceData, meta interface{}) error {
@@ -75,10 +80,8 @@ func resourceGithubUserSshKeyRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading user SSH key: %s", d.Id())
 	key, resp, err := client.Users.GetKey(ctx, id)
@@ -94,12 +97,16 @@ func resourceGithubUserSshKeyRead(d *schema.ResourceData, meta interface{}) erro
 				return nil
 			}
 		}
+		return err
 	}
 
 	d.Set("etag", resp.Header.Get("ETag"))
 	d.Set("title", key.Title)
 	d.Set("key", key.Key)
 	d.Set("url", key.URL)
+	if key.CreatedAt != nil {
+		d.Set("created_at", key.CreatedAt.Format(time.RFC3339))
+	}
 
 	return nil
 }
@@ -111,7 +118,7 @@ func resourceGithubUserSshKeyDelete(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return unconvertibleIdErr(d.Id(), err)
 	}
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting user SSH key: %s", d.Id())
 	_, err = client.Users.DeleteKey(ctx, id)
@@ -32,6 +32,7 @@ func TestAccGithubUserSshKey_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGithubUserSshKeyExists(rn, &key),
 					resource.TestCheckResourceAttr(rn, "title", title),
+					resource.TestCheckResourceAttrSet(rn, "created_at"),
 					resource.TestMatchResourceAttr(rn, "key", keyRe),
 					resource.TestMatchResourceAttr(rn, "url", urlRe),
 				),
@@ -0,0 +1,96 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGithubUserStarredRepository() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubUserStarredRepositoryCreate,
+		Read:   resourceGithubUserStarredRepositoryRead,
+		Delete: resourceGithubUserStarredRepositoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGithubUserStarredRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := meta.(*Organization).name
+	if v, ok := d.GetOk("owner"); ok {
+		owner = v.(string)
+	}
+	repoName := d.Get("repository").(string)
+	ctx := stopContext(meta)
+
+	log.Printf("[DEBUG] Starring repository: %s/%s", owner, repoName)
+	_, err := client.Activity.Star(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repoName))
+
+	return untilFound(d, meta, resourceGithubUserStarredRepositoryRead)
+}
+
+func resourceGithubUserStarredRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("Unexpected ID format (%q). Expected owner/repository", d.Id())
+	}
+	owner, repoName := parts[0], parts[1]
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading starred repository: %s/%s", owner, repoName)
+	starred, _, err := client.Activity.IsStarred(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+	if !starred {
+		log.Printf("[WARN] Removing starred repository %s/%s from state because it is no longer starred in GitHub",
+			owner, repoName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("owner", owner)
+	d.Set("repository", repoName)
+
+	return nil
+}
+
+func resourceGithubUserStarredRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Organization).client
+
+	owner := d.Get("owner").(string)
+	repoName := d.Get("repository").(string)
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Unstarring repository: %s/%s", owner, repoName)
+	_, err := client.Activity.Unstar(ctx, owner, repoName)
+	return err
+}
@@ -0,0 +1,71 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGithubUserStarredRepository_basic(t *testing.T) {
+	rn := "github_user_starred_repository.test"
+	rs := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("tf-acc-test-star-%s", rs)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGithubUserStarredRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubUserStarredRepositoryConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "repository", repoName),
+					resource.TestCheckResourceAttr(rn, "owner", testOrganization),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGithubUserStarredRepositoryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*Organization).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "github_user_starred_repository" {
+			continue
+		}
+
+		starred, _, err := conn.Activity.IsStarred(context.TODO(),
+			rs.Primary.Attributes["owner"], rs.Primary.Attributes["repository"])
+		if err != nil {
+			// The repository itself is destroyed in the same run
+			return nil
+		}
+		if starred {
+			return fmt.Errorf("Repository %s is still starred", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccGithubUserStarredRepositoryConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "github_repository" "test" {
+  name = "%s"
+}
+
+resource "github_user_starred_repository" "test" {
+  repository = "${github_repository.test.name}"
+}
+`, repoName)
+}
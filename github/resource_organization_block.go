@@ -20,9 +20,10 @@ func resourceOrganizationBlock() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"username": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: caseInsensitive(),
 			},
 
 			"etag": {
@@ -41,7 +42,7 @@ func resourceOrganizationBlockCreate(d *schema.ResourceData, meta interface{}) e
 
 	client := meta.(*Organization).client
 	orgName := meta.(*Organization).name
-	ctx := context.Background()
+	ctx := stopContext(meta)
 	username := d.Get("username").(string)
 
 	log.Printf("[DEBUG] Creating organization block: %s (%s)", username, orgName)
@@ -51,7 +52,7 @@ func resourceOrganizationBlockCreate(d *schema.ResourceData, meta interface{}) e
 	}
 	d.SetId(username)
 
-	return resourceOrganizationBlockRead(d, meta)
+	return untilFound(d, meta, resourceOrganizationBlockRead)
 }
 
 func resourceOrganizationBlockRead(d *schema.ResourceData, meta interface{}) error {
@@ -60,10 +61,8 @@ func resourceOrganizationBlockRead(d *schema.ResourceData, meta interface{}) err
 
 	username := d.Id()
 
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
-	if !d.IsNewResource() {
-		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
-	}
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
+	ctx = withEtag(ctx, d, meta)
 
 	log.Printf("[DEBUG] Reading organization block: %s (%s)", d.Id(), orgName)
 	blocked, resp, err := client.Organizations.IsBlocked(ctx, orgName, username)
@@ -99,7 +98,7 @@ func resourceOrganizationBlockDelete(d *schema.ResourceData, meta interface{}) e
 
 	orgName := meta.(*Organization).name
 	username := d.Id()
-	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	ctx := context.WithValue(stopContext(meta), ctxId, d.Id())
 
 	log.Printf("[DEBUG] Deleting organization block: %s (%s)", d.Id(), orgName)
 	_, err := client.Organizations.UnblockUser(ctx, orgName, username)
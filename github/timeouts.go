@@ -0,0 +1,87 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Terraform's own default for operations without a timeout
+const defaultOperationTimeout = 20 * time.Minute
+
+// timeoutResources gives every resource a timeouts block for the operations
+// it has, unless it declares its own defaults, and makes the requests of each
+// operation stop once its timeout elapsed.
+func timeoutResources(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		if r.Timeouts == nil {
+			r.Timeouts = &schema.ResourceTimeout{}
+		}
+		r.Timeouts.Create = operationTimeout(r.Create, r.Timeouts.Create)
+		r.Timeouts.Read = operationTimeout(r.Read, r.Timeouts.Read)
+		r.Timeouts.Update = operationTimeout(r.Update, r.Timeouts.Update)
+		r.Timeouts.Delete = operationTimeout(r.Delete, r.Timeouts.Delete)
+
+		r.Create = timeoutOperation(name, schema.TimeoutCreate, r.Create)
+		r.Read = timeoutOperation(name, schema.TimeoutRead, r.Read)
+		r.Update = timeoutOperation(name, schema.TimeoutUpdate, r.Update)
+		r.Delete = timeoutOperation(name, schema.TimeoutDelete, r.Delete)
+	}
+}
+
+func operationTimeout(f func(*schema.ResourceData, interface{}) error, timeout *time.Duration) *time.Duration {
+	if f == nil || timeout != nil {
+		return timeout
+	}
+	return schema.DefaultTimeout(defaultOperationTimeout)
+}
+
+func timeoutOperation(resourceType, key string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		timeout := d.Timeout(key)
+		ctx, cancel := context.WithTimeout(stopContext(meta), timeout)
+		defer cancel()
+
+		err := f(d, meta.(*Organization).withStopContext(ctx))
+		if err != nil && ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("%s of %s did not complete within its %s timeout: %s", key, resourceType, timeout, err)
+		}
+		return err
+	}
+}
+
+// timeLeft returns how long an operation with ctx may still wait for GitHub,
+// e.g. to finish creating something in the background.
+func timeLeft(ctx context.Context) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline)
+	}
+	return defaultOperationTimeout
+}
+
+// withStopContext returns the meta of o for an operation whose requests stop
+// with ctx. What o caches is shared with it.
+func (o *Organization) withStopContext(ctx context.Context) *Organization {
+	return &Organization{
+		name:                o.name,
+		client:              o.client,
+		StopContext:         ctx,
+		organizationOptions: o.organizationOptions,
+
+		shared: o.base(),
+	}
+}
+
+// base returns the meta which keeps the caches of o.
+func (o *Organization) base() *Organization {
+	if o.shared != nil {
+		return o.shared
+	}
+	return o
+}
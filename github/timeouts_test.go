@@ -0,0 +1,55 @@
+package github
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestTimeoutResources(t *testing.T) {
+	var deadline time.Time
+	var seen *Organization
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			deadline, _ = stopContext(meta).Deadline()
+			seen = meta.(*Organization)
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			<-stopContext(meta).Done()
+			return stopContext(meta).Err()
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Millisecond),
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+	timeoutResources(map[string]*schema.Resource{"github_example": r})
+
+	if r.Timeouts.Update != nil {
+		t.Fatal("Expected no update timeout for a resource which cannot be updated")
+	}
+	if *r.Timeouts.Create != defaultOperationTimeout || *r.Timeouts.Delete != defaultOperationTimeout {
+		t.Fatalf("Expected Terraform's default timeouts, got %s and %s", *r.Timeouts.Create, *r.Timeouts.Delete)
+	}
+
+	meta := &Organization{name: "example"}
+	d := r.Data(nil)
+	if err := r.Create(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if left := time.Until(deadline); left < defaultOperationTimeout-time.Minute || left > defaultOperationTimeout {
+		t.Fatalf("Expected the create to stop after its timeout, got a deadline in %s", left)
+	}
+	if seen == meta || seen.base() != meta || seen.name != "example" {
+		t.Fatal("Expected the create to get a meta sharing the caches of the provider")
+	}
+
+	err := r.Read(d, meta)
+	if err == nil || !strings.HasPrefix(err.Error(), "read of github_example did not complete within its 10ms timeout") {
+		t.Fatalf("Expected the read to time out, got %v", err)
+	}
+}
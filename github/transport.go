@@ -2,10 +2,14 @@ package github
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -13,9 +17,10 @@ import (
 )
 
 const (
-	ctxEtag    = "etag"
-	ctxId      = "id"
-	writeDelay = 1 * time.Second
+	ctxEtag       = "etag"
+	ctxId         = "id"
+	ctxStaleState = "stale-state"
+	writeDelay    = 1 * time.Second
 )
 
 // etagTransport allows saving API quota by passing previously stored Etag
@@ -39,30 +44,149 @@ func NewEtagTransport(rt http.RoundTripper) *etagTransport {
 	return &etagTransport{transport: rt}
 }
 
+// apiVersionTransport pins the version of the REST API requests are served by
+type apiVersionTransport struct {
+	transport http.RoundTripper
+	version   string
+}
+
+func (avt *apiVersionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.Header.Set("X-GitHub-Api-Version", avt.version)
+
+	return avt.transport.RoundTrip(req)
+}
+
+func NewApiVersionTransport(rt http.RoundTripper, version string) *apiVersionTransport {
+	return &apiVersionTransport{transport: rt, version: version}
+}
+
+// requestLogTransport logs every request the provider makes at TRACE level,
+// and counts them in metrics, to tell why a plan is slow or rate limited.
+type requestLogTransport struct {
+	transport http.RoundTripper
+	metrics   *requestMetrics
+}
+
+func (rlt *requestLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := rlt.transport.RoundTrip(req)
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[TRACE] GitHub API request %s %s failed after %s: %s", req.Method, req.URL.Path, duration, err)
+		rlt.metrics.record(0, "", duration)
+		return resp, err
+	}
+
+	remaining := resp.Header.Get("X-RateLimit-Remaining")
+	log.Printf("[TRACE] GitHub API request %s %s: %d in %s, %s requests of the rate limit remaining",
+		req.Method, req.URL.Path, resp.StatusCode, duration, remaining)
+	rlt.metrics.record(resp.StatusCode, remaining, duration)
+
+	return resp, nil
+}
+
+func NewRequestLogTransport(rt http.RoundTripper, metrics *requestMetrics) *requestLogTransport {
+	return &requestLogTransport{transport: rt, metrics: metrics}
+}
+
+// requestMetrics aggregates the requests of every provider configuration
+// which logs them. Failed requests are counted with the status 0.
+type requestMetrics struct {
+	requests           int
+	statuses           map[int]int
+	duration           time.Duration
+	rateLimitRemaining string
+
+	m sync.Mutex
+}
+
+var apiRequestMetrics = &requestMetrics{statuses: map[int]int{}}
+
+func (rm *requestMetrics) record(status int, rateLimitRemaining string, duration time.Duration) {
+	rm.m.Lock()
+	defer rm.m.Unlock()
+
+	rm.requests++
+	rm.statuses[status]++
+	rm.duration += duration
+	if rateLimitRemaining != "" {
+		rm.rateLimitRemaining = rateLimitRemaining
+	}
+}
+
+func (rm *requestMetrics) String() string {
+	rm.m.Lock()
+	defer rm.m.Unlock()
+
+	statuses := make([]int, 0, len(rm.statuses))
+	for status := range rm.statuses {
+		statuses = append(statuses, status)
+	}
+	sort.Ints(statuses)
+	counts := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		counts = append(counts, fmt.Sprintf("%d: %d", status, rm.statuses[status]))
+	}
+
+	summary := fmt.Sprintf("%d requests taking %s (%s)", rm.requests, rm.duration, strings.Join(counts, ", "))
+	if rm.rateLimitRemaining != "" {
+		summary += fmt.Sprintf(", %s requests of the rate limit remaining", rm.rateLimitRemaining)
+	}
+	return summary
+}
+
+// LogRequestMetrics logs the requests counted by the provider configurations
+// with log_requests, once Terraform is done with the provider.
+func LogRequestMetrics() {
+	apiRequestMetrics.m.Lock()
+	requests := apiRequestMetrics.requests
+	apiRequestMetrics.m.Unlock()
+
+	if requests > 0 {
+		log.Printf("[INFO] GitHub API: %s", apiRequestMetrics)
+	}
+}
+
 // rateLimitTransport implements GitHub's best practices
 // for avoiding rate limits
 // https://developer.github.com/v3/guides/best-practices-for-integrators/#dealing-with-abuse-rate-limits
+//
+// Requests are made serially unless max_concurrent_requests allows reads to
+// be made concurrently: writes are still made one at a time, and never while
+// reads are in flight.
 type rateLimitTransport struct {
 	transport        http.RoundTripper
 	delayNextRequest bool
 
-	m sync.Mutex
+	m sync.RWMutex
+	// The slots of the reads in flight, if they may be made concurrently
+	reads chan struct{}
 }
 
 func (rlt *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	// Make requests for a single user or client ID serially
 	// This is also necessary for safely saving
 	// and restoring bodies between retries below
-	rlt.lock(req)
+	serial, err := rlt.lock(req)
+	if err != nil {
+		return nil, err
+	}
 
 	// If you're making a large number of POST, PATCH, PUT, or DELETE requests
 	// for a single user or client ID, wait at least one second between each request.
-	if rlt.delayNextRequest {
-		log.Printf("[DEBUG] Sleeping %s between write operations", writeDelay)
-		time.Sleep(writeDelay)
-	}
+	// Concurrent reads leave this to the next serial request.
+	if serial {
+		if rlt.delayNextRequest {
+			log.Printf("[DEBUG] Sleeping %s between write operations", writeDelay)
+			if err := sleep(req.Context(), writeDelay); err != nil {
+				rlt.unlock(req)
+				return nil, err
+			}
+		}
 
-	rlt.delayNextRequest = isWriteMethod(req.Method)
+		rlt.delayNextRequest = isWriteMethod(req.Method)
+	}
 
 	resp, err := rlt.transport.RoundTrip(req)
 	if err != nil {
@@ -75,6 +199,7 @@ func (rlt *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, err
 	// See https://github.com/google/go-github/pull/986
 	r1, r2, err := drainBody(resp.Body)
 	if err != nil {
+		rlt.unlock(req)
 		return nil, err
 	}
 	resp.Body = r1
@@ -83,22 +208,32 @@ func (rlt *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, err
 
 	// When you have been limited, use the Retry-After response header to slow down.
 	if arlErr, ok := ghErr.(*github.AbuseRateLimitError); ok {
-		rlt.delayNextRequest = false
+		if serial {
+			rlt.delayNextRequest = false
+		}
 		retryAfter := arlErr.GetRetryAfter()
 		log.Printf("[DEBUG] Abuse detection mechanism triggered, sleeping for %s before retrying",
 			retryAfter)
-		time.Sleep(retryAfter)
+		err := sleep(req.Context(), retryAfter)
 		rlt.unlock(req)
+		if err != nil {
+			return nil, err
+		}
 		return rlt.RoundTrip(req)
 	}
 
 	if rlErr, ok := ghErr.(*github.RateLimitError); ok {
-		rlt.delayNextRequest = false
+		if serial {
+			rlt.delayNextRequest = false
+		}
 		retryAfter := time.Until(rlErr.Rate.Reset.Time)
 		log.Printf("[DEBUG] Rate limit %d reached, sleeping for %s (until %s) before retrying",
 			rlErr.Rate.Limit, retryAfter, time.Now().Add(retryAfter))
-		time.Sleep(retryAfter)
+		err := sleep(req.Context(), retryAfter)
 		rlt.unlock(req)
+		if err != nil {
+			return nil, err
+		}
 		return rlt.RoundTrip(req)
 	}
 
@@ -107,20 +242,67 @@ func (rlt *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, err
 	return resp, nil
 }
 
-func (rlt *rateLimitTransport) lock(req *http.Request) {
+// serial reports whether req has to be made while no other request is in
+// flight.
+func (rlt *rateLimitTransport) serial(req *http.Request) bool {
+	return rlt.reads == nil || isWriteMethod(req.Method)
+}
+
+// lock waits until req may be made, and reports whether it is made serially.
+// Waiting for a slot of the concurrent reads stops when the context of req is
+// canceled, e.g. because Terraform was interrupted.
+func (rlt *rateLimitTransport) lock(req *http.Request) (bool, error) {
 	ctx := req.Context()
 	log.Printf("[TRACE] Acquiring lock for GitHub API request (%q)", ctx.Value(ctxId))
-	rlt.m.Lock()
+	if rlt.serial(req) {
+		rlt.m.Lock()
+		return true, nil
+	}
+
+	rlt.m.RLock()
+	select {
+	case rlt.reads <- struct{}{}:
+		return false, nil
+	case <-ctx.Done():
+		rlt.m.RUnlock()
+		return false, ctx.Err()
+	}
 }
 
 func (rlt *rateLimitTransport) unlock(req *http.Request) {
 	ctx := req.Context()
 	log.Printf("[TRACE] Releasing lock for GitHub API request (%q)", ctx.Value(ctxId))
-	rlt.m.Unlock()
+	if rlt.serial(req) {
+		rlt.m.Unlock()
+		return
+	}
+
+	<-rlt.reads
+	rlt.m.RUnlock()
 }
 
-func NewRateLimitTransport(rt http.RoundTripper) *rateLimitTransport {
-	return &rateLimitTransport{transport: rt}
+// sleep waits for d unless ctx is canceled first, e.g. because Terraform was
+// interrupted while waiting for a rate limit to reset.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// NewRateLimitTransport returns a transport which makes up to
+// maxConcurrentReads read requests at once, and every other request serially.
+func NewRateLimitTransport(rt http.RoundTripper, maxConcurrentReads int) *rateLimitTransport {
+	rlt := &rateLimitTransport{transport: rt}
+	if maxConcurrentReads > 1 {
+		rlt.reads = make(chan struct{}, maxConcurrentReads)
+	}
+	return rlt
 }
 
 // drainBody reads all of b to memory and then returns two equivalent
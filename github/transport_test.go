@@ -8,7 +8,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-github/v28/github"
 )
@@ -45,6 +48,146 @@ func TestEtagTransport(t *testing.T) {
 	}
 }
 
+func TestApiVersionTransport(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/test/blah",
+			ExpectedHeaders: map[string]string{
+				"X-GitHub-Api-Version": "2022-11-28",
+			},
+
+			ResponseBody: `{"id": 1234}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	httpClient := &http.Client{Transport: NewApiVersionTransport(http.DefaultTransport, "2022-11-28")}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	r, _, err := client.Repositories.Get(context.Background(), "test", "blah")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.GetID() != 1234 {
+		t.Fatalf("Expected ID to be 1234, got: %d", r.GetID())
+	}
+}
+
+func TestRequestLogTransport(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/test/blah",
+			ResponseHeaders: map[string]string{
+				"X-RateLimit-Remaining": "4999",
+			},
+
+			ResponseBody: `{"id": 1234}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri: "/repos/test/missing",
+
+			ResponseBody: `{"message": "Not Found"}`,
+			StatusCode:   404,
+		},
+	})
+	defer ts.Close()
+
+	metrics := &requestMetrics{statuses: map[int]int{}}
+	httpClient := &http.Client{Transport: NewRequestLogTransport(http.DefaultTransport, metrics)}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	if _, _, err := client.Repositories.Get(context.Background(), "test", "blah"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Repositories.Get(context.Background(), "test", "missing"); err == nil {
+		t.Fatal("Expected an error for the missing repository")
+	}
+
+	if metrics.requests != 2 {
+		t.Fatalf("Expected 2 requests to be counted, got %d", metrics.requests)
+	}
+	if metrics.statuses[200] != 1 || metrics.statuses[404] != 1 {
+		t.Fatalf("Unexpected statuses counted: %v", metrics.statuses)
+	}
+	if metrics.rateLimitRemaining != "4999" {
+		t.Fatalf("Expected the last remaining rate limit to be kept, got %q", metrics.rateLimitRemaining)
+	}
+	if s := metrics.String(); !strings.HasPrefix(s, "2 requests taking ") || !strings.Contains(s, "(200: 1, 404: 1), 4999 requests") {
+		t.Fatalf("Unexpected summary: %s", s)
+	}
+}
+
+func TestRateLimitTransport_canceled(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/repos/test/blah",
+			ResponseHeaders: map[string]string{
+				"Retry-After": "3600",
+			},
+			ResponseBody: `{"message": "You have triggered an abuse detection mechanism.", "documentation_url": "https://developer.github.com/v3/#abuse-rate-limits"}`,
+			StatusCode:   403,
+		},
+	})
+	defer ts.Close()
+
+	httpClient := &http.Client{Transport: NewRateLimitTransport(http.DefaultTransport, 1)}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	// Waiting for the abuse rate limit must stop with the context
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, _, err := client.Repositories.Get(ctx, "test", "blah")
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Expected the request to be aborted, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("Expected the request to be aborted promptly, took %s", elapsed)
+	}
+}
+
+func TestConfig_apiVersionRejected(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/meta",
+			ExpectedHeaders: map[string]string{
+				"X-GitHub-Api-Version": "1999-01-01",
+			},
+
+			ResponseBody: `{"message": "Unsupported 'X-GitHub-Api-Version' provided: '1999-01-01'"}`,
+			StatusCode:   400,
+		},
+	})
+	defer ts.Close()
+
+	config := Config{
+		BaseURL:    ts.URL + "/",
+		Individual: true,
+		Anonymous:  true,
+		APIVersion: "1999-01-01",
+	}
+
+	_, err := config.Client()
+	if err == nil {
+		t.Fatal("Expected the rejected API version to be reported")
+	}
+	if !strings.Contains(err.Error(), "api_version") {
+		t.Fatalf("Expected the error to name the api_version option, got: %s", err)
+	}
+}
+
 func githubApiMock(responseSequence []*mockResponse) *httptest.Server {
 	position := github.Int(0)
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -108,6 +251,111 @@ func githubApiMock(responseSequence []*mockResponse) *httptest.Server {
 	}))
 }
 
+func TestRateLimitTransport_concurrentReads(t *testing.T) {
+	var (
+		m                sync.Mutex
+		reads, peak      int
+		writeDuringReads bool
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.Lock()
+		if r.Method == "GET" {
+			reads++
+			if reads > peak {
+				peak = reads
+			}
+		} else if reads > 0 {
+			writeDuringReads = true
+		}
+		m.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		m.Lock()
+		if r.Method == "GET" {
+			reads--
+		}
+		m.Unlock()
+		w.WriteHeader(200)
+	}))
+	defer ts.Close()
+
+	httpClient := &http.Client{Transport: NewRateLimitTransport(http.DefaultTransport, 2)}
+
+	var wg sync.WaitGroup
+	for _, method := range []string{"GET", "GET", "GET", "POST", "GET"} {
+		wg.Add(1)
+		go func(method string) {
+			defer wg.Done()
+			req, _ := http.NewRequest(method, ts.URL, nil)
+			resp, err := httpClient.Do(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}(method)
+	}
+	wg.Wait()
+
+	if peak != 2 {
+		t.Fatalf("Expected 2 reads at most to be made at once, got %d", peak)
+	}
+	if writeDuringReads {
+		t.Fatal("Expected the write not to be made while reads were in flight")
+	}
+}
+
+func TestRateLimitTransport_canceledWaitingForRead(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			<-release
+		}
+		w.WriteHeader(200)
+	}))
+	defer ts.Close()
+
+	rlt := NewRateLimitTransport(http.DefaultTransport, 2)
+
+	// Take every slot of the concurrent reads
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest("GET", ts.URL, nil)
+			resp, err := rlt.RoundTrip(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	for len(rlt.reads) < 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// Waiting for a slot must stop with the context
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequest("GET", ts.URL, nil)
+	if _, err := rlt.RoundTrip(req.WithContext(ctx)); err != context.DeadlineExceeded {
+		t.Fatalf("Expected the request to be aborted, got %v", err)
+	}
+
+	// The aborted read does not hold up the next write
+	close(release)
+	wg.Wait()
+	req, _ = http.NewRequest("POST", ts.URL, nil)
+	resp, err := rlt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+}
+
 func TestRateLimitTransport_abuseLimit_get(t *testing.T) {
 	ts := githubApiMock([]*mockResponse{
 		{
@@ -141,7 +389,7 @@ func TestRateLimitTransport_abuseLimit_get(t *testing.T) {
 	defer ts.Close()
 
 	httpClient := http.DefaultClient
-	httpClient.Transport = NewRateLimitTransport(http.DefaultTransport)
+	httpClient.Transport = NewRateLimitTransport(http.DefaultTransport, 1)
 
 	client := github.NewClient(httpClient)
 	u, _ := url.Parse(ts.URL + "/")
@@ -186,7 +434,7 @@ func TestRateLimitTransport_abuseLimit_post(t *testing.T) {
 	defer ts.Close()
 
 	httpClient := http.DefaultClient
-	httpClient.Transport = NewRateLimitTransport(http.DefaultTransport)
+	httpClient.Transport = NewRateLimitTransport(http.DefaultTransport, 1)
 
 	client := github.NewClient(httpClient)
 	u, _ := url.Parse(ts.URL + "/")
@@ -246,7 +494,7 @@ func TestRateLimitTransport_abuseLimit_post_error(t *testing.T) {
 	defer ts.Close()
 
 	httpClient := http.DefaultClient
-	httpClient.Transport = NewRateLimitTransport(http.DefaultTransport)
+	httpClient.Transport = NewRateLimitTransport(http.DefaultTransport, 1)
 
 	client := github.NewClient(httpClient)
 	u, _ := url.Parse(ts.URL + "/")
@@ -1,10 +1,15 @@
 package github
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/google/go-github/v28/github"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -14,19 +19,184 @@ const (
 )
 
 func checkOrganization(meta interface{}) error {
-	if meta.(*Organization).name == "" {
+	if meta.(*Organization).individual || meta.(*Organization).name == "" {
 		return fmt.Errorf("This resource requires GitHub organization to be set on the provider.")
 	}
 
 	return nil
 }
 
+// checkEnterpriseServer makes sure a resource which only exists in GitHub
+// Enterprise Server is not used with github.com, whose API has no such
+// endpoints.
+func checkEnterpriseServer(meta interface{}) error {
+	if !meta.(*Organization).enterpriseServer {
+		return fmt.Errorf("This resource requires `base_url` to be set to the API of a GitHub Enterprise Server.")
+	}
+
+	return nil
+}
+
+// checkOwner makes sure there is an owner for the repositories a resource
+// manages, which is either the organization or the authenticated user of an
+// individual account.
+func checkOwner(meta interface{}) error {
+	if meta.(*Organization).name == "" {
+		return fmt.Errorf("This resource requires GitHub organization or an authenticated user to be set on the provider.")
+	}
+
+	return nil
+}
+
+// requireOrganization reports an individual account using a resource which
+// only exists within an organization when planning, rather than only once it
+// is applied.
+func requireOrganization(r *schema.Resource) *schema.Resource {
+	diff := r.CustomizeDiff
+	r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if err := checkOrganization(meta); err != nil {
+			return err
+		}
+		if diff != nil {
+			return diff(d, meta)
+		}
+		return nil
+	}
+
+	return r
+}
+
+// requireEnterpriseServer reports a resource of GitHub Enterprise Server being
+// used with github.com when planning, rather than only once it is applied.
+func requireEnterpriseServer(r *schema.Resource) *schema.Resource {
+	diff := r.CustomizeDiff
+	r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if err := checkEnterpriseServer(meta); err != nil {
+			return err
+		}
+		if diff != nil {
+			return diff(d, meta)
+		}
+		return nil
+	}
+
+	return r
+}
+
+// stopContext returns the context API requests are made with, which is
+// canceled when Terraform stops the provider, e.g. on an interrupt, so that
+// requests in flight are aborted rather than awaited.
+func stopContext(meta interface{}) context.Context {
+	if ctx := meta.(*Organization).StopContext; ctx != nil {
+		return ctx
+	}
+
+	return context.Background()
+}
+
+// apiRequest issues a request against an API endpoint which the vendored
+// go-github library does not expose yet, decoding the response into v.
+// Errors are returned as *github.ErrorResponse just like the library's own
+// methods, so callers can handle 304 and 404 responses the usual way.
+func apiRequest(ctx context.Context, client *github.Client, method, urlStr string, body, v interface{}) (*github.Response, error) {
+	req, err := client.NewRequest(method, urlStr, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, v)
+}
+
+// withEtag makes the read of d conditional on the etag recorded when it was
+// last read in full, so GitHub answers 304 Not Modified, which the caller
+// keeps the state as it is for, while nothing changed since. Resources which
+// were just created or imported, or have no etag yet, are always read in
+// full, as is every resource with disable_conditional_requests, and those
+// whose state conditionalReadResources found to be stale. The etag only
+// covers the response it came with: other requests made by the same read must
+// not be given ctx, and must still be made after a 304.
+func withEtag(ctx context.Context, d *schema.ResourceData, meta interface{}) context.Context {
+	etag, _ := d.Get("etag").(string)
+	if d.IsNewResource() || etag == "" || !meta.(*Organization).conditionalRequests {
+		return ctx
+	}
+	if stale, _ := ctx.Value(ctxStaleState).(bool); stale {
+		log.Printf("[DEBUG] Reading %s in full, as its state lacks attributes the read sets", d.Id())
+		return ctx
+	}
+
+	return context.WithValue(ctx, ctxEtag, etag)
+}
+
+// conditionalReadResources makes the reads of every resource with an etag
+// skip their conditional request when the state was written by a release
+// which did not set every attribute they set yet: after a 304 the state is
+// kept as it is, so attributes added since would never be filled in.
+func conditionalReadResources(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		if _, ok := r.Schema["etag"]; !ok || r.Read == nil {
+			continue
+		}
+
+		// The attributes only the read sets, which are never empty once
+		// read
+		var computed []string
+		for key, s := range r.Schema {
+			if key != "etag" && s.Computed && !s.Optional && (s.Type == schema.TypeString || s.Type == schema.TypeInt) {
+				computed = append(computed, key)
+			}
+		}
+
+		read, version := r.Read, r.SchemaVersion
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			if !staleState(d, version, computed) {
+				return read(d, meta)
+			}
+			ctx := context.WithValue(stopContext(meta), ctxStaleState, true)
+			return read(d, meta.(*Organization).withStopContext(ctx))
+		}
+	}
+}
+
+// staleState tells whether the state of d was written with an older version
+// of its schema, or lacks any of the computed attributes.
+func staleState(d *schema.ResourceData, version int, computed []string) bool {
+	if state := d.State(); state != nil && version > 0 {
+		stored, _ := strconv.Atoi(fmt.Sprint(state.Meta["schema_version"]))
+		if stored < version {
+			return true
+		}
+	}
+
+	for _, key := range computed {
+		switch v := d.Get(key).(type) {
+		case string:
+			if v == "" {
+				return true
+			}
+		case int:
+			if v == 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func caseInsensitive() schema.SchemaDiffSuppressFunc {
 	return func(k, old, new string, d *schema.ResourceData) bool {
 		return strings.EqualFold(old, new)
 	}
 }
 
+// hashStringCaseInsensitive hashes sets of logins, which GitHub compares
+// case-insensitively. Their elements suppress changes to the case of a login
+// with caseInsensitive, as the case GitHub returns is kept in state.
+func hashStringCaseInsensitive(v interface{}) int {
+	return schema.HashString(strings.ToLower(v.(string)))
+}
+
 func validateValueFunc(values []string) schema.SchemaValidateFunc {
 	return func(v interface{}, k string) (we []string, errors []error) {
 		value := v.(string)
@@ -45,6 +215,14 @@ func validateValueFunc(values []string) schema.SchemaValidateFunc {
 	}
 }
 
+// validateDuration checks a value is a duration such as `30m` or `24h`.
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s is not a valid duration for argument %s: %s", v, k, err))
+	}
+	return
+}
+
 // return the pieces of id `a:b` as a, b
 func parseTwoPartID(id string) (string, string, error) {
 	parts := strings.SplitN(id, ":", 2)
@@ -93,6 +271,21 @@ func (e *unconvertibleIdError) Error() string {
 		e.OriginalId, e.OriginalError.Error())
 }
 
+// getTeamID resolves a team reference, which may be either a numeric team ID
+// or a team slug, to the numeric ID of the team.
+func getTeamID(ctx context.Context, meta interface{}, teamIDOrSlug string) (int64, error) {
+	if teamID, err := strconv.ParseInt(teamIDOrSlug, 10, 64); err == nil {
+		return teamID, nil
+	}
+
+	err := checkOrganization(meta)
+	if err != nil {
+		return 0, err
+	}
+
+	return getTeamIDBySlug(meta, teamIDOrSlug)
+}
+
 func validateTeamIDFunc(v interface{}, keyName string) (we []string, errors []error) {
 	teamIDString, ok := v.(string)
 	if !ok {
@@ -105,3 +298,22 @@ func validateTeamIDFunc(v interface{}, keyName string) (we []string, errors []er
 
 	return
 }
+
+// nextPageCursor returns the after cursor of the next page of a listing which
+// is paginated by cursor, such as the audit log, or "" for the last page.
+// go-github does not parse the cursors from the Link header.
+func nextPageCursor(resp *github.Response) string {
+	for _, link := range strings.Split(resp.Header.Get("Link"), ",") {
+		segments := strings.Split(strings.TrimSpace(link), ";")
+		if len(segments) < 2 || strings.TrimSpace(segments[1]) != `rel="next"` {
+			continue
+		}
+		u, err := url.Parse(strings.Trim(segments[0], "<>"))
+		if err != nil {
+			continue
+		}
+		return u.Query().Get("after")
+	}
+
+	return ""
+}
@@ -0,0 +1,296 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// lookupCache keeps the IDs objects are resolved to by name, such as teams by
+// their slug, for the lifetime of the provider. Resources planned and applied
+// in parallel often resolve the same name, so concurrent lookups of a name
+// wait for the one in flight rather than each requesting it. Failed lookups
+// are not kept, so the next lookup of the name requests it again.
+//
+// With lookup_cache_file, what is resolved is also kept on disk under prefix,
+// so later runs of the provider do not resolve the same names again.
+type lookupCache struct {
+	mu      sync.Mutex
+	entries map[string]*lookup
+
+	file   *lookupCacheFile
+	prefix string
+}
+
+type lookup struct {
+	done chan struct{}
+	id   int64
+	err  error
+}
+
+func (c *lookupCache) get(key string, resolve func() (int64, error)) (int64, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = map[string]*lookup{}
+	}
+	if l, ok := c.entries[key]; ok {
+		c.mu.Unlock()
+		<-l.done
+		return l.id, l.err
+	}
+	l := &lookup{done: make(chan struct{})}
+	c.entries[key] = l
+	c.mu.Unlock()
+
+	if id, ok := c.file.get(c.prefix + key); ok {
+		l.id = id
+	} else {
+		l.id, l.err = resolve()
+		if l.err != nil {
+			c.forget(key)
+		} else {
+			c.file.put(c.prefix+key, l.id)
+		}
+	}
+	close(l.done)
+
+	return l.id, l.err
+}
+
+// forget drops what was resolved for key, e.g. because the object was renamed
+// or deleted.
+func (c *lookupCache) forget(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+	c.file.forget(c.prefix + key)
+}
+
+// lookupCacheFile keeps the IDs resolved by lookup caches in a local JSON
+// file. Entries older than ttl are resolved again, as the object a name refers
+// to may have been deleted and another one created with the same name.
+// Concurrent runs of the provider each write what they know, so the entries
+// one of them added may be lost, and are then resolved again. Changes are
+// written lookupCacheFileDelay after the first of them, and once Terraform
+// is done with the provider, rather than the whole file for every change.
+type lookupCacheFile struct {
+	path string
+	ttl  time.Duration
+
+	m       sync.Mutex
+	entries map[string]*lookupCacheFileEntry
+	// Whether entries changed since they were written, and the timer
+	// writing them if so
+	dirty bool
+	timer *time.Timer
+}
+
+const lookupCacheFileDelay = 5 * time.Second
+
+// lookupCacheFiles are the caches loaded by the provider configurations, to
+// be written once Terraform is done with the provider.
+var lookupCacheFiles struct {
+	m     sync.Mutex
+	files []*lookupCacheFile
+}
+
+type lookupCacheFileEntry struct {
+	ID       int64     `json:"id"`
+	Resolved time.Time `json:"resolved"`
+}
+
+// loadLookupCacheFile reads the cache at path. A missing or unreadable file
+// gives an empty cache, as everything in it can be resolved again.
+func loadLookupCacheFile(path string, ttl time.Duration) *lookupCacheFile {
+	f := &lookupCacheFile{path: path, ttl: ttl, entries: map[string]*lookupCacheFileEntry{}}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[WARN] Unable to read lookup cache %s: %s", path, err)
+		}
+		return f
+	}
+	if err := json.Unmarshal(b, &f.entries); err != nil {
+		log.Printf("[WARN] Ignoring lookup cache %s which cannot be decoded: %s", path, err)
+		f.entries = map[string]*lookupCacheFileEntry{}
+	}
+
+	lookupCacheFiles.m.Lock()
+	lookupCacheFiles.files = append(lookupCacheFiles.files, f)
+	lookupCacheFiles.m.Unlock()
+
+	return f
+}
+
+// FlushLookupCaches writes the changes of the lookup caches of the provider
+// configurations, once Terraform is done with the provider.
+func FlushLookupCaches() {
+	lookupCacheFiles.m.Lock()
+	files := lookupCacheFiles.files
+	lookupCacheFiles.m.Unlock()
+
+	for _, f := range files {
+		f.flush()
+	}
+}
+
+func (f *lookupCacheFile) get(key string) (int64, bool) {
+	if f == nil {
+		return 0, false
+	}
+
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	e, ok := f.entries[key]
+	if !ok || time.Since(e.Resolved) > f.ttl {
+		return 0, false
+	}
+	log.Printf("[TRACE] Using ID %d cached in %s for: %s", e.ID, f.path, key)
+	return e.ID, true
+}
+
+func (f *lookupCacheFile) put(key string, id int64) {
+	if f == nil {
+		return
+	}
+
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	f.entries[key] = &lookupCacheFileEntry{ID: id, Resolved: time.Now().UTC()}
+	f.changed()
+}
+
+func (f *lookupCacheFile) forget(key string) {
+	if f == nil {
+		return
+	}
+
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if _, ok := f.entries[key]; ok {
+		delete(f.entries, key)
+		f.changed()
+	}
+}
+
+// changed schedules writing the entries, which must be locked.
+func (f *lookupCacheFile) changed() {
+	f.dirty = true
+	if f.timer == nil {
+		f.timer = time.AfterFunc(lookupCacheFileDelay, f.flush)
+	}
+}
+
+// flush writes the entries if they changed since they were written.
+func (f *lookupCacheFile) flush() {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if f.timer != nil {
+		f.timer.Stop()
+		f.timer = nil
+	}
+	if f.dirty {
+		f.save()
+		f.dirty = false
+	}
+}
+
+// save writes the entries which did not expire, through a temporary file so
+// that another run never reads a partly written cache. Failing to write the
+// cache only costs later runs the lookups.
+func (f *lookupCacheFile) save() {
+	for key, e := range f.entries {
+		if time.Since(e.Resolved) > f.ttl {
+			delete(f.entries, key)
+		}
+	}
+
+	b, err := json.Marshal(f.entries)
+	if err != nil {
+		log.Printf("[WARN] Unable to encode lookup cache: %s", err)
+		return
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		log.Printf("[WARN] Unable to write lookup cache %s: %s", f.path, err)
+		return
+	}
+	_, err = tmp.Write(b)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		log.Printf("[WARN] Unable to write lookup cache %s: %s", f.path, err)
+	}
+}
+
+// persistLookups makes the lookup caches of o keep what they resolve in file,
+// under the host of the API and the owner o manages.
+func (o *Organization) persistLookups(file *lookupCacheFile) {
+	o.lookupCacheFile = file
+	if file == nil {
+		return
+	}
+
+	host := strings.ToLower(o.client.BaseURL.Host)
+	owner := strings.ToLower(o.name)
+	o.teamIDs.file, o.teamIDs.prefix = file, fmt.Sprintf("team/%s/%s/", host, owner)
+	o.repositoryIDs.file, o.repositoryIDs.prefix = file, fmt.Sprintf("repository/%s/%s/", host, owner)
+	// Users do not belong to the owner
+	o.userIDs.file, o.userIDs.prefix = file, fmt.Sprintf("user/%s/", host)
+}
+
+// getTeamIDBySlug resolves the slug of a team of the organization to its ID.
+func getTeamIDBySlug(meta interface{}, slug string) (int64, error) {
+	org := meta.(*Organization)
+
+	return org.base().teamIDs.get(slug, func() (int64, error) {
+		log.Printf("[DEBUG] Resolving ID of team: %s", slug)
+
+		// Not the caller's context, which may carry the ETag of another object
+		team, err := getGithubTeamBySlug(stopContext(meta), org.client, org.name, slug)
+		if err != nil {
+			return 0, err
+		}
+		return team.GetID(), nil
+	})
+}
+
+// getRepositoryID resolves the name of a repository of the owner to its ID.
+// Repository names are not case sensitive.
+func getRepositoryID(meta interface{}, repoName string) (int64, error) {
+	org := meta.(*Organization)
+
+	return org.base().repositoryIDs.get(strings.ToLower(repoName), func() (int64, error) {
+		log.Printf("[DEBUG] Resolving ID of repository: %s/%s", org.name, repoName)
+
+		repo, _, err := org.client.Repositories.Get(stopContext(meta), org.name, repoName)
+		if err != nil {
+			return 0, err
+		}
+		return repo.GetID(), nil
+	})
+}
+
+// forgetRepositoryID drops the ID resolved for the name of a repository which
+// was renamed or deleted.
+func forgetRepositoryID(meta interface{}, repoName string) {
+	meta.(*Organization).base().repositoryIDs.forget(strings.ToLower(repoName))
+}
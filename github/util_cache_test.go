@@ -0,0 +1,192 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+)
+
+func TestLookupCache_singleFlight(t *testing.T) {
+	var c lookupCache
+	var calls int32
+	release := make(chan struct{})
+
+	var wg sync.WaitGroup
+	ids := make([]int64, 100)
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id, err := c.get("developers", func() (int64, error) {
+				atomic.AddInt32(&calls, 1)
+				<-release
+				return 42, nil
+			})
+			if err != nil {
+				t.Error(err)
+			}
+			ids[i] = id
+		}(i)
+	}
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("Expected one lookup, got %d", calls)
+	}
+	for i, id := range ids {
+		if id != 42 {
+			t.Fatalf("Expected lookup %d to resolve to 42, got %d", i, id)
+		}
+	}
+}
+
+func TestLookupCache_errorsNotKept(t *testing.T) {
+	var c lookupCache
+
+	_, err := c.get("developers", func() (int64, error) {
+		return 0, errors.New("unavailable")
+	})
+	if err == nil {
+		t.Fatal("Expected the error of the lookup")
+	}
+
+	id, err := c.get("developers", func() (int64, error) {
+		return 42, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 42 {
+		t.Fatalf("Expected the failed lookup to be retried, got %d", id)
+	}
+
+	c.forget("developers")
+	id, _ = c.get("developers", func() (int64, error) {
+		return 43, nil
+	})
+	if id != 43 {
+		t.Fatalf("Expected a forgotten lookup to be retried, got %d", id)
+	}
+}
+
+func TestGetTeamID_cached(t *testing.T) {
+	// Responses beyond the first fail, so every lookup must share it
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/orgs/example/teams?per_page=10",
+			ResponseBody: `[{"id": 1234, "slug": "developers"}]`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := getTeamID(context.Background(), meta, "developers")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if id != 1234 {
+				t.Errorf("Expected team ID 1234, got %d", id)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLookupCacheFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lookup-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "lookups.json")
+
+	first := lookupCache{file: loadLookupCacheFile(path, time.Hour), prefix: "team/api.github.com/example/"}
+	if _, err := first.get("developers", func() (int64, error) { return 42, nil }); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatal("Expected the cache to be written once flushed rather than for every change")
+	}
+	first.file.flush()
+
+	// A later run resolves nothing the earlier one resolved
+	second := lookupCache{file: loadLookupCacheFile(path, time.Hour), prefix: "team/api.github.com/example/"}
+	id, err := second.get("developers", func() (int64, error) {
+		return 0, errors.New("Expected the ID kept on disk to be used")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 42 {
+		t.Fatalf("Expected the ID kept on disk, got %d", id)
+	}
+
+	// Other owners have their own entries
+	other := lookupCache{file: second.file, prefix: "team/api.github.com/other/"}
+	id, _ = other.get("developers", func() (int64, error) { return 7, nil })
+	if id != 7 {
+		t.Fatalf("Expected the team of another owner to be resolved, got %d", id)
+	}
+
+	second.forget("developers")
+	FlushLookupCaches()
+	third := lookupCache{file: loadLookupCacheFile(path, time.Hour), prefix: "team/api.github.com/example/"}
+	id, _ = third.get("developers", func() (int64, error) { return 43, nil })
+	if id != 43 {
+		t.Fatalf("Expected a forgotten ID to be resolved again, got %d", id)
+	}
+
+	expired := lookupCache{file: loadLookupCacheFile(path, 0), prefix: "team/api.github.com/example/"}
+	id, _ = expired.get("developers", func() (int64, error) { return 44, nil })
+	if id != 44 {
+		t.Fatalf("Expected an expired ID to be resolved again, got %d", id)
+	}
+}
+
+func TestLookupCacheFile_unreadable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lookup-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "lookups.json")
+	if err := ioutil.WriteFile(path, []byte("not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := lookupCache{file: loadLookupCacheFile(path, time.Hour), prefix: "user/api.github.com/"}
+	id, err := c.get("octocat", func() (int64, error) { return 1, nil })
+	if err != nil || id != 1 {
+		t.Fatalf("Expected a corrupt cache to be ignored, got %d, %v", id, err)
+	}
+	c.file.flush()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"user/api.github.com/octocat":{"id":1,`) {
+		t.Fatalf("Expected the cache to be rewritten, got %s", b)
+	}
+}
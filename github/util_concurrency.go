@@ -0,0 +1,61 @@
+package github
+
+import (
+	"sync"
+)
+
+// concurrentRequests returns how many read requests the provider may have in
+// flight at once, which is at least one.
+func concurrentRequests(meta interface{}) int {
+	if n := meta.(*Organization).maxConcurrentRequests; n > 1 {
+		return n
+	}
+	return 1
+}
+
+// forEachConcurrently calls fn with 0 to n-1, with at most max calls running
+// at once, and returns the error of the first call which failed. Once a call
+// failed, the calls which did not start yet are skipped.
+func forEachConcurrently(max, n int, fn func(i int) error) error {
+	if max > n {
+		max = n
+	}
+
+	var (
+		wg       sync.WaitGroup
+		m        sync.Mutex
+		firstErr error
+	)
+	indexes := make(chan int)
+
+	for w := 0; w < max; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				m.Lock()
+				failed := firstErr != nil
+				m.Unlock()
+				if failed {
+					continue
+				}
+
+				if err := fn(i); err != nil {
+					m.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					m.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return firstErr
+}
@@ -0,0 +1,115 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v28/github"
+)
+
+func TestForEachConcurrently(t *testing.T) {
+	var (
+		m                sync.Mutex
+		inFlight, peak   int
+		called           = map[int]bool{}
+		errFailedRequest = fmt.Errorf("failed")
+	)
+
+	err := forEachConcurrently(3, 10, func(i int) error {
+		m.Lock()
+		called[i] = true
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		m.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		m.Lock()
+		inFlight--
+		m.Unlock()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(called) != 10 {
+		t.Fatalf("Expected fn to be called for 10 indexes, got %d", len(called))
+	}
+	if peak > 3 {
+		t.Fatalf("Expected at most 3 calls at once, got %d", peak)
+	}
+
+	calls := 0
+	err = forEachConcurrently(1, 10, func(i int) error {
+		calls++
+		if i == 2 {
+			return errFailedRequest
+		}
+		return nil
+	})
+	if err != errFailedRequest {
+		t.Fatalf("Expected the error of the failed call, got %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("Expected the calls after the failed one to be skipped, got %d calls", calls)
+	}
+}
+
+func TestListTeamMembersConcurrently(t *testing.T) {
+	var (
+		m              sync.Mutex
+		inFlight, peak int
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		m.Unlock()
+		defer func() {
+			m.Lock()
+			inFlight--
+			m.Unlock()
+		}()
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page != 1 {
+			// The later pages answer out of order
+			time.Sleep(time.Duration(5-page) * 10 * time.Millisecond)
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/teams/1/members?page=4>; rel="last", `+
+			`<https://api.github.com/teams/1/members?page=%d>; rel="next"`, page+1))
+		fmt.Fprintf(w, `[{"login": "user%d"}]`, page)
+	}))
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{maxConcurrentRequests: 3}}
+	meta.teamsRoutes.resolved = true
+
+	members, err := listGithubTeamMembers(context.Background(), meta, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"user1", "user2", "user3", "user4"}
+	if fmt.Sprint(members) != fmt.Sprint(expected) {
+		t.Fatalf("Expected members %v in page order, got %v", expected, members)
+	}
+	if peak != 3 {
+		t.Fatalf("Expected the pages after the first one to be requested at once, got %d requests at most", peak)
+	}
+}
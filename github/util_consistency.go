@@ -0,0 +1,56 @@
+package github
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// untilFound calls fn, the read or update which follows the creation of d,
+// again while GitHub does not find d yet: for a moment after creating e.g. a
+// team, a repository or an invitation, its requests may answer 404 or the
+// read may remove d from the state. It gives up after the eventual
+// consistency timeout of the provider, or once the operation timed out. The
+// updates of resources which were not just created are not retried.
+func untilFound(d *schema.ResourceData, meta interface{}, fn func(*schema.ResourceData, interface{}) error) error {
+	if !d.IsNewResource() {
+		return fn(d, meta)
+	}
+
+	id := d.Id()
+	timeout := meta.(*Organization).consistencyTimeout
+	if left := timeLeft(stopContext(meta)); left < timeout {
+		timeout = left
+	}
+
+	attempt := func() *resource.RetryError {
+		err := fn(d, meta)
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] Retrying since GitHub did not find %s yet: %s", id, err)
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if d.Id() == "" {
+			// It was created, so it is kept in the state to be tainted
+			// rather than orphaned if it is never found
+			log.Printf("[DEBUG] Retrying since GitHub did not find %s yet", id)
+			d.SetId(id)
+			return resource.RetryableError(fmt.Errorf("%s was not found after it was created", id))
+		}
+		return nil
+	}
+
+	if timeout <= 0 {
+		if err := attempt(); err != nil {
+			return err.Err
+		}
+		return nil
+	}
+	return resource.Retry(timeout, attempt)
+}
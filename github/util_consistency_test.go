@@ -0,0 +1,63 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestUntilFound(t *testing.T) {
+	r := &schema.Resource{Schema: map[string]*schema.Schema{}}
+	meta := &Organization{name: "example", organizationOptions: organizationOptions{consistencyTimeout: 10 * time.Second}}
+
+	// A read which removes what was just created is retried until it finds it
+	d := r.TestResourceData()
+	d.SetId("example")
+	d.MarkNewResource()
+	reads := 0
+	err := untilFound(d, meta, func(d *schema.ResourceData, meta interface{}) error {
+		reads++
+		if reads < 3 {
+			d.SetId("")
+		}
+		return nil
+	})
+	if err != nil || reads != 3 || d.Id() != "example" {
+		t.Fatalf("Expected 3 reads keeping the ID, got %d reads, ID %q and %v", reads, d.Id(), err)
+	}
+
+	// Errors other than GitHub not finding it are not retried
+	reads = 0
+	errFailedRequest := fmt.Errorf("failed")
+	err = untilFound(d, meta, func(d *schema.ResourceData, meta interface{}) error {
+		reads++
+		return errFailedRequest
+	})
+	if err != errFailedRequest || reads != 1 {
+		t.Fatalf("Expected a single read failing, got %d reads and %v", reads, err)
+	}
+
+	// Without a timeout the created resource is kept in the state to be tainted
+	meta.consistencyTimeout = 0
+	err = untilFound(d, meta, func(d *schema.ResourceData, meta interface{}) error {
+		d.SetId("")
+		return nil
+	})
+	if err == nil || d.Id() != "example" {
+		t.Fatalf("Expected an error keeping the ID, got ID %q and %v", d.Id(), err)
+	}
+
+	// Updates of existing resources are not retried
+	d = r.TestResourceData()
+	d.SetId("example")
+	meta.consistencyTimeout = 10 * time.Second
+	err = untilFound(d, meta, func(d *schema.ResourceData, meta interface{}) error {
+		d.SetId("")
+		return nil
+	})
+	if err != nil || d.Id() != "" {
+		t.Fatalf("Expected the update to remove the resource, got ID %q and %v", d.Id(), err)
+	}
+}
@@ -0,0 +1,114 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v28/github"
+)
+
+// Some settings, such as the IP allow list, are only exposed by the GraphQL
+// API, which the vendored go-github library has no client for. Queries are
+// posted through the REST client instead, so they share its authentication,
+// rate limiting and logging.
+
+type graphqlRequestBody struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []*graphqlError `json:"errors"`
+}
+
+// graphqlError is an error GitHub answered a query with. Type is e.g.
+// NOT_FOUND or FORBIDDEN.
+type graphqlError struct {
+	Type    string   `json:"type"`
+	Path    []string `json:"path"`
+	Message string   `json:"message"`
+}
+
+func (e *graphqlError) Error() string {
+	return e.Message
+}
+
+// graphqlURL returns the endpoint of the GraphQL API: /api/graphql next to
+// /api/v3 on GitHub Enterprise Server, and /graphql on GitHub.com.
+func graphqlURL(client *github.Client) string {
+	u := *client.BaseURL
+	if strings.HasSuffix(u.Path, "/api/v3/") {
+		u.Path = strings.TrimSuffix(u.Path, "v3/") + "graphql"
+	} else {
+		u.Path += "graphql"
+	}
+
+	return u.String()
+}
+
+// graphqlRequest runs query, decoding its data into v. The first error of a
+// response is returned as a *graphqlError.
+func graphqlRequest(ctx context.Context, client *github.Client, query string, variables map[string]interface{}, v interface{}) error {
+	resp := new(graphqlResponse)
+	_, err := apiRequest(ctx, client, "POST", graphqlURL(client),
+		&graphqlRequestBody{Query: query, Variables: variables}, resp)
+	if err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return resp.Errors[0]
+	}
+	if v == nil {
+		return nil
+	}
+
+	return json.Unmarshal(resp.Data, v)
+}
+
+// isGraphqlNotFound reports whether err is GitHub answering a query for an
+// object which does not exist.
+func isGraphqlNotFound(err error) bool {
+	gqlErr, ok := err.(*graphqlError)
+	return ok && gqlErr.Type == "NOT_FOUND"
+}
+
+// getOwnerNodeID returns the node ID of the enterprise with the given slug,
+// or of the organization of meta if enterprise is "".
+func getOwnerNodeID(ctx context.Context, meta interface{}, enterprise string) (string, error) {
+	client := meta.(*Organization).client
+
+	var data struct {
+		Enterprise *struct {
+			ID string `json:"id"`
+		} `json:"enterprise"`
+		Organization *struct {
+			ID string `json:"id"`
+		} `json:"organization"`
+	}
+	if enterprise != "" {
+		err := graphqlRequest(ctx, client, `query($slug: String!) { enterprise(slug: $slug) { id } }`,
+			map[string]interface{}{"slug": enterprise}, &data)
+		if err != nil {
+			return "", err
+		}
+		if data.Enterprise == nil {
+			return "", fmt.Errorf("Could not find enterprise with slug: %s", enterprise)
+		}
+		return data.Enterprise.ID, nil
+	}
+
+	orgName := meta.(*Organization).name
+	err := graphqlRequest(ctx, client, `query($login: String!) { organization(login: $login) { id } }`,
+		map[string]interface{}{"login": orgName}, &data)
+	if err != nil {
+		return "", err
+	}
+	if data.Organization == nil {
+		return "", fmt.Errorf("Could not find organization: %s", orgName)
+	}
+
+	return data.Organization.ID, nil
+}
@@ -0,0 +1,27 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+)
+
+func TestGraphqlURL(t *testing.T) {
+	cases := []struct {
+		baseURL  string
+		expected string
+	}{
+		{"https://api.github.com/", "https://api.github.com/graphql"},
+		{"https://github.example.com/api/v3/", "https://github.example.com/api/graphql"},
+	}
+
+	for _, tc := range cases {
+		client := github.NewClient(nil)
+		client.BaseURL, _ = url.Parse(tc.baseURL)
+
+		if u := graphqlURL(client); u != tc.expected {
+			t.Fatalf("Expected the GraphQL API of %s at %s, got %s", tc.baseURL, tc.expected, u)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package github
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// perPage returns how many items are requested per page of a list: per_page,
+// or the most GitHub returns.
+func perPage(meta interface{}) int {
+	if n := meta.(*Organization).perPage; n > 0 && n < maxPerPage {
+		return n
+	}
+	return maxPerPage
+}
+
+// maxResultsSchema returns the schema of the max_results argument of the data
+// sources reading a list, which caps how many items they read, 0 meaning all.
+func maxResultsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+}
+
+// listOptions selects a page of a list: by its number, or for lists paginated
+// with cursors by the cursor GitHub returned with the page before it.
+type listOptions struct {
+	github.ListOptions
+	After string
+}
+
+// pageItems collects the items of the pages of a list, up to max of them
+// unless max is 0.
+type pageItems struct {
+	items []interface{}
+	max   int
+}
+
+func (p *pageItems) collect(page interface{}) {
+	if page == nil {
+		return
+	}
+	v := reflect.ValueOf(page)
+	for i := 0; i < v.Len(); i++ {
+		p.items = append(p.items, v.Index(i).Interface())
+	}
+}
+
+func (p *pageItems) full() bool {
+	return p.max > 0 && len(p.items) >= p.max
+}
+
+func (p *pageItems) list() []interface{} {
+	if p.full() {
+		return p.items[:p.max]
+	}
+	return p.items
+}
+
+// pageSize returns how many items are requested per page of a list read up to
+// maxResults items.
+func pageSize(meta interface{}, maxResults int) int {
+	size := perPage(meta)
+	if maxResults > 0 && maxResults < size {
+		size = maxResults
+	}
+	return size
+}
+
+// listPages requests the pages of a paginated list and returns their items in
+// order. list requests the page opt selects, and returns the slice of items
+// it holds. Unless maxResults is 0, pages are only requested until they hold
+// maxResults items, and no more are returned.
+//
+// When the first page links to the last one, the pages after it are requested
+// concurrently, up to max_concurrent_requests at once; otherwise they are
+// followed one by one, by their number or, for lists paginated with cursors,
+// by the after cursor of their link.
+func listPages(meta interface{}, maxResults int, list func(opt listOptions) (interface{}, *github.Response, error)) ([]interface{}, error) {
+	size := pageSize(meta, maxResults)
+	items := &pageItems{items: []interface{}{}, max: maxResults}
+
+	page, resp, err := list(listOptions{ListOptions: github.ListOptions{Page: 1, PerPage: size}})
+	if err != nil {
+		return nil, err
+	}
+	items.collect(page)
+
+	switch {
+	case items.full():
+	case resp.NextPage == 0:
+		after := nextPageCursor(resp)
+		if after == "" {
+			break
+		}
+		opt := listOptions{ListOptions: github.ListOptions{PerPage: size}, After: after}
+		err = followCursors(items, opt, func(opt listOptions) (interface{}, string, error) {
+			page, resp, err := list(opt)
+			if err != nil {
+				return nil, "", err
+			}
+			return page, nextPageCursor(resp), nil
+		})
+	case resp.LastPage == 0:
+		for resp.NextPage != 0 && !items.full() {
+			page, resp, err = list(listOptions{ListOptions: github.ListOptions{Page: resp.NextPage, PerPage: size}})
+			if err != nil {
+				return nil, err
+			}
+			items.collect(page)
+		}
+	default:
+		last := resp.LastPage
+		if n := (maxResults + size - 1) / size; maxResults > 0 && n < last {
+			last = n
+		}
+
+		rest := make([]interface{}, last-resp.NextPage+1)
+		err = forEachConcurrently(concurrentRequests(meta), len(rest), func(i int) error {
+			page, _, err := list(listOptions{ListOptions: github.ListOptions{Page: resp.NextPage + i, PerPage: size}})
+			rest[i] = page
+			return err
+		})
+		for _, page := range rest {
+			items.collect(page)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return items.list(), nil
+}
+
+// listCursorPages is listPages for lists which return the cursor of their
+// next page with their items rather than in a link, e.g. the Git LFS locks or
+// the connections of the GraphQL API. list returns the items of the page opt
+// selects and the cursor of the next one, which is empty on the last page.
+func listCursorPages(meta interface{}, maxResults int, list func(opt listOptions) (interface{}, string, error)) ([]interface{}, error) {
+	items := &pageItems{items: []interface{}{}, max: maxResults}
+
+	opt := listOptions{ListOptions: github.ListOptions{PerPage: pageSize(meta, maxResults)}}
+	if err := followCursors(items, opt, list); err != nil {
+		return nil, err
+	}
+
+	return items.list(), nil
+}
+
+// followCursors collects the pages of a list paginated with cursors, starting
+// with the page opt selects, until the last one or until items is full.
+func followCursors(items *pageItems, opt listOptions, list func(opt listOptions) (interface{}, string, error)) error {
+	for {
+		page, next, err := list(opt)
+		if err != nil {
+			return err
+		}
+		items.collect(page)
+
+		if next == "" || items.full() {
+			return nil
+		}
+		opt.After = next
+	}
+}
+
+// pageQuery returns query with the page opt selects, encoded. query itself is
+// left alone, as pages may be requested concurrently.
+func pageQuery(query url.Values, opt listOptions) string {
+	q := url.Values{}
+	for key, values := range query {
+		q[key] = values
+	}
+	q.Set("page", strconv.Itoa(opt.Page))
+	q.Set("per_page", strconv.Itoa(opt.PerPage))
+
+	return q.Encode()
+}
+
+// cursorQuery is pageQuery for lists paginated with cursors, which do not take
+// page numbers.
+func cursorQuery(query url.Values, opt listOptions) string {
+	q := url.Values{}
+	for key, values := range query {
+		q[key] = values
+	}
+	q.Set("per_page", strconv.Itoa(opt.PerPage))
+	if opt.After != "" {
+		q.Set("after", opt.After)
+	}
+
+	return q.Encode()
+}
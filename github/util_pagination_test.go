@@ -0,0 +1,183 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+)
+
+func listTeamsPages(t *testing.T, meta *Organization, maxResults int) []string {
+	items, err := listPages(meta, maxResults, func(opt listOptions) (interface{}, *github.Response, error) {
+		return meta.client.Teams.ListTeams(stopContext(meta), "example", &opt.ListOptions)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slugs := []string{}
+	for _, v := range items {
+		slugs = append(slugs, v.(*github.Team).GetSlug())
+	}
+	return slugs
+}
+
+func TestListPages_maxResults(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/orgs/example/teams?page=1&per_page=2",
+			ResponseHeaders: map[string]string{
+				"Link": `<https://api.github.com/orgs/example/teams?page=2&per_page=2>; rel="next", ` +
+					`<https://api.github.com/orgs/example/teams?page=10&per_page=2>; rel="last"`,
+			},
+			ResponseBody: `[{"slug": "a"}, {"slug": "b"}]`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/orgs/example/teams?page=2&per_page=2",
+			ResponseBody: `[{"slug": "c"}, {"slug": "d"}]`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/orgs/example/teams?page=1&per_page=3",
+			ResponseBody: `[{"slug": "a"}, {"slug": "b"}, {"slug": "c"}]`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{perPage: 2}}
+
+	// Only the pages holding the first three teams are requested
+	slugs := listTeamsPages(t, meta, 3)
+	if fmt.Sprint(slugs) != "[a b c]" {
+		t.Fatalf("Expected the first three teams, got %v", slugs)
+	}
+
+	// A cap below the page size requests a single smaller page
+	meta.perPage = 0
+	slugs = listTeamsPages(t, meta, 3)
+	if fmt.Sprint(slugs) != "[a b c]" {
+		t.Fatalf("Expected the first three teams, got %v", slugs)
+	}
+}
+
+func TestListPages_followsNext(t *testing.T) {
+	// Without a link to the last page, the pages are followed one by one
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/orgs/example/teams?page=1&per_page=100",
+			ResponseHeaders: map[string]string{
+				"Link": `<https://api.github.com/orgs/example/teams?page=2&per_page=100>; rel="next"`,
+			},
+			ResponseBody: `[{"slug": "a"}]`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/orgs/example/teams?page=2&per_page=100",
+			ResponseBody: `[{"slug": "b"}]`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{maxConcurrentRequests: 4}}
+
+	slugs := listTeamsPages(t, meta, 0)
+	if fmt.Sprint(slugs) != "[a b]" {
+		t.Fatalf("Expected every team, got %v", slugs)
+	}
+}
+
+func TestListPages_followsCursors(t *testing.T) {
+	// Lists paginated with cursors link to their next page by its after cursor
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: "/orgs/example/alerts?per_page=100",
+			ResponseHeaders: map[string]string{
+				"Link": `<https://api.github.com/orgs/example/alerts?after=abc&per_page=100>; rel="next"`,
+			},
+			ResponseBody: `[{"number": 1}]`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/orgs/example/alerts?after=abc&per_page=100",
+			ResponseBody: `[{"number": 2}]`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	items, err := listPages(meta, 0, func(opt listOptions) (interface{}, *github.Response, error) {
+		var page []*struct {
+			Number int `json:"number"`
+		}
+		resp, err := apiRequest(stopContext(meta), client, "GET", "orgs/example/alerts?"+cursorQuery(url.Values{}, opt), nil, &page)
+		return page, resp, err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("Expected the alerts of both pages, got %d", len(items))
+	}
+}
+
+func TestListCursorPages(t *testing.T) {
+	pages := map[string][]string{"": {"a", "b"}, "b": {"c", "d"}, "d": {"e"}}
+	var cursors []string
+	list := func(opt listOptions) (interface{}, string, error) {
+		cursors = append(cursors, opt.After)
+		page := pages[opt.After]
+		if opt.PerPage != 2 {
+			return nil, "", fmt.Errorf("Expected pages of 2 items, got %d", opt.PerPage)
+		}
+		if opt.After == "d" {
+			return page, "", nil
+		}
+		return page, page[len(page)-1], nil
+	}
+	meta := &Organization{name: "example", organizationOptions: organizationOptions{perPage: 2}}
+
+	items, err := listCursorPages(meta, 0, list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(items) != "[a b c d e]" || fmt.Sprint(cursors) != "[ b d]" {
+		t.Fatalf("Expected every item, following the cursors, got %v after %v", items, cursors)
+	}
+
+	// The cursors stop being followed once enough items were read
+	cursors = nil
+	items, err = listCursorPages(meta, 3, list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(items) != "[a b c]" || fmt.Sprint(cursors) != "[ b]" {
+		t.Fatalf("Expected the first three items, got %v after %v", items, cursors)
+	}
+}
+
+func TestPageQuery(t *testing.T) {
+	query := url.Values{"state": {"open"}}
+
+	if q := pageQuery(query, listOptions{ListOptions: github.ListOptions{Page: 2, PerPage: 50}}); q != "page=2&per_page=50&state=open" {
+		t.Fatalf("Unexpected query: %s", q)
+	}
+	if _, ok := query["page"]; ok {
+		t.Fatal("Expected the query of the list to be left alone")
+	}
+
+	if q := cursorQuery(query, listOptions{ListOptions: github.ListOptions{PerPage: 50}, After: "abc"}); q != "after=abc&per_page=50&state=open" {
+		t.Fatalf("Unexpected cursor query: %s", q)
+	}
+}
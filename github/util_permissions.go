@@ -1,16 +1,20 @@
 package github
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/go-github/v28/github"
 )
 
 const (
-	pullPermission  string = "pull"
-	pushPermission  string = "push"
-	adminPermission string = "admin"
+	pullPermission     string = "pull"
+	triagePermission   string = "triage"
+	pushPermission     string = "push"
+	maintainPermission string = "maintain"
+	adminPermission    string = "admin"
 
 	writePermission string = "write"
 	readPermission  string = "read"
@@ -23,8 +27,12 @@ func getRepoPermission(p *map[string]bool) (string, error) {
 	// permission, the map will be: {"pull": true, "push": true, "admin": false}
 	if (*p)[adminPermission] {
 		return adminPermission, nil
+	} else if (*p)[maintainPermission] {
+		return maintainPermission, nil
 	} else if (*p)[pushPermission] {
 		return pushPermission, nil
+	} else if (*p)[triagePermission] {
+		return triagePermission, nil
 	} else {
 		if (*p)[pullPermission] {
 			return pullPermission, nil
@@ -37,13 +45,71 @@ func getInvitationPermission(i *github.RepositoryInvitation) (string, error) {
 	// Permissions for some GitHub API routes are expressed as "read",
 	// "write", and "admin"; in other places, they are expressed as "pull",
 	// "push", and "admin".
-	if *i.Permissions == readPermission {
-		return pullPermission, nil
-	} else if *i.Permissions == writePermission {
-		return pushPermission, nil
-	} else if *i.Permissions == adminPermission {
-		return adminPermission, nil
+	// Other roles, including custom ones, are named the same everywhere.
+	if i.GetPermissions() == "" {
+		return "", fmt.Errorf("unexpected permission value: %q", i.GetPermissions())
+	}
+
+	return repoPermissionFromRoleName(i.GetPermissions()), nil
+}
+
+// repoPermissionFromRoleName returns the permission of a role name, which
+// names the built-in pull and push roles read and write.
+func repoPermissionFromRoleName(roleName string) string {
+	switch roleName {
+	case readPermission:
+		return pullPermission
+	case writePermission:
+		return pushPermission
+	}
+	return roleName
+}
+
+// builtinRepoPermissions are the repository roles every organization has.
+var builtinRepoPermissions = []string{
+	pullPermission, triagePermission, pushPermission, maintainPermission, adminPermission,
+}
+
+type customRepositoryRole struct {
+	ID       *int64  `json:"id,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	BaseRole *string `json:"base_role,omitempty"`
+}
+
+type customRepositoryRoles struct {
+	TotalCount  int                     `json:"total_count"`
+	CustomRoles []*customRepositoryRole `json:"custom_roles"`
+}
+
+// validateRepoPermission makes sure a permission is one of the built-in
+// repository roles or a custom repository role of the organization, so a
+// misspelled role is reported when planning.
+func validateRepoPermission(ctx context.Context, meta interface{}, permission string) error {
+	for _, p := range builtinRepoPermissions {
+		if permission == p {
+			return nil
+		}
+	}
+
+	if err := checkOrganization(meta); err != nil {
+		return fmt.Errorf("%q is not a built-in repository role (%s), and custom roles require an organization",
+			permission, strings.Join(builtinRepoPermissions, ", "))
+	}
+
+	client := meta.(*Organization).client
+	orgName := meta.(*Organization).name
+
+	roles := new(customRepositoryRoles)
+	_, err := apiRequest(ctx, client, "GET", fmt.Sprintf("orgs/%s/custom-repository-roles", orgName), nil, roles)
+	if err != nil {
+		return err
+	}
+	for _, r := range roles.CustomRoles {
+		if r.Name != nil && *r.Name == permission {
+			return nil
+		}
 	}
 
-	return "", fmt.Errorf("unexpected permission value: %v", *i.Permissions)
+	return fmt.Errorf("%q is neither a built-in repository role (%s) nor a custom repository role of %s",
+		permission, strings.Join(builtinRepoPermissions, ", "), orgName)
 }
@@ -0,0 +1,122 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/configs/hcl2shim"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestCollaboratorUserPermission(t *testing.T) {
+	cases := map[string]string{
+		`{"login": "octocat", "role_name": "write", "permissions": {"pull": true, "push": true}}`:  "push",
+		`{"login": "octocat", "role_name": "security-engineer", "permissions": {"pull": true}}`:    "security-engineer",
+		`{"login": "octocat", "permissions": {"pull": true, "triage": true, "push": false}}`:       "triage",
+		`{"login": "octocat", "role_name": "", "permissions": {"pull": true, "maintain": true}}`:   "maintain",
+		`{"login": "octocat", "role_name": "read", "permissions": {"pull": true, "admin": false}}`: "pull",
+	}
+
+	for body, expected := range cases {
+		u := new(collaboratorUser)
+		if err := json.Unmarshal([]byte(body), u); err != nil {
+			t.Fatal(err)
+		}
+		if u.GetLogin() != "octocat" {
+			t.Fatalf("Expected the login to be decoded, got %q", u.GetLogin())
+		}
+
+		permission, err := u.permission()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if permission != expected {
+			t.Fatalf("Expected permission %s for %s, got %s", expected, body, permission)
+		}
+	}
+}
+
+func TestValidateRepoPermission(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/orgs/example/custom-repository-roles",
+			ResponseBody: `{"total_count": 1, "custom_roles": [{"id": 8030, "name": "security-engineer", "base_role": "maintain"}]}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/orgs/example/custom-repository-roles",
+			ResponseBody: `{"total_count": 1, "custom_roles": [{"id": 8030, "name": "security-engineer", "base_role": "maintain"}]}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+	ctx := context.Background()
+
+	// Built-in roles are not looked up
+	for _, permission := range builtinRepoPermissions {
+		if err := validateRepoPermission(ctx, meta, permission); err != nil {
+			t.Fatalf("Expected built-in role %s to be valid, got %s", permission, err)
+		}
+	}
+
+	if err := validateRepoPermission(ctx, meta, "security-engineer"); err != nil {
+		t.Fatalf("Expected the custom role to be valid, got %s", err)
+	}
+	if err := validateRepoPermission(ctx, meta, "security-engineers"); err == nil {
+		t.Fatal("Expected an unknown role to be reported")
+	}
+
+	individual := &Organization{name: "octocat", client: client, organizationOptions: organizationOptions{individual: true}}
+	if err := validateRepoPermission(ctx, individual, "security-engineer"); err == nil {
+		t.Fatal("Expected custom roles to require an organization")
+	}
+}
+
+func TestRepoPermissionDiff_unknown(t *testing.T) {
+	// Custom roles cannot be validated for an individual, so any
+	// permission looked up fails the plan
+	meta := &Organization{name: "octocat", client: github.NewClient(nil), organizationOptions: organizationOptions{individual: true}}
+
+	resources := map[string]*schema.Resource{
+		"github_team_repository":          resourceGithubTeamRepository(),
+		"github_repository_collaborator":  resourceGithubRepositoryCollaborator(),
+		"github_repository_collaborators": resourceGithubRepositoryCollaborators(),
+	}
+	configs := map[string]func(permission string) map[string]interface{}{
+		"github_team_repository": func(permission string) map[string]interface{} {
+			return map[string]interface{}{"team_id": "1234", "repository": "service", "permission": permission}
+		},
+		"github_repository_collaborator": func(permission string) map[string]interface{} {
+			return map[string]interface{}{"username": "octocat", "repository": "service", "permission": permission}
+		},
+		"github_repository_collaborators": func(permission string) map[string]interface{} {
+			return map[string]interface{}{
+				"repository": "service",
+				"user": []interface{}{
+					map[string]interface{}{"username": "octocat", "permission": permission},
+				},
+			}
+		},
+	}
+
+	for name, r := range resources {
+		config := terraform.NewResourceConfigRaw(configs[name]("security-engineer"))
+		if _, err := r.Diff(nil, config, meta); err == nil {
+			t.Fatalf("%s: Expected the custom role to be validated", name)
+		}
+
+		// Permissions only known when applying are left to GitHub
+		config = terraform.NewResourceConfigRaw(configs[name](hcl2shim.UnknownVariableValue))
+		if _, err := r.Diff(nil, config, meta); err != nil {
+			t.Fatalf("%s: Expected the unknown permission not to be validated, got %s", name, err)
+		}
+	}
+}
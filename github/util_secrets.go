@@ -0,0 +1,54 @@
+package github
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// encryptSecretValue encrypts value with the public key of a scope of secrets
+// the way GitHub expects it, as a libsodium sealed box encoded in base64.
+func encryptSecretValue(key *secretsPublicKey, value string) (string, error) {
+	if key == nil || key.Key == nil {
+		return "", fmt.Errorf("GitHub did not return the public key of key ID %s", key.GetKeyID())
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(*key.Key)
+	if err != nil || len(decoded) != 32 {
+		return "", fmt.Errorf("GitHub returned an invalid public key of key ID %s", key.GetKeyID())
+	}
+	var recipient [32]byte
+	copy(recipient[:], decoded)
+
+	sealed, err := sealAnonymous([]byte(value), &recipient, rand.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// sealAnonymous is the crypto_box_seal of libsodium, which the vendored
+// nacl/box does not provide yet: message is sealed with a new key pair, whose
+// public key is prepended, and a nonce derived from both public keys.
+func sealAnonymous(message []byte, recipient *[32]byte, rand io.Reader) ([]byte, error) {
+	ephemeralPublic, ephemeralPrivate, err := box.GenerateKey(rand)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := blake2b.New(24, nil)
+	if err != nil {
+		return nil, err
+	}
+	h.Write(ephemeralPublic[:])
+	h.Write(recipient[:])
+	var nonce [24]byte
+	copy(nonce[:], h.Sum(nil))
+
+	return box.Seal(ephemeralPublic[:], message, &nonce, recipient, ephemeralPrivate), nil
+}
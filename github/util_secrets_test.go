@@ -0,0 +1,49 @@
+package github
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestEncryptSecretValue(t *testing.T) {
+	public, private, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := &secretsPublicKey{
+		KeyID: github.String("568250167242549743"),
+		Key:   github.String(base64.StdEncoding.EncodeToString(public[:])),
+	}
+
+	encrypted, err := encryptSecretValue(key, "s3cr3t")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The sealed box is opened as libsodium's crypto_box_seal_open does
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ephemeralPublic [32]byte
+	copy(ephemeralPublic[:], sealed[:32])
+	var nonce [24]byte
+	h, _ := blake2b.New(24, nil)
+	h.Write(ephemeralPublic[:])
+	h.Write(public[:])
+	copy(nonce[:], h.Sum(nil))
+
+	opened, ok := box.Open(nil, sealed[32:], &nonce, &ephemeralPublic, private)
+	if !ok || string(opened) != "s3cr3t" {
+		t.Fatalf("Expected the value to be sealed for the key, got %q", opened)
+	}
+
+	if _, err := encryptSecretValue(&secretsPublicKey{Key: github.String("bm90IGEga2V5")}, "s3cr3t"); err == nil {
+		t.Fatal("Expected an invalid public key to be reported")
+	}
+}
@@ -0,0 +1,162 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The /teams/{team_id} routes used by the vendored go-github library are
+// deprecated in favour of routes scoped to the organization. The
+// organization-scoped routes which take a team ID rather than a slug were
+// added in GitHub Enterprise 2.21, so they are used wherever they exist and
+// the legacy routes only on older releases. Both address a team by its ID,
+// so the IDs kept in state need no migration either way.
+
+const (
+	// Needed by GitHub Enterprise releases where nested teams are in preview
+	teamsNestedPreviewMediaType = "application/vnd.github.hellcat-preview+json"
+
+	// Makes the team repository route return the repository and its permissions
+	teamRepositoryMediaType = "application/vnd.github.v3.repository+json"
+)
+
+// teamsRoutes caches which team routes the configured GitHub supports. Only
+// a successful lookup is cached, so a failed one is tried again by the next
+// team request.
+type teamsRoutes struct {
+	m        sync.Mutex
+	resolved bool
+	orgID    int64
+}
+
+// supportsOrgScopedTeams reports whether the organization-scoped team routes
+// exist on the GitHub Enterprise release with the given version. An empty
+// version means github.com, which always has them.
+func supportsOrgScopedTeams(version string) bool {
+	if version == "" {
+		return true
+	}
+
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return true
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return true
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return true
+	}
+
+	return major > 2 || (major == 2 && minor >= 21)
+}
+
+// resolve looks the routes up with ctx, which must be the context of the
+// provider rather than of an operation: the routes are shared by every
+// operation, and the context of a request may carry the ETag of a team.
+func (r *teamsRoutes) resolve(ctx context.Context, client *github.Client, orgName string) (int64, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.resolved || orgName == "" {
+		return r.orgID, nil
+	}
+
+	m := new(githubMeta)
+	_, err := apiRequest(ctx, client, "GET", "meta", nil, m)
+	if err != nil {
+		return 0, err
+	}
+	if !supportsOrgScopedTeams(m.GetInstalledVersion()) {
+		log.Printf("[INFO] Using legacy team routes for GitHub Enterprise %s", m.GetInstalledVersion())
+		r.resolved = true
+		return 0, nil
+	}
+
+	org, _, err := client.Organizations.Get(ctx, orgName)
+	if err != nil {
+		return 0, err
+	}
+	r.orgID = org.GetID()
+	r.resolved = true
+
+	return r.orgID, nil
+}
+
+// teamURL returns the route of the team with the given ID followed by
+// suffix, e.g. "/memberships/octocat".
+func teamURL(meta interface{}, teamID int64, suffix string) (string, error) {
+	org := meta.(*Organization)
+
+	orgID, err := org.base().teamsRoutes.resolve(stopContext(org.base()), org.client, org.name)
+	if err != nil {
+		return "", err
+	}
+	if orgID == 0 {
+		return fmt.Sprintf("teams/%d%s", teamID, suffix), nil
+	}
+
+	return fmt.Sprintf("organizations/%d/team/%d%s", orgID, teamID, suffix), nil
+}
+
+// teamRequest issues a request against a route of the team with the given
+// ID, decoding the response into v.
+func teamRequest(ctx context.Context, meta interface{}, method string, teamID int64, suffix, accept string, body, v interface{}) (*github.Response, error) {
+	client := meta.(*Organization).client
+
+	u, err := teamURL(meta, teamID, suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	return client.Do(ctx, req, v)
+}
+
+// importTeamTwoPartID imports a resource with an ID of `team:name`, where the
+// team may be given by its numeric ID or its slug. The ID kept in state always
+// uses the numeric ID, while team_id keeps the team as it was given.
+func importTeamTwoPartID(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	teamIDOrSlug, name, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	teamID, err := getTeamID(stopContext(meta), meta, teamIDOrSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	teamIDString := strconv.FormatInt(teamID, 10)
+	d.SetId(buildTwoPartID(&teamIDString, &name))
+	d.Set("team_id", teamIDOrSlug)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setTeamIDReference sets team_id to the numeric ID read from GitHub, unless
+// it refers to the team by slug, which would otherwise show up as a diff.
+func setTeamIDReference(d *schema.ResourceData, teamIDString string) {
+	if ref := d.Get("team_id").(string); ref != "" {
+		if _, err := strconv.ParseInt(ref, 10, 64); err != nil {
+			return
+		}
+	}
+	d.Set("team_id", teamIDString)
+}
@@ -0,0 +1,101 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestSupportsOrgScopedTeams(t *testing.T) {
+	cases := []struct {
+		Version  string
+		Expected bool
+	}{
+		{Version: "", Expected: true},
+		{Version: "2.20.5", Expected: false},
+		{Version: "2.21.0", Expected: true},
+		{Version: "3.9.1", Expected: true},
+	}
+
+	for _, tc := range cases {
+		if got := supportsOrgScopedTeams(tc.Version); got != tc.Expected {
+			t.Fatalf("Expected %t for version %q, got %t", tc.Expected, tc.Version, got)
+		}
+	}
+}
+
+func TestTeamsRoutesResolve_retriesFailures(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/meta",
+			ResponseBody: `{"message": "Server Error"}`,
+			StatusCode:   500,
+		},
+		{
+			ExpectedUri:  "/meta",
+			ResponseBody: `{"installed_version": "3.9.1"}`,
+			StatusCode:   200,
+		},
+		{
+			ExpectedUri:  "/orgs/example",
+			ResponseBody: `{"id": 42, "login": "example"}`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	if _, err := teamURL(meta, 1234, ""); err == nil {
+		t.Fatal("Expected the failure to look the routes up to be reported")
+	}
+
+	// The failure is not cached, while the routes found next are
+	for i := 0; i < 2; i++ {
+		u, err := teamURL(meta, 1234, "/members")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u != "organizations/42/team/1234/members" {
+			t.Fatalf("Expected the organization-scoped route, got %s", u)
+		}
+	}
+}
+
+func TestImportTeamTwoPartID(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/orgs/example/teams?per_page=10",
+			ResponseBody: `[{"id": 1234, "slug": "developers"}]`,
+			StatusCode:   200,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	for _, teamRef := range []string{"1234", "developers"} {
+		d := schema.TestResourceDataRaw(t, resourceGithubTeamMembership().Schema, map[string]interface{}{})
+		d.SetId(teamRef + ":octocat")
+
+		imported, err := importTeamTwoPartID(d, meta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id := imported[0].Id(); id != "1234:octocat" {
+			t.Fatalf("Expected ID 1234:octocat importing %s, got %s", teamRef, id)
+		}
+
+		// The team is kept as it was given
+		setTeamIDReference(imported[0], "1234")
+		if got := imported[0].Get("team_id").(string); got != teamRef {
+			t.Fatalf("Expected team_id %s, got %s", teamRef, got)
+		}
+	}
+}
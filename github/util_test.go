@@ -1,8 +1,15 @@
 package github
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"unicode"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func TestAccValidateTeamIDFunc(t *testing.T) {
@@ -89,6 +96,28 @@ func TestAccGithubUtilTwoPartID(t *testing.T) {
 	}
 }
 
+func TestCheckOrganization(t *testing.T) {
+	cases := []struct {
+		Meta         *Organization
+		Organization bool
+		Owner        bool
+	}{
+		{&Organization{name: "example"}, true, true},
+		{&Organization{name: "octocat", organizationOptions: organizationOptions{individual: true}}, false, true},
+		{&Organization{organizationOptions: organizationOptions{individual: true}}, false, false},
+		{(&Organization{name: "octocat", organizationOptions: organizationOptions{individual: true}}).forOwner("example"), true, true},
+	}
+
+	for _, tc := range cases {
+		if err := checkOrganization(tc.Meta); (err == nil) != tc.Organization {
+			t.Fatalf("Expected organization check of %q to pass: %t, got %v", tc.Meta.name, tc.Organization, err)
+		}
+		if err := checkOwner(tc.Meta); (err == nil) != tc.Owner {
+			t.Fatalf("Expected owner check of %q to pass: %t, got %v", tc.Meta.name, tc.Owner, err)
+		}
+	}
+}
+
 func flipUsernameCase(username string) string {
 	oc := []rune(username)
 
@@ -106,3 +135,91 @@ func flipUsernameCase(username string) string {
 	}
 	return string(oc)
 }
+
+func TestHashStringCaseInsensitive(t *testing.T) {
+	configured := schema.NewSet(hashStringCaseInsensitive, []interface{}{"FooBar", "baz"})
+	current := schema.NewSet(hashStringCaseInsensitive, []interface{}{"foobar", "BAZ"})
+
+	if configured.Difference(current).Len() != 0 || current.Difference(configured).Len() != 0 {
+		t.Fatalf("Expected logins differing only in case to be equal, actual: %v and %v",
+			configured.List(), current.List())
+	}
+
+	if !configured.Contains("FOOBAR") {
+		t.Fatalf("Expected %v to contain FOOBAR", configured.List())
+	}
+}
+
+func TestWithEtag(t *testing.T) {
+	r := resourceGithubIssueLabel()
+	cases := []struct {
+		name        string
+		etag        string
+		isNew       bool
+		conditional bool
+		expected    interface{}
+	}{
+		{"read before", `"abc"`, false, true, `"abc"`},
+		{"just created", `"abc"`, true, true, nil},
+		{"imported", "", false, true, nil},
+		{"disabled", `"abc"`, false, false, nil},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+		d.Set("etag", tc.etag)
+		if tc.isNew {
+			d.MarkNewResource()
+		}
+		meta := &Organization{organizationOptions: organizationOptions{conditionalRequests: tc.conditional}}
+
+		ctx := withEtag(context.Background(), d, meta)
+		if etag := ctx.Value(ctxEtag); etag != tc.expected {
+			t.Fatalf("%s: expected etag %v, actual: %v", tc.name, tc.expected, etag)
+		}
+	}
+}
+
+func TestConditionalReadResources(t *testing.T) {
+	// GitHub answers 304 for as long as the team does not change
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("If-None-Match") == `"abc"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"abc"`)
+		w.Write([]byte(`{"id": 1234, "node_id": "T_kwDOAbc", "name": "example", "slug": "example"}`))
+	}))
+	defer ts.Close()
+
+	client := github.NewClient(&http.Client{Transport: NewEtagTransport(http.DefaultTransport)})
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client, organizationOptions: organizationOptions{conditionalRequests: true}}
+	meta.teamsRoutes.resolved = true
+
+	r := resourceGithubTeam()
+	conditionalReadResources(map[string]*schema.Resource{"github_team": r})
+
+	// State written before node_id was read lacks it, despite its etag
+	d := r.Data(nil)
+	d.SetId("1234")
+	d.Set("name", "example")
+	d.Set("slug", "example")
+	d.Set("etag", `"abc"`)
+	if err := r.Read(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("node_id").(string) != "T_kwDOAbc" {
+		t.Fatalf("Expected the team to be read in full, got node_id %q", d.Get("node_id"))
+	}
+
+	// Complete state is read conditionally, and kept as it is after a 304
+	d.Set("name", "renamed")
+	if err := r.Read(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("name").(string) != "renamed" {
+		t.Fatal("Expected the complete state to be read conditionally")
+	}
+}
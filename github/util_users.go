@@ -0,0 +1,109 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Users can change their login at any time, while their ID stays the same.
+// The resources whose ID is made up of `something:login` keep the ID of their
+// user in user_id, so a login which no longer exists can be traced to the new
+// login of the same user. The ID in state is then updated to the new login,
+// while the username argument keeps the former login until the configuration
+// is changed, which suppressRenamedUsername keeps from forcing a new resource.
+
+// getUserID returns the ID of the user with the given login. Logins are not
+// case sensitive.
+func getUserID(meta interface{}, login string) (int64, error) {
+	org := meta.(*Organization)
+
+	return org.base().userIDs.get(strings.ToLower(login), func() (int64, error) {
+		log.Printf("[DEBUG] Resolving ID of user: %s", login)
+
+		user, _, err := org.client.Users.Get(stopContext(meta), login)
+		if err != nil {
+			return 0, err
+		}
+		return user.GetID(), nil
+	})
+}
+
+// getUserLogin returns the current login of the user with the given ID, or
+// "" when the user no longer exists.
+func getUserLogin(ctx context.Context, client *github.Client, userID int64) (string, error) {
+	user := new(github.User)
+	_, err := apiRequest(ctx, client, "GET", fmt.Sprintf("user/%d", userID), nil, user)
+	if err != nil {
+		if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return user.GetLogin(), nil
+}
+
+// renamedUserLogin returns the new login of the user kept in user_id, or ""
+// when login still belongs to that user or no user ID is known.
+func renamedUserLogin(ctx context.Context, d *schema.ResourceData, meta interface{}, login string) (string, error) {
+	userID := int64(d.Get("user_id").(int))
+	if userID == 0 {
+		return "", nil
+	}
+
+	current, err := getUserLogin(ctx, meta.(*Organization).client, userID)
+	if err != nil || current == "" || strings.EqualFold(current, login) {
+		return "", err
+	}
+
+	log.Printf("[INFO] User %d was renamed from %s to %s", userID, login, current)
+	return current, nil
+}
+
+// renameUserID replaces the login in the `something:login` ID of d.
+func renameUserID(d *schema.ResourceData, login string) error {
+	prefix, _, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	d.SetId(buildTwoPartID(&prefix, &login))
+
+	return nil
+}
+
+// userLoginFromID returns the login in the `something:login` ID of d, which
+// is kept up to date when its user is renamed, unlike the username argument.
+func userLoginFromID(d *schema.ResourceData) (string, error) {
+	_, login, err := parseTwoPartID(d.Id())
+	return login, err
+}
+
+// setUsername records the login read from GitHub as the username, unless the
+// username is a former login of the user.
+func setUsername(d *schema.ResourceData, login string) {
+	if username := d.Get("username").(string); username == "" || strings.EqualFold(username, login) {
+		d.Set("username", login)
+	}
+}
+
+// suppressRenamedUsername suppresses changes to the case of a login, and
+// changing the username from a former login of a renamed user to the login
+// the ID of the resource already refers to.
+func suppressRenamedUsername() schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if strings.EqualFold(old, new) {
+			return true
+		}
+		if d.Id() == "" {
+			return false
+		}
+		login, err := userLoginFromID(d)
+		return err == nil && strings.EqualFold(login, new)
+	}
+}
@@ -0,0 +1,63 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v28/github"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestRenamedMembershipUser(t *testing.T) {
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/orgs/example/memberships/alice",
+			StatusCode:   404,
+			ResponseBody: `{"message": "Not Found"}`,
+		},
+		{
+			ExpectedUri:  "/user/42",
+			StatusCode:   200,
+			ResponseBody: `{"id": 42, "login": "alice-renamed"}`,
+		},
+		{
+			ExpectedUri: "/orgs/example/memberships/alice-renamed",
+			StatusCode:  200,
+			ResponseBody: `{"role": "member", "state": "active",
+  "organization": {"login": "example"}, "user": {"id": 42, "login": "alice-renamed"}}`,
+		},
+	})
+	defer ts.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(ts.URL + "/")
+	meta := &Organization{name: "example", client: client}
+
+	r := resourceGithubMembership()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"username": "alice",
+	})
+	d.SetId("example:alice")
+	d.Set("user_id", 42)
+
+	if err := resourceGithubMembershipRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "example:alice-renamed" {
+		t.Fatalf("Expected the ID to follow the renamed user, got %s", d.Id())
+	}
+	if username := d.Get("username").(string); username != "alice" {
+		t.Fatalf("Expected the configured username to be kept, got %s", username)
+	}
+
+	// Neither the former nor the new login forces a new membership
+	suppress := r.Schema["username"].DiffSuppressFunc
+	for _, login := range []string{"alice-renamed", "Alice-Renamed"} {
+		if !suppress("username", "alice", login, d) {
+			t.Fatalf("Expected changing the username to %s to be suppressed", login)
+		}
+	}
+	if suppress("username", "alice", "bob", d) {
+		t.Fatal("Expected changing the username to another user not to be suppressed")
+	}
+}
@@ -5,5 +5,7 @@ require (
 	github.com/hashicorp/terraform v0.12.7
 	github.com/kylelemons/godebug v1.1.0
 	github.com/terraform-providers/terraform-provider-tls v1.2.0
+	github.com/zclconf/go-cty-yaml v1.0.1
+	golang.org/x/crypto v0.0.0-20190701094942-4def268fd1a4
 	golang.org/x/oauth2 v0.0.0-20190604054615-0f29369cfe45
 )
@@ -8,4 +8,8 @@ import (
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: github.Provider})
+
+	// Serve returns once Terraform shut the provider down
+	github.FlushLookupCaches()
+	github.LogRequestMetrics()
 }